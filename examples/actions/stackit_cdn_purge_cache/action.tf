@@ -0,0 +1,27 @@
+action "stackit_cdn_purge_cache" "example" {
+  config {
+    project_id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    distribution_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+    paths           = ["/index.html", "/assets/*"]
+  }
+}
+
+# Purge the cache whenever the uploaded assets change
+resource "terraform_data" "assets" {
+  input = sha1(join("", [for f in fileset("${path.module}/dist", "**") : filesha1("${path.module}/dist/${f}")]))
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.stackit_cdn_purge_cache.example]
+    }
+  }
+}
+
+# Purge the whole cache of a distribution manually with: terraform apply -invoke=action.stackit_cdn_purge_cache.all
+action "stackit_cdn_purge_cache" "all" {
+  config {
+    project_id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    distribution_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+  }
+}
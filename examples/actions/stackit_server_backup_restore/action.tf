@@ -0,0 +1,19 @@
+# Restore the whole server manually with: terraform apply -invoke=action.stackit_server_backup_restore.example
+action "stackit_server_backup_restore" "example" {
+  config {
+    project_id                 = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    server_id                  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    backup_id                  = stackit_server_backup.example.backup_id
+    start_server_after_restore = true
+  }
+}
+
+# Restore only a single volume of the backup
+action "stackit_server_backup_restore" "volume" {
+  config {
+    project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    server_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    backup_id  = stackit_server_backup.example.backup_id
+    volume_ids = ["yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"]
+  }
+}
@@ -0,0 +1,21 @@
+# Update the server manually with: terraform apply -invoke=action.stackit_server_update_run.example
+action "stackit_server_update_run" "example" {
+  config {
+    project_id         = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    server_id          = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    maintenance_window = 1
+  }
+}
+
+resource "stackit_server_update_enable" "enable" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  server_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+  # Update the server right after the update service was enabled
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.stackit_server_update_run.example]
+    }
+  }
+}
@@ -0,0 +1,7 @@
+data "stackit_application_load_balancer_plans" "example" {
+}
+
+# Choose the smallest plan which supports at least 50000 connections per instance
+locals {
+  plan_id = [for plan in data.stackit_application_load_balancer_plans.example.plans : plan.plan_id if plan.max_connections >= 50000][0]
+}
@@ -0,0 +1,4 @@
+data "stackit_intake" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  intake_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
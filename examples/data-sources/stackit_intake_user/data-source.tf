@@ -0,0 +1,5 @@
+data "stackit_intake_user" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  intake_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  user_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
@@ -0,0 +1,13 @@
+data "stackit_loadbalancer_quotas" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Fail early, if no further load balancer can be created in the project
+resource "terraform_data" "quota_check" {
+  lifecycle {
+    precondition {
+      condition     = data.stackit_loadbalancer_quotas.example.used_load_balancers < data.stackit_loadbalancer_quotas.example.max_load_balancers
+      error_message = "The load balancer quota of the project is exhausted."
+    }
+  }
+}
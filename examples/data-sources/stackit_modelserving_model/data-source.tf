@@ -0,0 +1,3 @@
+data "stackit_modelserving_model" "example" {
+  name = "cortecs/Llama-3.3-70B-Instruct-FP8-Dynamic"
+}
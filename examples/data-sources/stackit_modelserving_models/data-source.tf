@@ -0,0 +1,4 @@
+data "stackit_modelserving_models" "example" {
+  type       = "chat"
+  name_regex = "^google/"
+}
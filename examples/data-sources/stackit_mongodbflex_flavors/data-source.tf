@@ -0,0 +1,3 @@
+data "stackit_mongodbflex_flavors" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
@@ -0,0 +1,4 @@
+data "stackit_mongodbflex_storages" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  flavor_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
@@ -0,0 +1,3 @@
+data "stackit_scf_quotas" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
@@ -0,0 +1,4 @@
+data "stackit_scf_space" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  space_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
@@ -0,0 +1,9 @@
+data "stackit_server_updates" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  server_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Number of installed updates per update run, e.g. for compliance reports
+output "installed_updates" {
+  value = { for update in data.stackit_server_updates.example.items : update.update_id => update.installed_updates }
+}
@@ -0,0 +1,16 @@
+# The ciphertext was created beforehand with the KMS encrypt endpoint and can safely be committed to git,
+# together with the number of the key version it was encrypted with.
+ephemeral "stackit_kms_decrypt" "db_password" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  keyring_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  key_id         = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  version_number = 1
+  ciphertext     = file("${path.module}/db_password.enc")
+}
+
+# The plaintext can be used in provider configurations and write-only arguments without being stored in the state.
+provider "postgresql" {
+  host     = "example.postgresql.eu01.onstackit.cloud"
+  username = "admin"
+  password = ephemeral.stackit_kms_decrypt.db_password.plaintext
+}
@@ -0,0 +1,13 @@
+ephemeral "stackit_secretsmanager_secret" "example" {
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  path        = "app/database"
+  username    = stackit_secretsmanager_user.reader.username
+  password    = stackit_secretsmanager_user.reader.password
+}
+
+# The secret values can be used in other ephemeral contexts, e.g. in provider configurations
+provider "postgresql" {
+  host     = "example.postgresql.eu01.onstackit.cloud"
+  username = ephemeral.stackit_secretsmanager_secret.example.data["username"]
+  password = ephemeral.stackit_secretsmanager_secret.example.data["password"]
+}
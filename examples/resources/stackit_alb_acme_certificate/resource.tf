@@ -0,0 +1,39 @@
+resource "stackit_dns_zone" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "Example zone"
+  dns_name   = "example.runs.onstackit.cloud"
+}
+
+# Key of the ACME account, which is reused by all renewals of the certificate
+resource "tls_private_key" "acme_account" {
+  algorithm   = "ECDSA"
+  ecdsa_curve = "P256"
+}
+
+resource "stackit_alb_acme_certificate" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name        = "example-certificate"
+  domains     = ["example.runs.onstackit.cloud", "*.example.runs.onstackit.cloud"]
+  dns_zone_id = stackit_dns_zone.example.zone_id
+  acme_email  = "admin@example.com"
+
+  acme_account_key_wo = tls_private_key.acme_account.private_key_pem
+
+  # renew the certificate when a plan is created less than 30 days before it expires
+  renew_before_days = 30
+
+  # create the renewed certificate before the old one is removed from the load balancer
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+# Use the certificate in an HTTPS listener of an application load balancer
+# listeners = [{
+#   ...
+#   https = {
+#     certificate_config = {
+#       certificate_ids = [stackit_alb_acme_certificate.example.cert_id]
+#     }
+#   }
+# }]
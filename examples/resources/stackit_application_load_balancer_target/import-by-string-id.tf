@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing application load balancer target
+import {
+  to = stackit_application_load_balancer_target.import-example
+  id = "${var.project_id},${var.region},${var.load_balancer_name},${var.target_pool_name},${var.ip}"
+}
\ No newline at end of file
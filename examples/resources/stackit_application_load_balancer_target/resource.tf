@@ -0,0 +1,7 @@
+resource "stackit_application_load_balancer_target" "example" {
+  project_id         = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  load_balancer_name = "example-load-balancer"
+  target_pool_name   = "example-target-pool"
+  ip                 = "192.168.0.10"
+  display_name       = "example-target"
+}
@@ -0,0 +1,11 @@
+# Only use the import statement, if you want to import the existing role assignments of a folder
+import {
+  to = stackit_authorization_folder_role_assignments.import-example
+  id = var.folder_id
+}
+
+# Only use the import statement, if you want to import the existing members of a single role of a folder
+import {
+  to = stackit_authorization_folder_role_assignments.import-role-example
+  id = "${var.folder_id},${var.folder_role}"
+}
@@ -0,0 +1,33 @@
+resource "stackit_resourcemanager_folder" "example" {
+  name        = "example_folder"
+  owner_email = "foo.bar@stackit.cloud"
+  # in this case a org-id
+  parent_container_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Manages all members of the folder. Members added outside of Terraform are removed.
+resource "stackit_authorization_folder_role_assignments" "all" {
+  resource_id = stackit_resourcemanager_folder.example.folder_id
+  members = [
+    {
+      role    = "owner"
+      subject = "foo.bar@stackit.cloud"
+    },
+    {
+      role    = "reader"
+      subject = "jane.doe@stackit.cloud"
+    },
+  ]
+}
+
+# Manages only the members of the "editor" role, other roles are not touched.
+resource "stackit_authorization_folder_role_assignments" "editors" {
+  resource_id = stackit_resourcemanager_folder.example.folder_id
+  role        = "editor"
+  members = [
+    {
+      role    = "editor"
+      subject = "john.doe@stackit.cloud"
+    },
+  ]
+}
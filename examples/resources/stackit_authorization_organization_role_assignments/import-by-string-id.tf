@@ -0,0 +1,11 @@
+# Only use the import statement, if you want to import the existing role assignments of a organization
+import {
+  to = stackit_authorization_organization_role_assignments.import-example
+  id = var.organization_id
+}
+
+# Only use the import statement, if you want to import the existing members of a single role of a organization
+import {
+  to = stackit_authorization_organization_role_assignments.import-role-example
+  id = "${var.organization_id},${var.organization_role}"
+}
@@ -0,0 +1,26 @@
+# Manages all members of the organization. Members added outside of Terraform are removed.
+resource "stackit_authorization_organization_role_assignments" "all" {
+  resource_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  members = [
+    {
+      role    = "owner"
+      subject = "foo.bar@stackit.cloud"
+    },
+    {
+      role    = "reader"
+      subject = "jane.doe@stackit.cloud"
+    },
+  ]
+}
+
+# Manages only the members of the "editor" role, other roles are not touched.
+resource "stackit_authorization_organization_role_assignments" "editors" {
+  resource_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  role        = "editor"
+  members = [
+    {
+      role    = "editor"
+      subject = "john.doe@stackit.cloud"
+    },
+  ]
+}
@@ -0,0 +1,11 @@
+# Only use the import statement, if you want to import the existing role assignments of a project
+import {
+  to = stackit_authorization_project_role_assignments.import-example
+  id = var.project_id
+}
+
+# Only use the import statement, if you want to import the existing members of a single role of a project
+import {
+  to = stackit_authorization_project_role_assignments.import-role-example
+  id = "${var.project_id},${var.project_role}"
+}
@@ -0,0 +1,13 @@
+resource "stackit_dremio_privilege" "example" {
+  url = stackit_dremio_instance.example.endpoints.ui
+  authentication = {
+    authority_url = stackit_dremio_instance.example.authentication.oauth.authority_url
+    client_id     = stackit_dremio_instance.example.authentication.oauth.client_id
+    client_secret = stackit_dremio_instance.example.authentication.oauth.client_secret
+  }
+
+  catalog_id   = stackit_dremio_source.example.source_id
+  grantee_type = "ROLE"
+  grantee_id   = stackit_dremio_role.example.role_id
+  privileges   = ["SELECT"]
+}
@@ -0,0 +1,11 @@
+resource "stackit_dremio_role" "example" {
+  url = stackit_dremio_instance.example.endpoints.ui
+  authentication = {
+    authority_url = stackit_dremio_instance.example.authentication.oauth.authority_url
+    client_id     = stackit_dremio_instance.example.authentication.oauth.client_id
+    client_secret = stackit_dremio_instance.example.authentication.oauth.client_secret
+  }
+
+  name        = "analysts"
+  description = "Read access to the lakehouse"
+}
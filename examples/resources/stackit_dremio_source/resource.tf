@@ -0,0 +1,21 @@
+resource "stackit_dremio_source" "example" {
+  url = stackit_dremio_instance.example.endpoints.ui
+  authentication = {
+    authority_url = stackit_dremio_instance.example.authentication.oauth.authority_url
+    client_id     = stackit_dremio_instance.example.authentication.oauth.client_id
+    client_secret = stackit_dremio_instance.example.authentication.oauth.client_secret
+  }
+
+  name = "lakehouse"
+  type = "S3"
+  config = jsonencode({
+    accessKey         = stackit_objectstorage_credential.example.access_key
+    secretAccessKey   = stackit_objectstorage_credential.example.secret_access_key
+    buckets           = [stackit_objectstorage_bucket.example.name]
+    compatibilityMode = true
+    propertyList = [
+      { name = "fs.s3a.endpoint", value = "object.storage.eu01.onstackit.cloud" },
+      { name = "fs.s3a.path.style.access", value = "true" }
+    ]
+  })
+}
@@ -0,0 +1,10 @@
+resource "stackit_git_branch_protection" "example" {
+  url                     = stackit_git.example.url
+  token                   = var.git_token
+  organization            = stackit_git_organization.example.name
+  repository              = stackit_git_repository.example.name
+  rule_name               = "main"
+  required_approvals      = 1
+  status_check_contexts   = ["ci/build"]
+  dismiss_stale_approvals = true
+}
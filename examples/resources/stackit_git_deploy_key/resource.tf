@@ -0,0 +1,9 @@
+resource "stackit_git_deploy_key" "example" {
+  url          = stackit_git.example.url
+  token        = var.git_token
+  organization = stackit_git_organization.example.name
+  repository   = stackit_git_repository.example.name
+  title        = "argocd"
+  key          = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBaq1d2Dk0tS2gS7iV+0bJc2hAg3rc1zQn4Lzvq4x4Ph argocd"
+  read_only    = true
+}
@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing git organization
+import {
+  to = stackit_git_organization.import-example
+  id = "${var.git_url},${var.git_organization_name}"
+}
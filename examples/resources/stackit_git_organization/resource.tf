@@ -0,0 +1,8 @@
+resource "stackit_git_organization" "example" {
+  url         = stackit_git.example.url
+  token       = var.git_token
+  name        = "platform-team"
+  full_name   = "Platform Team"
+  description = "Repositories of the platform team"
+  visibility  = "private"
+}
@@ -0,0 +1,10 @@
+resource "stackit_git_repository" "example" {
+  url            = stackit_git.example.url
+  token          = var.git_token
+  organization   = stackit_git_organization.example.name
+  name           = "infrastructure"
+  description    = "Terraform configuration of the platform"
+  private        = true
+  auto_init      = true
+  default_branch = "main"
+}
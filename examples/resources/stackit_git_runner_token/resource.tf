@@ -0,0 +1,24 @@
+resource "stackit_git_runner_token" "example" {
+  url          = stackit_git.example.url
+  token        = var.git_token
+  organization = stackit_git_organization.example.name
+}
+
+# Register a Forgejo Actions runner on a server
+resource "stackit_server" "runner" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name         = "forgejo-runner"
+  machine_type = "c2i.2"
+  boot_volume = {
+    size        = 64
+    source_type = "image"
+    source_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+  user_data = <<-EOT
+    #!/bin/sh
+    forgejo-runner register --no-interactive \
+      --instance ${stackit_git.example.url} \
+      --token ${stackit_git_runner_token.example.registration_token} \
+      --name forgejo-runner
+  EOT
+}
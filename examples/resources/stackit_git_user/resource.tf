@@ -0,0 +1,14 @@
+resource "stackit_git_user" "example" {
+  url       = stackit_git.example.url
+  token     = var.git_admin_token
+  username  = "jane.doe"
+  email     = "jane.doe@example.com"
+  full_name = "Jane Doe"
+  password  = random_password.git_user.result
+  # the user has to choose a new password on the first login
+  must_change_password = true
+}
+
+resource "random_password" "git_user" {
+  length = 24
+}
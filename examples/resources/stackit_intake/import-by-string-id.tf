@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing intake
+import {
+  to = stackit_intake.example
+  id = "${var.project_id},${var.region},${var.intake_id}"
+}
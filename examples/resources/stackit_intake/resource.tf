@@ -0,0 +1,24 @@
+resource "stackit_intake" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  runner_id   = stackit_intake_runner.example.runner_id
+  name        = "example-intake"
+  description = "An example intake for STACKIT Intake"
+  catalog = {
+    uri          = "https://dremio-xxxxxxxx.dremio.onstackit.cloud/iceberg"
+    warehouse    = "catalog"
+    namespace    = "intake"
+    table_name   = "events"
+    partitioning = "manual"
+    partition_by = ["day(__intake_ts)"]
+    auth = {
+      type = "dremio"
+      dremio = {
+        token_endpoint        = "https://dremio-xxxxxxxx.dremio.onstackit.cloud/oauth/token"
+        personal_access_token = var.dremio_personal_access_token
+      }
+    }
+  }
+  labels = {
+    "env" = "development"
+  }
+}
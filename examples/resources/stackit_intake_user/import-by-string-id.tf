@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing intake user
+import {
+  to = stackit_intake_user.example
+  id = "${var.project_id},${var.region},${var.intake_id},${var.user_id}"
+}
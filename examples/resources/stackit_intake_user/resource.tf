@@ -0,0 +1,12 @@
+resource "stackit_intake_user" "example" {
+  project_id          = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  intake_id           = stackit_intake.example.intake_id
+  name                = "example-producer"
+  description         = "Producer of the example intake"
+  type                = "intake"
+  password_wo         = var.intake_user_password
+  password_wo_version = 1
+  labels = {
+    "env" = "development"
+  }
+}
@@ -6,3 +6,14 @@ resource "stackit_kms_key" "key" {
   algorithm    = "aes_256_gcm"
   purpose      = "symmetric_encrypt_decrypt"
 }
+
+# Key which is rotated yearly
+resource "stackit_kms_key" "rotated_key" {
+  project_id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  keyring_id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  display_name    = "key-02"
+  protection      = "software"
+  algorithm       = "aes_256_gcm"
+  purpose         = "symmetric_encrypt_decrypt"
+  rotation_period = "8760h"
+}
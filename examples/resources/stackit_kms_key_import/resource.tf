@@ -0,0 +1,34 @@
+variable "key_material" {
+  description = "Base64 encoded AES-256 key material"
+  type        = string
+  sensitive   = true
+  ephemeral   = true
+}
+
+resource "stackit_kms_key" "example" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  keyring_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  display_name = "imported-key"
+  protection   = "software"
+  algorithm    = "aes_256_gcm"
+  purpose      = "symmetric_encrypt_decrypt"
+  import_only  = true
+}
+
+resource "stackit_kms_wrapping_key" "example" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  keyring_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  display_name = "wrapping-key"
+  protection   = "software"
+  algorithm    = "rsa_2048_oaep_sha256"
+  purpose      = "wrap_symmetric_key"
+}
+
+resource "stackit_kms_key_import" "example" {
+  project_id                     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  keyring_id                     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  key_id                         = stackit_kms_key.example.key_id
+  wrapping_key_id                = stackit_kms_wrapping_key.example.wrapping_key_id
+  key_material_base64_wo         = var.key_material
+  key_material_base64_wo_version = 1
+}
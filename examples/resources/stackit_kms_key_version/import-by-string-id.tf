@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing key version
+import {
+  to = stackit_kms_key_version.import-example
+  id = "${var.project_id},${var.region},${var.keyring_id},${var.key_id},${var.version_number}"
+}
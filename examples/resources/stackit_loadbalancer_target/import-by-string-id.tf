@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing loadbalancer target
+import {
+  to = stackit_loadbalancer_target.import-example
+  id = "${var.project_id},${var.region},${var.load_balancer_name},${var.target_pool_name},${var.ip}"
+}
\ No newline at end of file
@@ -0,0 +1,39 @@
+# Restore the latest backup in place
+data "stackit_mongodbflex_backups" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+resource "stackit_mongodbflex_instance_restore" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  backup_id   = data.stackit_mongodbflex_backups.example.backups[length(data.stackit_mongodbflex_backups.example.backups) - 1].id
+}
+
+# Clone a point in time of an instance into a new instance
+resource "stackit_mongodbflex_instance" "clone" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "example-clone"
+  acl        = ["XXX.XXX.XXX.X/XX", "XX.XXX.XX.X/XX"]
+  flavor = {
+    cpu = 1
+    ram = 4
+  }
+  replicas = 1
+  storage = {
+    class = "premium-perf2-mongodb"
+    size  = 10
+  }
+  version = "7.0"
+  options = {
+    type = "Single"
+  }
+  backup_schedule = "0 0 * * *"
+}
+
+resource "stackit_mongodbflex_instance_restore" "clone" {
+  project_id         = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id        = stackit_mongodbflex_instance.clone.instance_id
+  source_instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  timestamp          = "2026-01-01T12:00:00Z"
+}
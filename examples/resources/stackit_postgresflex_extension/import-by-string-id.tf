@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing postgresflex extension
+import {
+  to = stackit_postgresflex_extension.import-example
+  id = "${var.project_id},${var.region},${var.postgres_instance_id},${var.postgres_extension_name}"
+}
@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import existing postgresflex instance settings
+import {
+  to = stackit_postgresflex_instance_settings.import-example
+  id = "${var.project_id},${var.region},${var.postgres_instance_id}"
+}
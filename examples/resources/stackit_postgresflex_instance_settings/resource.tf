@@ -0,0 +1,9 @@
+resource "stackit_postgresflex_instance_settings" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  parameters = {
+    max_connections            = "200"
+    work_mem                   = "8MB"
+    log_min_duration_statement = "500"
+  }
+}
@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing scf space
+import {
+  to = stackit_scf_space.import-example
+  id = "${var.project_id},${var.region},${var.space_id}"
+}
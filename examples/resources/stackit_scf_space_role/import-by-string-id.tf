@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing scf space role
+import {
+  to = stackit_scf_space_role.import-example
+  id = "${var.project_id},${var.region},${var.space_id},${var.role_id}"
+}
@@ -0,0 +1,25 @@
+resource "stackit_secretsmanager_user" "writer" {
+  project_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  description   = "Terraform writer"
+  write_enabled = true
+}
+
+resource "stackit_secretsmanager_secret" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  path        = "app/database"
+  username    = stackit_secretsmanager_user.writer.username
+  password    = stackit_secretsmanager_user.writer.password
+
+  data_wo = {
+    username = "app"
+    password = ephemeral.random_password.database.result
+  }
+  # increment to write a new version of the secret
+  data_wo_version = 1
+}
+
+ephemeral "random_password" "database" {
+  length = 32
+}
@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing server backup
+import {
+  to = stackit_server_backup.import-example
+  id = "${var.project_id},${var.region},${var.server_id},${var.server_backup_id}"
+}
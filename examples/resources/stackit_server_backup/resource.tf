@@ -0,0 +1,15 @@
+resource "stackit_server_backup" "example" {
+  project_id       = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  server_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name             = "example-backup"
+  retention_period = 14
+  volume_ids       = ["yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"]
+  depends_on = [
+    stackit_server_backup_enable.enable
+  ]
+}
+
+resource "stackit_server_backup_enable" "enable" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  server_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing snapshot
+import {
+  to = stackit_sfs_snapshot.example
+  id = "${var.project_id},${var.region},${var.resource_pool_id},${var.snapshot_name}"
+}
@@ -0,0 +1,6 @@
+resource "stackit_sfs_snapshot" "example" {
+  project_id       = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  resource_pool_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+  name             = "before-upgrade"
+  comment          = "Snapshot before the application upgrade"
+}
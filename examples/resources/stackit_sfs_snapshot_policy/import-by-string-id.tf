@@ -0,0 +1,5 @@
+# Only use the import statement, if you want to import an existing snapshot policy
+import {
+  to = stackit_sfs_snapshot_policy.example
+  id = "${var.project_id},${var.policy_id}"
+}
@@ -0,0 +1,25 @@
+resource "stackit_sfs_snapshot_policy" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "daily"
+  comment    = "Nightly snapshots, kept for a week"
+  snapshot_schedules = [
+    {
+      name             = "nightly"
+      interval         = "0 2 * * *"
+      prefix           = "nightly"
+      retention_period = "P7D"
+    }
+  ]
+}
+
+resource "stackit_sfs_resource_pool" "example" {
+  project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name              = "example-resource-pool"
+  availability_zone = "eu01-m"
+  performance_class = "Standard"
+  size_gigabytes    = 512
+  ip_acl            = ["192.168.2.0/24"]
+  snapshot_policy = {
+    id = stackit_sfs_snapshot_policy.example.policy_id
+  }
+}
@@ -6,3 +6,26 @@ resource "stackit_sqlserverflex_database" "example" {
   collation     = "SQL_Latin1_General_CP1_CI_AS"
   compatibility = 160
 }
+
+# Restore a database from an instance backup
+resource "stackit_sqlserverflex_database" "restored" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name        = "example-database-restored"
+  owner       = "example-user"
+  restore = {
+    backup_id            = 123
+    source_database_name = "example-database"
+  }
+}
+
+# Migrate a database from a .bak file in an object storage bucket
+resource "stackit_sqlserverflex_database" "migrated" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name        = "example-database-migrated"
+  owner       = "example-user"
+  restore = {
+    backup_url = "https://example-bucket.object.storage.eu01.onstackit.cloud/example-database.bak?X-Amz-Signature=xxx"
+  }
+}
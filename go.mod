@@ -52,6 +52,7 @@ require (
 	github.com/stackitcloud/stackit-sdk-go/services/vpn v0.15.0
 	github.com/teambition/rrule-go v1.8.2
 	go.uber.org/mock v0.6.0
+	golang.org/x/crypto v0.55.0
 	golang.org/x/mod v0.40.0
 )
 
@@ -100,7 +101,6 @@ require (
 	github.com/vmihailenco/msgpack/v5 v5.4.1 // indirect
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
 	github.com/zclconf/go-cty v1.18.1 // indirect
-	golang.org/x/crypto v0.55.0 // indirect
 	golang.org/x/net v0.58.0 // indirect
 	golang.org/x/sync v0.22.0 // indirect
 	golang.org/x/sys v0.47.0 // indirect
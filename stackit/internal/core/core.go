@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ const (
 	Resource          ResourceType = "resource"
 	Datasource        ResourceType = "datasource"
 	EphemeralResource ResourceType = "ephemeral-resource"
+	Action            ResourceType = "action"
 
 	// Separator used for concatenation of TF-internal resource ID
 	Separator = ","
@@ -97,6 +99,23 @@ func (pd *ProviderData) GetRegionWithOverride(overrideRegion types.String) strin
 	return overrideRegion.ValueString()
 }
 
+// GetServiceAccountEmail returns the email of the service account the provider authenticates with. It is taken from the
+// provider configuration, the STACKIT_SERVICE_ACCOUNT_EMAIL environment variable or the service account key of the key flow.
+// It is empty if the email can't be determined, e.g. for the token flow.
+func GetServiceAccountEmail(configured types.String, roundTripper http.RoundTripper) string {
+	if !configured.IsNull() && !configured.IsUnknown() && configured.ValueString() != "" {
+		return configured.ValueString()
+	}
+	if email := os.Getenv("STACKIT_SERVICE_ACCOUNT_EMAIL"); email != "" {
+		return email
+	}
+	// the key flow of the SDK reads the email from the service account key
+	if keyFlow, ok := roundTripper.(interface{ GetServiceAccountEmail() string }); ok {
+		return keyFlow.GetServiceAccountEmail()
+	}
+	return ""
+}
+
 // DiagsToError Converts TF diagnostics' errors into an error with a human-readable description.
 // If there are no errors, the output is nil
 func DiagsToError(diags diag.Diagnostics) error {
@@ -1,9 +1,11 @@
 package core
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 )
 
 func TestProviderData_GetRegionWithOverride(t *testing.T) {
@@ -83,3 +85,60 @@ func TestProviderData_GetRegion(t *testing.T) {
 		})
 	}
 }
+
+// the key flow of the SDK must provide the email of the service account key
+var _ interface{ GetServiceAccountEmail() string } = &clients.KeyFlow{}
+
+type fakeKeyFlow struct {
+	http.RoundTripper
+	email string
+}
+
+func (f *fakeKeyFlow) GetServiceAccountEmail() string {
+	return f.email
+}
+
+func TestGetServiceAccountEmail(t *testing.T) {
+	tests := []struct {
+		name         string
+		configured   types.String
+		envValue     string
+		roundTripper http.RoundTripper
+		want         string
+	}{
+		{
+			name:         "configured",
+			configured:   types.StringValue("configured@sa.stackit.cloud"),
+			envValue:     "env@sa.stackit.cloud",
+			roundTripper: &fakeKeyFlow{email: "key@sa.stackit.cloud"},
+			want:         "configured@sa.stackit.cloud",
+		},
+		{
+			name:         "environment variable",
+			configured:   types.StringNull(),
+			envValue:     "env@sa.stackit.cloud",
+			roundTripper: &fakeKeyFlow{email: "key@sa.stackit.cloud"},
+			want:         "env@sa.stackit.cloud",
+		},
+		{
+			name:         "service account key",
+			configured:   types.StringNull(),
+			roundTripper: &fakeKeyFlow{email: "key@sa.stackit.cloud"},
+			want:         "key@sa.stackit.cloud",
+		},
+		{
+			name:         "token flow",
+			configured:   types.StringNull(),
+			roundTripper: http.DefaultTransport,
+			want:         "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("STACKIT_SERVICE_ACCOUNT_EMAIL", tt.envValue)
+			if got := GetServiceAccountEmail(tt.configured, tt.roundTripper); got != tt.want {
+				t.Errorf("GetServiceAccountEmail() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
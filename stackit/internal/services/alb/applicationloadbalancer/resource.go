@@ -462,7 +462,7 @@ func (r *applicationLoadBalancerResource) Schema(_ context.Context, _ resource.S
 		"unhealthy_threshold":                    "Unhealthy threshold of the health checking.",
 		"target_pools.name":                      "Target pool name.",
 		"target_port":                            "The number identifying the port where each target listens for traffic.",
-		"targets":                                "List of all targets which will be used in the pool. Limited to 250.",
+		"targets":                                "List of all targets which will be used in the pool. Limited to 250. If not set, the targets of the pool are not managed by this resource, e.g. to manage them with `stackit_application_load_balancer_target` resources.",
 		"targets.display_name":                   "Target display name",
 		"ip":                                     "Private target IP, which must by unique within a target pool.",
 		"tls_config":                             "Configuration for TLS bridging.",
@@ -1056,7 +1056,7 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 						},
 						"targets": schema.SetNestedAttribute{
 							Description: descriptions["targets"],
-							Required:    true,
+							Optional:    true,
 							Validators: []validator.Set{
 								setvalidator.SizeBetween(1, 250),
 							},
@@ -1289,8 +1289,25 @@ func (r *applicationLoadBalancerResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	// the targets of these pools are managed outside of this resource and must be kept. The update is sent with the
+	// version that was read, so concurrent changes of the targets are rejected. The lock avoids such conflicts with the
+	// target resources of this provider process.
+	externalTargetPools := externalTargetPoolNames(payload)
+	unlock := albUtils.LockTargetPools(projectId, region, name, externalTargetPools...)
+	if len(externalTargetPools) > 0 {
+		currentLoadBalancer, err := r.client.DefaultAPI.GetLoadBalancer(ctx, projectId, region, name).Execute()
+		if err != nil {
+			unlock()
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Application Load Balancer", fmt.Sprintf("Reading current targets: %v", err))
+			return
+		}
+		keepExternalTargets(payload, currentLoadBalancer)
+	}
+
 	// Update target pool
 	updateResp, err := r.client.DefaultAPI.UpdateLoadBalancer(ctx, projectId, region, name).UpdateLoadBalancerPayload(*payload).Execute()
+	// the lock isn't held while waiting, since the target pools were written with the update
+	unlock()
 	if err != nil {
 		errStr := utils.PrettyApiErr(ctx, &resp.Diagnostics, err)
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Application Load Balancer", fmt.Sprintf("Calling API for update: %v", errStr))
@@ -1984,6 +2001,41 @@ func toHttpHealthChecksPayload(ctx context.Context, check *activeHealthCheck) (*
 	return &payload, nil
 }
 
+// externalTargetPoolNames returns the names of the target pools of the payload without targets, their targets are
+// managed outside of the resource.
+func externalTargetPoolNames(payload *albSdk.UpdateLoadBalancerPayload) []string {
+	names := []string{}
+	if payload == nil {
+		return names
+	}
+	for i := range payload.TargetPools {
+		if payload.TargetPools[i].Targets == nil {
+			names = append(names, payload.TargetPools[i].GetName())
+		}
+	}
+	return names
+}
+
+// keepExternalTargets copies the current targets into all target pools of the payload without targets. The payload
+// gets the version of the current load balancer, so the update is rejected if the targets were changed since they were read.
+func keepExternalTargets(payload *albSdk.UpdateLoadBalancerPayload, current *albSdk.LoadBalancer) {
+	if payload == nil || current == nil {
+		return
+	}
+	payload.Version = current.Version
+	for i := range payload.TargetPools {
+		if payload.TargetPools[i].Targets != nil {
+			continue
+		}
+		for _, currentTargetPool := range current.TargetPools {
+			if currentTargetPool.GetName() == payload.TargetPools[i].GetName() {
+				payload.TargetPools[i].Targets = currentTargetPool.Targets
+				break
+			}
+		}
+	}
+}
+
 func toTargetsPayload(ctx context.Context, tp *targetPool) ([]albSdk.Target, error) {
 	if utils.IsUndefined(tp.Targets) {
 		return nil, nil
@@ -2743,9 +2795,14 @@ func mapTargetPools(ctx context.Context, applicationLoadBalancerResp *albSdk.Loa
 			return fmt.Errorf("mapping index %d, field TLSConfig: %w", i, err)
 		}
 
-		err = mapTargets(targetPoolResp.Targets, targetPoolMap)
-		if err != nil {
-			return fmt.Errorf("mapping index %d, field Targets: %w", i, err)
+		// targets of pools without targets in the model are managed outside of this resource
+		if configMatch != nil && configMatch.Targets.IsNull() {
+			targetPoolMap["targets"] = types.SetNull(types.ObjectType{AttrTypes: targetTypes})
+		} else {
+			err = mapTargets(targetPoolResp.Targets, targetPoolMap)
+			if err != nil {
+				return fmt.Errorf("mapping index %d, field Targets: %w", i, err)
+			}
 		}
 
 		targetPoolTF, diags := types.ObjectValue(targetPoolTypes, targetPoolMap)
@@ -1676,3 +1676,58 @@ func Test_toPathPayload(t *testing.T) {
 		})
 	}
 }
+
+func Test_keepExternalTargets(t *testing.T) {
+	current := &albSdk.LoadBalancer{
+		Version: new("2"),
+		TargetPools: []albSdk.TargetPool{
+			{
+				Name:    new("external"),
+				Targets: []albSdk.Target{{DisplayName: new("server-1"), Ip: new("10.0.0.1")}},
+			},
+			{
+				Name:    new("inline"),
+				Targets: []albSdk.Target{{DisplayName: new("server-2"), Ip: new("10.0.0.2")}},
+			},
+		},
+	}
+	payload := &albSdk.UpdateLoadBalancerPayload{
+		Version: new("1"),
+		TargetPools: []albSdk.TargetPool{
+			{
+				Name: new("external"),
+			},
+			{
+				Name:    new("inline"),
+				Targets: []albSdk.Target{{DisplayName: new("server-3"), Ip: new("10.0.0.3")}},
+			},
+			{
+				Name: new("new"),
+			},
+		},
+	}
+	expected := &albSdk.UpdateLoadBalancerPayload{
+		Version: new("2"),
+		TargetPools: []albSdk.TargetPool{
+			{
+				Name:    new("external"),
+				Targets: []albSdk.Target{{DisplayName: new("server-1"), Ip: new("10.0.0.1")}},
+			},
+			{
+				Name:    new("inline"),
+				Targets: []albSdk.Target{{DisplayName: new("server-3"), Ip: new("10.0.0.3")}},
+			},
+			{
+				Name: new("new"),
+			},
+		},
+	}
+
+	if diff := cmp.Diff([]string{"external", "new"}, externalTargetPoolNames(payload)); diff != "" {
+		t.Fatalf("External target pools do not match: %s", diff)
+	}
+	keepExternalTargets(payload, current)
+	if diff := cmp.Diff(expected, payload); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
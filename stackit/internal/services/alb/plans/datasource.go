@@ -0,0 +1,44 @@
+package alb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	albSdk "github.com/stackitcloud/stackit-sdk-go/services/alb/v2api"
+
+	albUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/alb/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/loadbalancing"
+)
+
+// NewPlansDataSource is a helper function to simplify the provider implementation.
+func NewPlansDataSource() datasource.DataSource {
+	return &loadbalancing.PlansDataSource[albSdk.APIClient]{
+		Product:          albUtils.Product,
+		ApiClientFactory: albUtils.ConfigureClient,
+		ExecListPlans: func(ctx context.Context, client *albSdk.APIClient, region string) ([]loadbalancing.Plan, error) {
+			plansResp, err := client.DefaultAPI.ListPlans(ctx, region).Execute()
+			if err != nil {
+				return nil, err
+			}
+			return toPlans(plansResp)
+		},
+	}
+}
+
+func toPlans(plansResp *albSdk.ListPlansResponse) ([]loadbalancing.Plan, error) {
+	if plansResp == nil {
+		return nil, fmt.Errorf("response input is nil")
+	}
+	plans := []loadbalancing.Plan{}
+	for i := range plansResp.ValidPlans {
+		plans = append(plans, loadbalancing.Plan{
+			PlanId:         plansResp.ValidPlans[i].PlanId,
+			Name:           plansResp.ValidPlans[i].Name,
+			Description:    plansResp.ValidPlans[i].Description,
+			FlavorName:     plansResp.ValidPlans[i].FlavorName,
+			MaxConnections: plansResp.ValidPlans[i].MaxConnections,
+		})
+	}
+	return plans, nil
+}
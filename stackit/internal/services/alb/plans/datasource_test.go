@@ -0,0 +1,79 @@
+package alb
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	albSdk "github.com/stackitcloud/stackit-sdk-go/services/alb/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/loadbalancing"
+)
+
+func TestToPlans(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *albSdk.ListPlansResponse
+		expected    []loadbalancing.Plan
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&albSdk.ListPlansResponse{},
+			[]loadbalancing.Plan{},
+			true,
+		},
+		{
+			"simple_values",
+			&albSdk.ListPlansResponse{
+				ValidPlans: []albSdk.PlanDetails{
+					{
+						PlanId:         new("p10"),
+						Name:           new("Application-Loadbalancer-Starter"),
+						Description:    new("starter"),
+						FlavorName:     new("c1.2"),
+						MaxConnections: new(int32(10000)),
+					},
+					{
+						PlanId: new("p50"),
+					},
+				},
+			},
+			[]loadbalancing.Plan{
+				{
+					PlanId:         new("p10"),
+					Name:           new("Application-Loadbalancer-Starter"),
+					Description:    new("starter"),
+					FlavorName:     new("c1.2"),
+					MaxConnections: new(int32(10000)),
+				},
+				{
+					PlanId: new("p50"),
+				},
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toPlans(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package alb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	albSdk "github.com/stackitcloud/stackit-sdk-go/services/alb/v2api"
+
+	albUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/alb/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/loadbalancing"
+)
+
+// NewQuotasDataSource is a helper function to simplify the provider implementation.
+func NewQuotasDataSource() datasource.DataSource {
+	return &loadbalancing.QuotasDataSource[albSdk.APIClient]{
+		Product:          albUtils.Product,
+		ApiClientFactory: albUtils.ConfigureClient,
+		ExecGetQuota: func(ctx context.Context, client *albSdk.APIClient, projectId, region string) (*loadbalancing.Quota, error) {
+			quotaResp, err := client.DefaultAPI.GetQuota(ctx, projectId, region).Execute()
+			if err != nil {
+				return nil, err
+			}
+			return toQuota(quotaResp)
+		},
+	}
+}
+
+func toQuota(quotaResp *albSdk.GetQuotaResponse) (*loadbalancing.Quota, error) {
+	if quotaResp == nil {
+		return nil, fmt.Errorf("response input is nil")
+	}
+	return &loadbalancing.Quota{
+		MaxLoadBalancers:  quotaResp.MaxLoadBalancers,
+		UsedLoadBalancers: quotaResp.UsedLoadBalancers,
+		MaxCredentials:    quotaResp.MaxCredentials,
+		UsedCredentials:   quotaResp.UsedCredentials,
+	}, nil
+}
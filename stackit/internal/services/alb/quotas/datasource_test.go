@@ -0,0 +1,65 @@
+package alb
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	albSdk "github.com/stackitcloud/stackit-sdk-go/services/alb/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/loadbalancing"
+)
+
+func TestToQuota(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *albSdk.GetQuotaResponse
+		expected    *loadbalancing.Quota
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&albSdk.GetQuotaResponse{},
+			&loadbalancing.Quota{},
+			true,
+		},
+		{
+			"simple_values",
+			&albSdk.GetQuotaResponse{
+				MaxLoadBalancers:  new(int32(10)),
+				UsedLoadBalancers: new(int32(3)),
+				MaxCredentials:    new(int32(20)),
+				UsedCredentials:   new(int32(1)),
+			},
+			&loadbalancing.Quota{
+				MaxLoadBalancers:  new(int32(10)),
+				UsedLoadBalancers: new(int32(3)),
+				MaxCredentials:    new(int32(20)),
+				UsedCredentials:   new(int32(1)),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toQuota(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
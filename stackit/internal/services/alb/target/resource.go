@@ -0,0 +1,138 @@
+package alb
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	albSdk "github.com/stackitcloud/stackit-sdk-go/services/alb/v2api"
+
+	albUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/alb/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/targetpool"
+)
+
+// NewTargetResource is a helper function to simplify the provider implementation.
+func NewTargetResource() resource.Resource {
+	return &targetpool.TargetResource[albSdk.APIClient]{
+		TypeName:             "application_load_balancer_target",
+		ProductName:          "Application Load Balancer",
+		LoadBalancerResource: "stackit_application_load_balancer",
+		DisplayNameOptional:  true,
+		DisplayNameValidator: stringvalidator.RegexMatches(
+			regexp.MustCompile(`^[0-9a-z](?:(?:[0-9a-z]|-){0,61}[0-9a-z])?$`),
+			"1-63 characters [0-9] & [a-z] also [-] but not at the beginning or end",
+		),
+		ApiClientFactory: albUtils.ConfigureClient,
+		LockTargetPool:   albUtils.LockTargetPools,
+		NewTargetPoolAPI: func(client *albSdk.APIClient, projectId, region, loadBalancerName, targetPoolName string) targetpool.TargetPoolAPI {
+			return &targetPoolAPI{
+				client:           client.DefaultAPI,
+				projectId:        projectId,
+				region:           region,
+				loadBalancerName: loadBalancerName,
+				targetPoolName:   targetPoolName,
+			}
+		},
+	}
+}
+
+// targetPoolAPI reads and writes the targets of a target pool with the Application Load Balancer API.
+type targetPoolAPI struct {
+	client           albSdk.DefaultAPI
+	projectId        string
+	region           string
+	loadBalancerName string
+	targetPoolName   string
+
+	// loadBalancer is the load balancer read last, its other settings and its version are kept when the targets are written
+	loadBalancer *albSdk.LoadBalancer
+}
+
+func (a *targetPoolAPI) GetTargets(ctx context.Context) ([]targetpool.Target, error) {
+	lbResp, err := a.client.GetLoadBalancer(ctx, a.projectId, a.region, a.loadBalancerName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("reading Application Load Balancer: %w", err)
+	}
+	targetPool := findTargetPool(lbResp, a.targetPoolName)
+	if targetPool == nil {
+		return nil, fmt.Errorf("Application Load Balancer %q: %w: %q", a.loadBalancerName, targetpool.ErrTargetPoolNotFound, a.targetPoolName)
+	}
+	a.loadBalancer = lbResp
+	return toTargets(targetPool.Targets), nil
+}
+
+// SetTargets updates the whole load balancer instead of only the target pool, since only the load balancer update
+// is sent with a version, which makes the API reject it if the load balancer was changed concurrently.
+func (a *targetPoolAPI) SetTargets(ctx context.Context, targets []targetpool.Target) error {
+	if a.loadBalancer == nil {
+		return fmt.Errorf("Application Load Balancer %q wasn't read before it is updated", a.loadBalancerName)
+	}
+	payload := toUpdateLoadBalancerPayload(a.loadBalancer, a.targetPoolName, toTargetsPayload(targets))
+	_, err := a.client.UpdateLoadBalancer(ctx, a.projectId, a.region, a.loadBalancerName).UpdateLoadBalancerPayload(payload).Execute()
+	return err
+}
+
+func findTargetPool(lb *albSdk.LoadBalancer, targetPoolName string) *albSdk.TargetPool {
+	if lb == nil {
+		return nil
+	}
+	for i := range lb.TargetPools {
+		if lb.TargetPools[i].GetName() == targetPoolName {
+			return &lb.TargetPools[i]
+		}
+	}
+	return nil
+}
+
+// toUpdateLoadBalancerPayload keeps all settings and the version of the load balancer and only replaces the targets
+// of the given target pool.
+func toUpdateLoadBalancerPayload(lb *albSdk.LoadBalancer, targetPoolName string, targets []albSdk.Target) albSdk.UpdateLoadBalancerPayload {
+	targetPools := slices.Clone(lb.TargetPools)
+	for i := range targetPools {
+		if targetPools[i].GetName() == targetPoolName {
+			targetPools[i].Targets = targets
+		}
+	}
+	// the external address must not be sent for ephemeral or private load balancers, like in the load balancer resource
+	externalAddress := lb.ExternalAddress
+	if lb.Options.GetEphemeralAddress() || lb.Options.GetPrivateNetworkOnly() {
+		externalAddress = nil
+	}
+	return albSdk.UpdateLoadBalancerPayload{
+		DisableTargetSecurityGroupAssignment: lb.DisableTargetSecurityGroupAssignment,
+		ExternalAddress:                      externalAddress,
+		Labels:                               lb.Labels,
+		Listeners:                            lb.Listeners,
+		Name:                                 lb.Name,
+		Networks:                             lb.Networks,
+		Options:                              lb.Options,
+		PlanId:                               lb.PlanId,
+		TargetPools:                          targetPools,
+		Version:                              lb.Version,
+	}
+}
+
+func toTargets(targets []albSdk.Target) []targetpool.Target {
+	result := []targetpool.Target{}
+	for i := range targets {
+		result = append(result, targetpool.Target{
+			Ip:          targets[i].GetIp(),
+			DisplayName: targets[i].DisplayName,
+		})
+	}
+	return result
+}
+
+func toTargetsPayload(targets []targetpool.Target) []albSdk.Target {
+	result := []albSdk.Target{}
+	for i := range targets {
+		result = append(result, albSdk.Target{
+			DisplayName: targets[i].DisplayName,
+			Ip:          new(targets[i].Ip),
+		})
+	}
+	return result
+}
@@ -0,0 +1,603 @@
+package acmecertificate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	certSdk "github.com/stackitcloud/stackit-sdk-go/services/certificates/v2api"
+	dns "github.com/stackitcloud/stackit-sdk-go/services/dns/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/albcertificates/issuer"
+	certUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/albcertificates/utils"
+	dnsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+const (
+	defaultRenewBeforeDays = 30
+	// propagationDelay gives the secondary name servers of the zone time to pick up the challenge records
+	propagationDelay = 10 * time.Second
+	// the certificate name gets a suffix of this length, so the name of the API is limited to 63 characters
+	nameSuffixLength = 8
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &acmeCertificateResource{}
+	_ resource.ResourceWithConfigure  = &acmeCertificateResource{}
+	_ resource.ResourceWithModifyPlan = &acmeCertificateResource{}
+)
+
+type Model struct {
+	Id               types.String `tfsdk:"id"` // needed by TF
+	ProjectId        types.String `tfsdk:"project_id"`
+	Region           types.String `tfsdk:"region"`
+	Name             types.String `tfsdk:"name"`
+	Domains          types.List   `tfsdk:"domains"`
+	DnsZoneId        types.String `tfsdk:"dns_zone_id"`
+	KeyType          types.String `tfsdk:"key_type"`
+	AcmeDirectoryUrl types.String `tfsdk:"acme_directory_url"`
+	AcmeEmail        types.String `tfsdk:"acme_email"`
+	AcmeAccountKey   types.String `tfsdk:"acme_account_key_wo"`
+	RenewBeforeDays  types.Int64  `tfsdk:"renew_before_days"`
+	CertID           types.String `tfsdk:"cert_id"`
+	CertificateName  types.String `tfsdk:"certificate_name"`
+	PublicKey        types.String `tfsdk:"public_key"`
+	NotAfter         types.String `tfsdk:"not_after"`
+}
+
+// NewACMECertificateResource is a helper function to simplify the provider implementation.
+func NewACMECertificateResource() resource.Resource {
+	return &acmeCertificateResource{}
+}
+
+// acmeCertificateResource is the resource implementation.
+type acmeCertificateResource struct {
+	client       *certSdk.APIClient
+	dnsClient    *dns.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *acmeCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alb_acme_certificate"
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan and to replace the certificate if it is due for renewal.
+func (r *acmeCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !configModel.AcmeAccountKey.IsUnknown() && !configModel.AcmeAccountKey.IsNull() {
+		if _, err := issuer.ParseAccountKey(configModel.AcmeAccountKey.ValueString()); err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error planning ACME certificate", fmt.Sprintf("Parsing ACME account key: %v", err))
+			return
+		}
+	}
+
+	// the certificate is renewed by replacing it, as certificates of the API can't be updated
+	if !req.State.Raw.IsNull() {
+		var stateModel Model
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		renew, err := needsRenewal(stateModel.NotAfter, planModel.RenewBeforeDays, time.Now())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error planning ACME certificate", fmt.Sprintf("Checking renewal: %v", err))
+			return
+		}
+		if renew {
+			tflog.Info(ctx, "ACME certificate is due for renewal", map[string]any{"not_after": stateModel.NotAfter.ValueString()})
+			planModel.Id = types.StringUnknown()
+			planModel.CertID = types.StringUnknown()
+			planModel.CertificateName = types.StringUnknown()
+			planModel.PublicKey = types.StringUnknown()
+			planModel.NotAfter = types.StringUnknown()
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("not_after"))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *acmeCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &r.providerData, &resp.Diagnostics, "stackit_alb_acme_certificate", core.Resource)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := certUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	dnsClient := dnsUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	r.dnsClient = dnsClient
+	tflog.Info(ctx, "Certificate client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *acmeCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "ACME certificate resource schema. Issues a certificate from an ACME CA like Let's Encrypt and stores it as certificate for the Application Load Balancer. " +
+			"The domains are validated with DNS-01 challenges, for which temporary TXT records are created in a STACKIT DNS zone. " +
+			"The certificate is replaced by a new one when a plan is created less than `renew_before_days` before it expires, so Terraform must run regularly to keep it valid. " +
+			"Use `create_before_destroy` in a `lifecycle` block to keep the load balancer serving a valid certificate during the renewal.",
+		"id":                 "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`cert_id`\".",
+		"project_id":         "STACKIT project ID to which the certificate is associated.",
+		"region":             "The resource region (e.g. eu01). If not defined, the provider region is used.",
+		"name":               "Name prefix of the certificate. A suffix derived from the serial number of the certificate is appended, so a renewed certificate can exist next to the old one.",
+		"domains":            "Domains the certificate is issued for. The first domain is used as common name. Wildcard domains like `*.example.com` are supported.",
+		"dns_zone_id":        "ID of the STACKIT DNS zone in the same project, in which the TXT records of the DNS-01 challenges are created. All domains must belong to this zone.",
+		"key_type":           fmt.Sprintf("Type of the private key of the certificate. Defaults to `%s`. %s", issuer.KeyTypeECP256, utils.FormatPossibleValues(issuer.KeyTypes...)),
+		"acme_directory_url": fmt.Sprintf("Directory URL of the ACME CA. Defaults to the Let's Encrypt production environment `%s`. Changing it only takes effect for the next renewal.", issuer.LetsEncryptDirectoryURL),
+		"acme_email":         "Email address registered as contact of the ACME account, e.g. to receive expiry notifications.",
+		"acme_account_key_wo": "PEM encoded ECDSA or RSA private key of the ACME account, e.g. the `private_key_pem` of a `tls_private_key`. " +
+			"The account is registered with the first order and reused by all renewals, so keep the key unchanged. Write-only - never stored in state.",
+		"renew_before_days": fmt.Sprintf("Number of days before the expiry of the certificate from which on it is renewed. Defaults to `%d`.", defaultRenewBeforeDays),
+		"cert_id":           "The ID of the certificate.",
+		"certificate_name":  "The name of the certificate in the API.",
+		"public_key":        "The PEM encoded certificate chain.",
+		"not_after":         "Expiry of the certificate in RFC3339 format.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         descriptions["main"],
+		MarkdownDescription: features.AddBetaDescription(fmt.Sprintf("%s\n\n-> **Note:** `acme_account_key_wo` is a write-only argument, which is supported in HashiCorp Terraform 1.11.0 and later. [Learn more](https://developer.hashicorp.com/terraform/language/resources/ephemeral#write-only-arguments).", descriptions["main"]), core.Resource),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: descriptions["region"],
+				Optional:    true,
+				// must be computed to allow for storing the override value from the provider
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: descriptions["name"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[0-9a-z](?:(?:[0-9a-z]|-){0,52}[0-9a-z])?$`),
+						"1-54 characters [0-9] & [a-z] also [-] but not at the beginning or end",
+					),
+				},
+			},
+			"domains": schema.ListAttribute{
+				Description: descriptions["domains"],
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+			"dns_zone_id": schema.StringAttribute{
+				Description: descriptions["dns_zone_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"key_type": schema.StringAttribute{
+				Description: descriptions["key_type"],
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(issuer.KeyTypeECP256),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(issuer.KeyTypes...),
+				},
+			},
+			"acme_directory_url": schema.StringAttribute{
+				Description: descriptions["acme_directory_url"],
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(issuer.LetsEncryptDirectoryURL),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an HTTP(S) URL"),
+				},
+			},
+			"acme_email": schema.StringAttribute{
+				Description: descriptions["acme_email"],
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"acme_account_key_wo": schema.StringAttribute{
+				Description: descriptions["acme_account_key_wo"],
+				Required:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+			},
+			"renew_before_days": schema.Int64Attribute{
+				Description: descriptions["renew_before_days"],
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultRenewBeforeDays),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"cert_id": schema.StringAttribute{
+				Description: descriptions["cert_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_name": schema.StringAttribute{
+				Description: descriptions["certificate_name"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: descriptions["public_key"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_after": schema.StringAttribute{
+				Description: descriptions["not_after"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create issues the certificate and sets the initial Terraform state.
+func (r *acmeCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	var domains []string
+	resp.Diagnostics.Append(model.Domains.ElementsAs(ctx, &domains, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the account key is write-only, so it is only part of the configuration
+	var configModel Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	accountKey, err := issuer.ParseAccountKey(configModel.AcmeAccountKey.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating ACME certificate", fmt.Sprintf("Parsing ACME account key: %v", err))
+		return
+	}
+
+	acmeIssuer := &issuer.Issuer{
+		DirectoryURL:     model.AcmeDirectoryUrl.ValueString(),
+		AccountKey:       accountKey,
+		Email:            model.AcmeEmail.ValueString(),
+		Solver:           issuer.NewDNSSolver(r.dnsClient.DefaultAPI, projectId, model.DnsZoneId.ValueString()),
+		PropagationDelay: propagationDelay,
+	}
+	cert, err := acmeIssuer.Issue(ctx, domains, model.KeyType.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating ACME certificate", fmt.Sprintf("Issuing certificate: %v", err))
+		return
+	}
+
+	payload := toCreatePayload(model.Name.ValueString(), cert)
+	createResp, err := r.client.DefaultAPI.CreateCertificate(ctx, projectId, region).CreateCertificatePayload(*payload).Execute()
+	if err != nil {
+		errStr := utils.PrettyApiErr(ctx, &resp.Diagnostics, err)
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating ACME certificate", fmt.Sprintf("Calling API for create: %v", errStr))
+		return
+	}
+	ctx = core.LogResponse(ctx)
+
+	if createResp == nil || createResp.Id == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating ACME certificate", "API response has no certificate ID")
+		return
+	}
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]interface{}{
+		"project_id": projectId,
+		"cert_id":    *createResp.Id,
+		"region":     region,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(createResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating ACME certificate", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "ACME certificate created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *acmeCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	certId := model.CertID.ValueString()
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "cert_id", certId)
+
+	readResp, err := r.client.DefaultAPI.GetCertificate(ctx, projectId, region, certId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) {
+			if oapiErr.StatusCode == http.StatusNotFound {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+		errStr := utils.PrettyApiErr(ctx, &resp.Diagnostics, err)
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading ACME certificate", fmt.Sprintf("Calling API: %v", errStr))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Map response body to schema
+	err = mapFields(readResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading ACME certificate", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "ACME certificate read")
+}
+
+// Update stores the changed settings, which only take effect for the next renewal.
+// All attributes describing the certificate itself require a replacement.
+func (r *acmeCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var stateModel Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	model.Id = stateModel.Id
+	model.CertID = stateModel.CertID
+	model.CertificateName = stateModel.CertificateName
+	model.PublicKey = stateModel.PublicKey
+	model.NotAfter = stateModel.NotAfter
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "ACME certificate updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *acmeCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	certId := model.CertID.ValueString()
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "cert_id", certId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	_, err := r.client.DefaultAPI.DeleteCertificate(ctx, projectId, region, certId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "ACME certificate already deleted")
+			return
+		}
+		errStr := utils.PrettyApiErr(ctx, &resp.Diagnostics, err)
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting ACME certificate", fmt.Sprintf("Calling API for delete: %v", errStr))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	tflog.Info(ctx, "ACME certificate deleted")
+}
+
+// needsRenewal reports whether the certificate expires within the renewal period.
+func needsRenewal(notAfter types.String, renewBeforeDays types.Int64, now time.Time) (bool, error) {
+	if notAfter.IsNull() || notAfter.IsUnknown() || renewBeforeDays.IsUnknown() {
+		return false, nil
+	}
+	expiry, err := time.Parse(time.RFC3339, notAfter.ValueString())
+	if err != nil {
+		return false, fmt.Errorf("parsing not_after %q: %w", notAfter.ValueString(), err)
+	}
+	days := renewBeforeDays.ValueInt64()
+	if renewBeforeDays.IsNull() {
+		days = defaultRenewBeforeDays
+	}
+	return !now.AddDate(0, 0, int(days)).Before(expiry), nil
+}
+
+// certificateName appends the end of the serial number to the name prefix, so the names of renewed certificates differ.
+func certificateName(prefix, serialNumber string) string {
+	if len(serialNumber) > nameSuffixLength {
+		serialNumber = serialNumber[len(serialNumber)-nameSuffixLength:]
+	}
+	return prefix + "-" + serialNumber
+}
+
+func toCreatePayload(name string, cert *issuer.Certificate) *certSdk.CreateCertificatePayload {
+	return &certSdk.CreateCertificatePayload{
+		Name:       new(certificateName(name, cert.SerialNumber)),
+		PrivateKey: new(cert.PrivateKeyPEM),
+		PublicKey:  new(cert.CertificatePEM),
+	}
+}
+
+func mapFields(cert *certSdk.GetCertificateResponse, m *Model, region string) error {
+	if cert == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if m == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var certID string
+	if m.CertID.ValueString() != "" {
+		certID = m.CertID.ValueString()
+	} else if cert.Id != nil {
+		certID = *cert.Id
+	} else {
+		return fmt.Errorf("cert ID not present")
+	}
+	m.Region = types.StringValue(region)
+	m.CertID = types.StringValue(certID)
+	m.Id = utils.BuildInternalTerraformId(m.ProjectId.ValueString(), region, certID)
+	m.CertificateName = types.StringPointerValue(cert.Name)
+	m.PublicKey = types.StringPointerValue(cert.PublicKey)
+
+	m.NotAfter = types.StringNull()
+	if cert.PublicKey != nil {
+		notAfter, err := issuer.ParseNotAfter(*cert.PublicKey)
+		if err != nil {
+			return fmt.Errorf("parsing certificate: %w", err)
+		}
+		m.NotAfter = types.StringValue(notAfter.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
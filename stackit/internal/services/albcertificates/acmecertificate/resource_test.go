@@ -0,0 +1,270 @@
+package acmecertificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	certSdk "github.com/stackitcloud/stackit-sdk-go/services/certificates/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/albcertificates/issuer"
+)
+
+const (
+	projectID = "b8c3fbaa-3ab4-4a8e-9584-de22453d046f"
+	region    = "eu01"
+	certID    = "example-cert-v1"
+	tfID      = projectID + "," + region + "," + certID
+)
+
+func testCertificatePEM(t *testing.T, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     []string{"example.com"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestNeedsRenewal(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description     string
+		notAfter        types.String
+		renewBeforeDays types.Int64
+		expected        bool
+		isValid         bool
+	}{
+		{
+			description:     "not due",
+			notAfter:        types.StringValue("2026-03-01T00:00:00Z"),
+			renewBeforeDays: types.Int64Value(30),
+			expected:        false,
+			isValid:         true,
+		},
+		{
+			description:     "due",
+			notAfter:        types.StringValue("2026-01-20T00:00:00Z"),
+			renewBeforeDays: types.Int64Value(30),
+			expected:        true,
+			isValid:         true,
+		},
+		{
+			description:     "exactly at threshold",
+			notAfter:        types.StringValue("2026-01-31T00:00:00Z"),
+			renewBeforeDays: types.Int64Value(30),
+			expected:        true,
+			isValid:         true,
+		},
+		{
+			description:     "expired",
+			notAfter:        types.StringValue("2025-12-01T00:00:00Z"),
+			renewBeforeDays: types.Int64Value(1),
+			expected:        true,
+			isValid:         true,
+		},
+		{
+			description:     "default renewal period",
+			notAfter:        types.StringValue("2026-01-20T00:00:00Z"),
+			renewBeforeDays: types.Int64Null(),
+			expected:        true,
+			isValid:         true,
+		},
+		{
+			description:     "unknown renewal period",
+			notAfter:        types.StringValue("2026-01-20T00:00:00Z"),
+			renewBeforeDays: types.Int64Unknown(),
+			expected:        false,
+			isValid:         true,
+		},
+		{
+			description:     "no expiry",
+			notAfter:        types.StringNull(),
+			renewBeforeDays: types.Int64Value(30),
+			expected:        false,
+			isValid:         true,
+		},
+		{
+			description:     "invalid expiry",
+			notAfter:        types.StringValue("tomorrow"),
+			renewBeforeDays: types.Int64Value(30),
+			isValid:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := needsRenewal(tt.notAfter, tt.renewBeforeDays, now)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if output != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		name        string
+		cert        *issuer.Certificate
+		expected    *certSdk.CreateCertificatePayload
+	}{
+		{
+			description: "long serial number",
+			name:        "example",
+			cert: &issuer.Certificate{
+				CertificatePEM: "public",
+				PrivateKeyPEM:  "private",
+				SerialNumber:   "4f1c2a9b8e7d6c5b",
+			},
+			expected: &certSdk.CreateCertificatePayload{
+				Name:       new("example-8e7d6c5b"),
+				PrivateKey: new("private"),
+				PublicKey:  new("public"),
+			},
+		},
+		{
+			description: "short serial number",
+			name:        "example",
+			cert: &issuer.Certificate{
+				CertificatePEM: "public",
+				PrivateKeyPEM:  "private",
+				SerialNumber:   "1",
+			},
+			expected: &certSdk.CreateCertificatePayload{
+				Name:       new("example-1"),
+				PrivateKey: new("private"),
+				PublicKey:  new("public"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toCreatePayload(tt.name, tt.cert)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapFields(t *testing.T) {
+	notAfter := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	publicKey := testCertificatePEM(t, notAfter)
+
+	tests := []struct {
+		description string
+		input       *certSdk.GetCertificateResponse
+		model       *Model
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			description: "default values",
+			input: &certSdk.GetCertificateResponse{
+				Id:        new(certID),
+				Name:      new("example-8e7d6c5b"),
+				PublicKey: new(publicKey),
+			},
+			model: &Model{
+				ProjectId: types.StringValue(projectID),
+			},
+			expected: &Model{
+				Id:              types.StringValue(tfID),
+				ProjectId:       types.StringValue(projectID),
+				Region:          types.StringValue(region),
+				CertID:          types.StringValue(certID),
+				CertificateName: types.StringValue("example-8e7d6c5b"),
+				PublicKey:       types.StringValue(publicKey),
+				NotAfter:        types.StringValue("2026-04-01T12:00:00Z"),
+			},
+			isValid: true,
+		},
+		{
+			description: "cert id from state",
+			input: &certSdk.GetCertificateResponse{
+				Name: new("example-8e7d6c5b"),
+			},
+			model: &Model{
+				ProjectId: types.StringValue(projectID),
+				CertID:    types.StringValue(certID),
+			},
+			expected: &Model{
+				Id:              types.StringValue(tfID),
+				ProjectId:       types.StringValue(projectID),
+				Region:          types.StringValue(region),
+				CertID:          types.StringValue(certID),
+				CertificateName: types.StringValue("example-8e7d6c5b"),
+				PublicKey:       types.StringNull(),
+				NotAfter:        types.StringNull(),
+			},
+			isValid: true,
+		},
+		{
+			description: "invalid certificate",
+			input: &certSdk.GetCertificateResponse{
+				Id:        new(certID),
+				PublicKey: new("invalid"),
+			},
+			model: &Model{
+				ProjectId: types.StringValue(projectID),
+			},
+			isValid: false,
+		},
+		{
+			description: "no cert id",
+			input:       &certSdk.GetCertificateResponse{},
+			model: &Model{
+				ProjectId: types.StringValue(projectID),
+			},
+			isValid: false,
+		},
+		{
+			description: "nil response",
+			input:       nil,
+			model:       &Model{},
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.model, region)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
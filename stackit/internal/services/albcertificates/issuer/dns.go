@@ -0,0 +1,70 @@
+package issuer
+
+import (
+	"context"
+	"fmt"
+
+	dns "github.com/stackitcloud/stackit-sdk-go/services/dns/v1api"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns/v1api/wait"
+)
+
+const challengeRecordTTL = 60
+
+var _ ChallengeSolver = &DNSSolver{}
+
+// DNSSolver publishes DNS-01 challenge records in a STACKIT DNS zone.
+type DNSSolver struct {
+	client    dns.DefaultAPI
+	projectId string
+	zoneId    string
+}
+
+// NewDNSSolver returns a solver which creates the challenge records in the given zone.
+func NewDNSSolver(client dns.DefaultAPI, projectId, zoneId string) *DNSSolver {
+	return &DNSSolver{
+		client:    client,
+		projectId: projectId,
+		zoneId:    zoneId,
+	}
+}
+
+// Present creates a TXT record set and waits until it is active.
+func (s *DNSSolver) Present(ctx context.Context, fqdn string, values []string) (func(context.Context) error, error) {
+	records := make([]dns.RecordPayload, 0, len(values))
+	for _, value := range values {
+		records = append(records, dns.RecordPayload{Content: value})
+	}
+	payload := dns.CreateRecordSetPayload{
+		Comment: new("ACME challenge created by the STACKIT Terraform provider"),
+		Name:    fqdn,
+		Records: records,
+		Ttl:     new(int32(challengeRecordTTL)),
+		Type:    dns.CREATERECORDSETPAYLOADTYPE_TXT,
+	}
+	recordSetResp, err := s.client.CreateRecordSet(ctx, s.projectId, s.zoneId).CreateRecordSetPayload(payload).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("creating record set: %w", err)
+	}
+	if recordSetResp == nil || recordSetResp.Rrset.Id == "" {
+		return nil, fmt.Errorf("create record set response has no ID")
+	}
+	recordSetId := recordSetResp.Rrset.Id
+
+	cleanup := func(ctx context.Context) error {
+		_, err := s.client.DeleteRecordSet(ctx, s.projectId, s.zoneId, recordSetId).Execute()
+		if err != nil {
+			return fmt.Errorf("deleting record set %q: %w", recordSetId, err)
+		}
+		_, err = wait.DeleteRecordSetWaitHandler(ctx, s.client, s.projectId, s.zoneId, recordSetId).WaitWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("waiting for deletion of record set %q: %w", recordSetId, err)
+		}
+		return nil
+	}
+
+	_, err = wait.CreateRecordSetWaitHandler(ctx, s.client, s.projectId, s.zoneId, recordSetId).WaitWithContext(ctx)
+	if err != nil {
+		return cleanup, fmt.Errorf("waiting for record set creation: %w", err)
+	}
+	return cleanup, nil
+}
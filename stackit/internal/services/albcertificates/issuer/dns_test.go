@@ -0,0 +1,103 @@
+package issuer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	dns "github.com/stackitcloud/stackit-sdk-go/services/dns/v1api"
+)
+
+func TestDNSSolverPresent(t *testing.T) {
+	const (
+		projectId   = "pid"
+		zoneId      = "zid"
+		recordSetId = "rid"
+	)
+
+	tests := []struct {
+		description    string
+		createError    error
+		createState    dns.RecordSetState
+		deleteError    error
+		expectError    bool
+		expectCleanup  bool
+		expectDeleted  bool
+		expectCleanErr bool
+	}{
+		{
+			description:   "ok",
+			createState:   dns.RECORDSETSTATE_CREATE_SUCCEEDED,
+			expectCleanup: true,
+			expectDeleted: true,
+		},
+		{
+			description: "create error",
+			createError: fmt.Errorf("create error"),
+			expectError: true,
+		},
+		{
+			description:   "record set failed",
+			createState:   dns.RECORDSETSTATE_CREATE_FAILED,
+			expectError:   true,
+			expectCleanup: true,
+			expectDeleted: true,
+		},
+		{
+			description:    "delete error",
+			createState:    dns.RECORDSETSTATE_CREATE_SUCCEEDED,
+			deleteError:    fmt.Errorf("delete error"),
+			expectCleanup:  true,
+			expectCleanErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := tt.createState
+			deleted := false
+
+			createFn := func(_ dns.ApiCreateRecordSetRequest) (*dns.RecordSetResponse, error) {
+				if tt.createError != nil {
+					return nil, tt.createError
+				}
+				return &dns.RecordSetResponse{Rrset: dns.RecordSet{Id: recordSetId, State: dns.RECORDSETSTATE_CREATING}}, nil
+			}
+			getFn := func(_ dns.ApiGetRecordSetRequest) (*dns.RecordSetResponse, error) {
+				return &dns.RecordSetResponse{Rrset: dns.RecordSet{Id: recordSetId, State: state}}, nil
+			}
+			deleteFn := func(_ dns.ApiDeleteRecordSetRequest) (*dns.Message, error) {
+				if tt.deleteError != nil {
+					return nil, tt.deleteError
+				}
+				deleted = true
+				state = dns.RECORDSETSTATE_DELETE_SUCCEEDED
+				return &dns.Message{}, nil
+			}
+			client := &dns.DefaultAPIServiceMock{
+				CreateRecordSetExecuteMock: &createFn,
+				GetRecordSetExecuteMock:    &getFn,
+				DeleteRecordSetExecuteMock: &deleteFn,
+			}
+
+			solver := NewDNSSolver(client, projectId, zoneId)
+			cleanup, err := solver.Present(context.Background(), "_acme-challenge.example.com.", []string{"value1", "value2"})
+			if (err != nil) != tt.expectError {
+				t.Fatalf("Present() error = %v, expectError %v", err, tt.expectError)
+			}
+			if (cleanup != nil) != tt.expectCleanup {
+				t.Fatalf("expected cleanup %v, got %v", tt.expectCleanup, cleanup != nil)
+			}
+			if cleanup == nil {
+				return
+			}
+			err = cleanup(context.Background())
+			if (err != nil) != tt.expectCleanErr {
+				t.Fatalf("cleanup() error = %v, expectError %v", err, tt.expectCleanErr)
+			}
+			if deleted != tt.expectDeleted {
+				t.Errorf("expected deleted %v, got %v", tt.expectDeleted, deleted)
+			}
+		})
+	}
+}
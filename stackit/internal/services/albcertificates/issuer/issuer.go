@@ -0,0 +1,313 @@
+package issuer
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	// LetsEncryptDirectoryURL is the ACME directory of the Let's Encrypt production environment.
+	LetsEncryptDirectoryURL = "https://acme-v02.api.letsencrypt.org/directory"
+
+	KeyTypeECP256  = "ec-p256"
+	KeyTypeECP384  = "ec-p384"
+	KeyTypeRSA2048 = "rsa-2048"
+	KeyTypeRSA4096 = "rsa-4096"
+
+	challengeType   = "dns-01"
+	challengePrefix = "_acme-challenge."
+)
+
+// KeyTypes are the supported types of certificate keys.
+var KeyTypes = []string{KeyTypeECP256, KeyTypeECP384, KeyTypeRSA2048, KeyTypeRSA4096}
+
+// ChallengeSolver publishes the TXT records of DNS-01 challenges.
+type ChallengeSolver interface {
+	// Present creates a TXT record with the given values for the fully qualified domain name (with trailing dot).
+	// The returned function removes the record again. It may be returned together with an error, if the record was created.
+	Present(ctx context.Context, fqdn string, values []string) (cleanup func(context.Context) error, err error)
+}
+
+// Issuer obtains certificates from an ACME CA by solving DNS-01 challenges.
+type Issuer struct {
+	// DirectoryURL of the ACME CA, defaults to LetsEncryptDirectoryURL.
+	DirectoryURL string
+	// AccountKey is the key of the ACME account. The account is registered with the first order and reused by the
+	// following orders with the same key.
+	AccountKey crypto.Signer
+	// Email is registered as contact of the ACME account, optional.
+	Email string
+	// HTTPClient is used for the requests to the ACME CA, defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Solver publishes the challenge records.
+	Solver ChallengeSolver
+	// PropagationDelay is waited for after the challenge records are published, before the CA is asked to validate them.
+	PropagationDelay time.Duration
+}
+
+// Certificate is an issued certificate with its private key.
+type Certificate struct {
+	// CertificatePEM contains the leaf certificate followed by the intermediate certificates.
+	CertificatePEM string
+	PrivateKeyPEM  string
+	NotAfter       time.Time
+	SerialNumber   string
+}
+
+// Issue orders a certificate for the given domains. The first domain is used as common name.
+func (i *Issuer) Issue(ctx context.Context, domains []string, keyType string) (*Certificate, error) {
+	if len(domains) == 0 {
+		return nil, fmt.Errorf("no domains given")
+	}
+	if i.Solver == nil {
+		return nil, fmt.Errorf("no challenge solver configured")
+	}
+	if i.AccountKey == nil {
+		return nil, fmt.Errorf("no account key configured")
+	}
+
+	directoryURL := i.DirectoryURL
+	if directoryURL == "" {
+		directoryURL = LetsEncryptDirectoryURL
+	}
+	client := &acme.Client{
+		Key:          i.AccountKey,
+		DirectoryURL: directoryURL,
+		HTTPClient:   i.HTTPClient,
+		UserAgent:    "terraform-provider-stackit",
+	}
+
+	account := &acme.Account{}
+	if i.Email != "" {
+		account.Contact = []string{"mailto:" + i.Email}
+	}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, fmt.Errorf("registering ACME account: %w", err)
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(domains...))
+	if err != nil {
+		return nil, fmt.Errorf("creating order: %w", err)
+	}
+
+	if err := i.authorize(ctx, client, order); err != nil {
+		return nil, err
+	}
+
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for order: %w", err)
+	}
+
+	certKey, privateKeyPEM, err := generateKey(keyType)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: domains[0]},
+		DNSNames: domains,
+	}, certKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating certificate request: %w", err)
+	}
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, fmt.Errorf("finalizing order: %w", err)
+	}
+	return toCertificate(chain, privateKeyPEM)
+}
+
+// authorize solves the pending DNS-01 challenges of the order. Challenges of a domain and its wildcard share one record.
+func (i *Issuer) authorize(ctx context.Context, client *acme.Client, order *acme.Order) error {
+	type pendingChallenge struct {
+		authzURL  string
+		challenge *acme.Challenge
+	}
+	var pending []pendingChallenge
+	records := map[string][]string{}
+	var fqdns []string
+
+	for _, authzURL := range order.AuthzURLs {
+		authz, err := client.GetAuthorization(ctx, authzURL)
+		if err != nil {
+			return fmt.Errorf("reading authorization: %w", err)
+		}
+		if authz.Status == acme.StatusValid {
+			continue
+		}
+		idx := slices.IndexFunc(authz.Challenges, func(c *acme.Challenge) bool { return c.Type == challengeType })
+		if idx < 0 {
+			return fmt.Errorf("no %s challenge offered for %q", challengeType, authz.Identifier.Value)
+		}
+		challenge := authz.Challenges[idx]
+		value, err := client.DNS01ChallengeRecord(challenge.Token)
+		if err != nil {
+			return fmt.Errorf("computing challenge record for %q: %w", authz.Identifier.Value, err)
+		}
+
+		fqdn := ChallengeFQDN(authz.Identifier.Value)
+		if _, ok := records[fqdn]; !ok {
+			fqdns = append(fqdns, fqdn)
+		}
+		records[fqdn] = append(records[fqdn], value)
+		pending = append(pending, pendingChallenge{authzURL: authz.URI, challenge: challenge})
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	var cleanups []func(context.Context) error
+	defer func() {
+		for _, cleanup := range cleanups {
+			// the records are removed even if the context was canceled in the meantime
+			if err := cleanup(context.WithoutCancel(ctx)); err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Removing ACME challenge record: %v", err))
+			}
+		}
+	}()
+	for _, fqdn := range fqdns {
+		cleanup, err := i.Solver.Present(ctx, fqdn, records[fqdn])
+		if cleanup != nil {
+			cleanups = append(cleanups, cleanup)
+		}
+		if err != nil {
+			return fmt.Errorf("presenting challenge record %q: %w", fqdn, err)
+		}
+	}
+
+	if i.PropagationDelay > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(i.PropagationDelay):
+		}
+	}
+
+	for _, p := range pending {
+		if _, err := client.Accept(ctx, p.challenge); err != nil {
+			return fmt.Errorf("accepting challenge: %w", err)
+		}
+		if _, err := client.WaitAuthorization(ctx, p.authzURL); err != nil {
+			return fmt.Errorf("waiting for authorization: %w", err)
+		}
+	}
+	return nil
+}
+
+// ParseAccountKey parses a PEM encoded ECDSA or RSA private key as ACME account key. The key can be encoded in
+// SEC 1, PKCS #1 or PKCS #8 format, like the keys of the hashicorp/tls provider.
+func ParseAccountKey(keyPEM string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded private key found")
+	}
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		switch key := key.(type) {
+		case *ecdsa.PrivateKey:
+			return key, nil
+		case *rsa.PrivateKey:
+			return key, nil
+		default:
+			return nil, fmt.Errorf("unsupported private key type %T, expected an ECDSA or RSA key", key)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
+
+// ChallengeFQDN returns the name of the TXT record of the DNS-01 challenge for a domain.
+func ChallengeFQDN(domain string) string {
+	domain = strings.TrimPrefix(domain, "*.")
+	return challengePrefix + strings.TrimSuffix(domain, ".") + "."
+}
+
+// generateKey creates a certificate key. The private key is PEM encoded like by the hashicorp/tls provider.
+func generateKey(keyType string) (crypto.Signer, string, error) {
+	switch keyType {
+	case KeyTypeECP256, KeyTypeECP384:
+		curve := elliptic.P256()
+		if keyType == KeyTypeECP384 {
+			curve = elliptic.P384()
+		}
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return nil, "", fmt.Errorf("generating key: %w", err)
+		}
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, "", fmt.Errorf("encoding key: %w", err)
+		}
+		return key, string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})), nil
+	case KeyTypeRSA2048, KeyTypeRSA4096:
+		bits := 2048
+		if keyType == KeyTypeRSA4096 {
+			bits = 4096
+		}
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, "", fmt.Errorf("generating key: %w", err)
+		}
+		return key, string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})), nil
+	default:
+		return nil, "", fmt.Errorf("unsupported key type %q, expected one of %v", keyType, KeyTypes)
+	}
+}
+
+func toCertificate(chain [][]byte, privateKeyPEM string) (*Certificate, error) {
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("no certificate returned")
+	}
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate: %w", err)
+	}
+
+	var certificatePEM strings.Builder
+	for _, der := range chain {
+		certificatePEM.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	}
+	return &Certificate{
+		CertificatePEM: certificatePEM.String(),
+		PrivateKeyPEM:  privateKeyPEM,
+		NotAfter:       leaf.NotAfter,
+		SerialNumber:   fmt.Sprintf("%x", leaf.SerialNumber),
+	}, nil
+}
+
+// ParseNotAfter returns the expiry of the first certificate of a PEM encoded chain.
+func ParseNotAfter(certificatePEM string) (time.Time, error) {
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return time.Time{}, fmt.Errorf("no PEM encoded certificate found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing certificate: %w", err)
+	}
+	return cert.NotAfter, nil
+}
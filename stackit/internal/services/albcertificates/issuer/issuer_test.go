@@ -0,0 +1,266 @@
+package issuer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChallengeFQDN(t *testing.T) {
+	tests := []struct {
+		description string
+		domain      string
+		expected    string
+	}{
+		{"domain", "example.com", "_acme-challenge.example.com."},
+		{"fqdn", "example.com.", "_acme-challenge.example.com."},
+		{"wildcard", "*.example.com", "_acme-challenge.example.com."},
+		{"subdomain", "www.example.com", "_acme-challenge.www.example.com."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := ChallengeFQDN(tt.domain); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGenerateKey(t *testing.T) {
+	tests := []struct {
+		keyType     string
+		pemType     string
+		expectError bool
+	}{
+		{KeyTypeECP256, "EC PRIVATE KEY", false},
+		{KeyTypeECP384, "EC PRIVATE KEY", false},
+		{KeyTypeRSA2048, "RSA PRIVATE KEY", false},
+		{"dsa", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.keyType, func(t *testing.T) {
+			key, keyPEM, err := generateKey(tt.keyType)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("generateKey() error = %v, expectError %v", err, tt.expectError)
+			}
+			if tt.expectError {
+				return
+			}
+			if key == nil {
+				t.Fatal("expected key")
+			}
+			block, _ := pem.Decode([]byte(keyPEM))
+			if block == nil || block.Type != tt.pemType {
+				t.Errorf("expected PEM block of type %q", tt.pemType)
+			}
+		})
+	}
+}
+
+func TestParseAccountKey(t *testing.T) {
+	ecKey, ecPEM, err := generateKey(KeyTypeECP256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, rsaPEM, err := generateKey(KeyTypeRSA2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8DER, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ed25519DER, err := x509.MarshalPKCS8PrivateKey(ed25519Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		description string
+		keyPEM      string
+		expectError bool
+	}{
+		{"ec", ecPEM, false},
+		{"rsa", rsaPEM, false},
+		{"pkcs8", string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8DER})), false},
+		{"pkcs8 ed25519", string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: ed25519DER})), true},
+		{"certificate", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: pkcs8DER})), true},
+		{"no pem", "not a key", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			key, err := ParseAccountKey(tt.keyPEM)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("ParseAccountKey() error = %v, expectError %v", err, tt.expectError)
+			}
+			if !tt.expectError && key == nil {
+				t.Fatal("expected key")
+			}
+		})
+	}
+}
+
+func TestToCertificate(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	key, _, err := generateKey(KeyTypeECP256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0xabcdef123),
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     []string{"example.com"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := toCertificate([][]byte{der, der}, "key")
+	if err != nil {
+		t.Fatalf("toCertificate() error = %v", err)
+	}
+	if !cert.NotAfter.Equal(notAfter) {
+		t.Errorf("expected not after %v, got %v", notAfter, cert.NotAfter)
+	}
+	if cert.SerialNumber != "abcdef123" {
+		t.Errorf("expected serial number %q, got %q", "abcdef123", cert.SerialNumber)
+	}
+	if n := strings.Count(cert.CertificatePEM, "BEGIN CERTIFICATE"); n != 2 {
+		t.Errorf("expected 2 certificates in chain, got %d", n)
+	}
+
+	parsed, err := ParseNotAfter(cert.CertificatePEM)
+	if err != nil {
+		t.Fatalf("ParseNotAfter() error = %v", err)
+	}
+	if !parsed.Equal(notAfter) {
+		t.Errorf("expected parsed not after %v, got %v", notAfter, parsed)
+	}
+
+	if _, err := toCertificate(nil, "key"); err == nil {
+		t.Error("expected error for empty chain")
+	}
+	if _, err := ParseNotAfter("invalid"); err == nil {
+		t.Error("expected error for invalid PEM")
+	}
+}
+
+// challtestsrvSolver publishes the challenge records with the management API of pebble-challtestsrv.
+type challtestsrvSolver struct {
+	url string
+}
+
+func (s *challtestsrvSolver) post(ctx context.Context, path string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", path, resp.Status)
+	}
+	return nil
+}
+
+func (s *challtestsrvSolver) Present(ctx context.Context, fqdn string, values []string) (func(context.Context) error, error) {
+	// challtestsrv keeps a single value per host, which is enough as long as no wildcard is ordered
+	for _, value := range values {
+		if err := s.post(ctx, "/set-txt", map[string]string{"host": fqdn, "value": value}); err != nil {
+			return nil, err
+		}
+	}
+	return func(ctx context.Context) error {
+		return s.post(ctx, "/clear-txt", map[string]string{"host": fqdn})
+	}, nil
+}
+
+// TestIssuePebble issues a certificate from a local Pebble ACME server, e.g. started with
+//
+//	docker run -d -p 8055:8055 -p 8053:8053/udp ghcr.io/letsencrypt/pebble-challtestsrv
+//	docker run -d -p 14000:14000 -e PEBBLE_VA_NOSLEEP=1 ghcr.io/letsencrypt/pebble -dnsserver <challtestsrv-ip>:8053
+//	PEBBLE_DIRECTORY_URL=https://localhost:14000/dir PEBBLE_CHALLTESTSRV_URL=http://localhost:8055 go test ./...
+func TestIssuePebble(t *testing.T) {
+	directoryURL := os.Getenv("PEBBLE_DIRECTORY_URL")
+	challtestsrvURL := os.Getenv("PEBBLE_CHALLTESTSRV_URL")
+	if directoryURL == "" || challtestsrvURL == "" {
+		t.Skip("PEBBLE_DIRECTORY_URL and PEBBLE_CHALLTESTSRV_URL must be set")
+	}
+
+	accountKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := &Issuer{
+		DirectoryURL: directoryURL,
+		AccountKey:   accountKey,
+		Email:        "test@example.com",
+		HTTPClient: &http.Client{
+			Transport: &http.Transport{
+				// Pebble uses a certificate of its own test CA
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // only used against the local test server
+			},
+		},
+		Solver: &challtestsrvSolver{url: challtestsrvURL},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	domains := []string{"example.com", "www.example.com"}
+	cert, err := issuer.Issue(ctx, domains, KeyTypeECP256)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	// a renewal reuses the account registered by the first order
+	cert, err = issuer.Issue(ctx, domains, KeyTypeECP256)
+	if err != nil {
+		t.Fatalf("Issue() with registered account error = %v", err)
+	}
+
+	block, _ := pem.Decode([]byte(cert.CertificatePEM))
+	if block == nil {
+		t.Fatal("no certificate in PEM")
+	}
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, domain := range domains {
+		if err := leaf.VerifyHostname(domain); err != nil {
+			t.Errorf("certificate is not valid for %q: %v", domain, err)
+		}
+	}
+	if cert.NotAfter.Before(time.Now()) {
+		t.Errorf("certificate is already expired: %v", cert.NotAfter)
+	}
+	if !strings.Contains(cert.PrivateKeyPEM, "EC PRIVATE KEY") {
+		t.Error("expected EC private key")
+	}
+}
@@ -0,0 +1,634 @@
+package roleassignments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	authorization "github.com/stackitcloud/stackit-sdk-go/services/authorization/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	authorizationUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/authorization/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// ownerRole is the role which grants full access to a container, including the management of its members
+const ownerRole = "owner"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &roleAssignmentsResource{}
+	_ resource.ResourceWithConfigure      = &roleAssignmentsResource{}
+	_ resource.ResourceWithModifyPlan     = &roleAssignmentsResource{}
+	_ resource.ResourceWithImportState    = &roleAssignmentsResource{}
+	_ resource.ResourceWithValidateConfig = &roleAssignmentsResource{}
+)
+
+type MembersModel struct {
+	Id         types.String `tfsdk:"id"` // needed by TF
+	ResourceId types.String `tfsdk:"resource_id"`
+	Role       types.String `tfsdk:"role"`
+	Members    types.Set    `tfsdk:"members"`
+}
+
+// Struct corresponding to MembersModel.Members[i]
+type memberModel struct {
+	Role    types.String `tfsdk:"role"`
+	Subject types.String `tfsdk:"subject"`
+}
+
+// Types corresponding to memberModel
+var memberTypes = map[string]attr.Type{
+	"role":    types.StringType,
+	"subject": types.StringType,
+}
+
+// NewRoleAssignmentsResources is a helper function to simplify the provider implementation.
+// Authoritative role assignments are only available for containers, i.e. not for service accounts.
+func NewRoleAssignmentsResources() []func() resource.Resource {
+	resources := make([]func() resource.Resource, 0)
+	for _, v := range roleTargets {
+		if v == "service-account" {
+			continue
+		}
+		resources = append(resources, func() resource.Resource {
+			return &roleAssignmentsResource{
+				apiName: v,
+			}
+		})
+	}
+	return resources
+}
+
+// roleAssignmentsResource is the resource implementation.
+type roleAssignmentsResource struct {
+	authorizationClient *authorization.APIClient
+	providerData        core.ProviderData
+	apiName             string
+}
+
+// Metadata returns the resource type name.
+func (r *roleAssignmentsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_authorization_%s_role_assignments", req.ProviderTypeName, r.apiName)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *roleAssignmentsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckExperimentEnabled(ctx, &r.providerData, features.IamExperiment, fmt.Sprintf("stackit_authorization_%s_role_assignments", r.apiName), core.Resource, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := authorizationUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.authorizationClient = apiClient
+	tflog.Info(ctx, fmt.Sprintf("Resource Manager %s Role Assignments client configured", r.apiName))
+}
+
+// Schema defines the schema for the resource.
+func (r *roleAssignmentsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Capitalize the first letter for display (e.g. "Project")
+	resourceTitle := fmt.Sprintf("%s%s", strings.ToUpper(r.apiName[:1]), strings.ToLower(r.apiName[1:]))
+
+	descriptionText := fmt.Sprintf(
+		"%s Role Assignments resource schema. Authoritative for all members of a %s, or for all members of a single role if `role` is set: "+
+			"assignments which are not part of the configuration are removed, also if they were added outside of Terraform.\n\n"+
+			"~> **Important:** To protect against locking yourself out, the `%s` role of the service account used by the provider is never removed. "+
+			"The service account is taken from `service_account_email`, the `STACKIT_SERVICE_ACCOUNT_EMAIL` environment variable or the service account key; with token authentication it is unknown and not protected. "+
+			"The last `%s` of a %s can't be removed. Don't use this resource together with `stackit_authorization_%s_role_assignment` for the same %s and role.",
+		resourceTitle, r.apiName, ownerRole, ownerRole, r.apiName, r.apiName, r.apiName,
+	)
+
+	descriptions := map[string]string{
+		"main":            features.AddExperimentDescription(descriptionText, features.IamExperiment, core.Resource),
+		"id":              "Terraform's internal resource identifier. It is structured as \"`resource_id`\" or \"`resource_id`,`role`\" if `role` is set.",
+		"resource_id":     fmt.Sprintf("%s Resource to manage the role assignments of.", resourceTitle),
+		"role":            "If set, only the members of this role are managed. Otherwise, the members of all roles are managed.",
+		"members":         "The complete set of role assignments. If `role` is set, all members must have this role.",
+		"members.role":    "Role to be assigned. Available roles can be queried using stackit-cli: `stackit curl https://authorization.api.stackit.cloud/v2/{resourceType}/{resourceId}/roles`",
+		"members.subject": "Identifier of user, service account or client. Usually email address or name in case of clients. All letters must be lowercased.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Description: descriptions["resource_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: descriptions["role"],
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				Description: descriptions["members"],
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: descriptions["members.role"],
+							Required:    true,
+						},
+						"subject": schema.StringAttribute{
+							Description: descriptions["members.subject"],
+							Required:    true,
+							Validators: []validator.String{
+								validate.IsLowercased(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates that all members have the managed role, if one is set.
+func (r *roleAssignmentsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model MembersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if utils.IsUndefined(model.Role) || utils.IsUndefined(model.Members) {
+		return
+	}
+
+	members, err := toMembers(ctx, model.Members)
+	if err != nil {
+		// members contain unknown values, they are validated during plan
+		return
+	}
+	for _, m := range members {
+		if m.Role != model.Role.ValueString() {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error validating role assignments", fmt.Sprintf("Member %q has role %q, but only members of role %q are managed by this resource", m.Subject, m.Role, model.Role.ValueString()))
+		}
+	}
+}
+
+// ModifyPlan checks the guard rails for assignments which are removed in the plan.
+// Assignments which were added outside of Terraform are part of the refreshed state, so they are considered here as well.
+func (r *roleAssignmentsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planModel, stateModel MembersModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := toMembers(ctx, planModel.Members)
+	if err != nil {
+		// members are not known yet, the guard rails are checked during apply
+		return
+	}
+	current, err := toMembers(ctx, stateModel.Members)
+	if err != nil {
+		return
+	}
+
+	add, remove := diffMembers(current, desired)
+	if err := checkRemovals(current, add, remove, r.providerData.ServiceAccountEmail); err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error planning %s role assignments", r.apiName), err.Error())
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *roleAssignmentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model MembersModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = tflog.SetField(ctx, "resource_type", r.apiName)
+	ctx = tflog.SetField(ctx, "resource_id", model.ResourceId.ValueString())
+	ctx = tflog.SetField(ctx, "role", model.Role.ValueString())
+
+	r.applyMembers(ctx, &model, &resp.Diagnostics, "creating")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("%s role assignments created", r.apiName))
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *roleAssignmentsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model MembersModel
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = tflog.SetField(ctx, "resource_type", r.apiName)
+	ctx = tflog.SetField(ctx, "resource_id", model.ResourceId.ValueString())
+	ctx = tflog.SetField(ctx, "role", model.Role.ValueString())
+
+	listResp, err := r.authorizationClient.DefaultAPI.ListMembers(ctx, r.apiName, model.ResourceId.ValueString()).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading authorizations", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapMembersFields(listResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading authorizations", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("%s role assignments read successful", r.apiName))
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *roleAssignmentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model MembersModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = tflog.SetField(ctx, "resource_type", r.apiName)
+	ctx = tflog.SetField(ctx, "resource_id", model.ResourceId.ValueString())
+	ctx = tflog.SetField(ctx, "role", model.Role.ValueString())
+
+	r.applyMembers(ctx, &model, &resp.Diagnostics, "updating")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("%s role assignments updated", r.apiName))
+}
+
+// Delete removes the managed role assignments and removes the Terraform state on success.
+// The owner role of the caller is kept.
+func (r *roleAssignmentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model MembersModel
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = tflog.SetField(ctx, "resource_type", r.apiName)
+	ctx = tflog.SetField(ctx, "resource_id", model.ResourceId.ValueString())
+	ctx = tflog.SetField(ctx, "role", model.Role.ValueString())
+
+	resourceId := model.ResourceId.ValueString()
+	lockKey := fmt.Sprintf("%s,%s", resourceId, r.apiName)
+	unlock := authorizationUtils.LockAssignment(lockKey)
+	defer unlock()
+
+	listResp, err := r.authorizationClient.DefaultAPI.ListMembers(ctx, r.apiName, resourceId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error deleting %s role assignments", r.apiName), fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	current := filterMembers(listResp.Members, model.Role.ValueString())
+
+	managed, err := toMembers(ctx, model.Members)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error deleting %s role assignments", r.apiName), err.Error())
+		return
+	}
+
+	remove := []authorization.Member{}
+	for _, m := range managed {
+		if !containsMember(current, m) {
+			continue
+		}
+		if isCallerOwner(m, r.providerData.ServiceAccountEmail) {
+			core.LogAndAddWarning(ctx, &resp.Diagnostics, fmt.Sprintf("%s role assignment kept", r.apiName), fmt.Sprintf("The %q role of %q, which is used by the provider, is not removed to prevent losing access to the %s.", m.Role, m.Subject, r.apiName))
+			continue
+		}
+		remove = append(remove, m)
+	}
+
+	if err := checkRemovals(current, nil, remove, r.providerData.ServiceAccountEmail); err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error deleting %s role assignments", r.apiName), err.Error())
+		return
+	}
+
+	if len(remove) > 0 {
+		payload := authorization.RemoveMembersPayload{
+			ResourceType: r.apiName,
+			Members:      remove,
+		}
+		_, err = r.authorizationClient.DefaultAPI.RemoveMembers(ctx, resourceId).RemoveMembersPayload(payload).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error deleting %s role assignments", r.apiName), fmt.Sprintf("Calling API: %v", err))
+			return
+		}
+
+		ctx = core.LogResponse(ctx)
+
+		// sleep to ensure that cache window has passed
+		time.Sleep(10 * time.Second)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("%s role assignments deleted", r.apiName))
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the role assignments resource import identifier is: resource_id or resource_id,role
+func (r *roleAssignmentsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+	if (len(idParts) != 1 && len(idParts) != 2) || slices.Contains(idParts, "") {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			fmt.Sprintf("Error importing %s role assignments", r.apiName),
+			fmt.Sprintf("Expected import identifier with format [resource_id] or [resource_id],[role], got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), idParts[0])...)
+	if len(idParts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), idParts[1])...)
+	}
+	tflog.Info(ctx, fmt.Sprintf("%s role assignments state imported", r.apiName))
+}
+
+// applyMembers adds and removes role assignments, so the members of the container match the model.
+func (r *roleAssignmentsResource) applyMembers(ctx context.Context, model *MembersModel, diags *diag.Diagnostics, operation string) {
+	resourceId := model.ResourceId.ValueString()
+	role := model.Role.ValueString()
+	errorSummary := fmt.Sprintf("Error %s %s role assignments", operation, r.apiName)
+
+	// All changes of the members of one container are serialized, since each change is based on the current members.
+	lockKey := fmt.Sprintf("%s,%s", resourceId, r.apiName)
+	unlock := authorizationUtils.LockAssignment(lockKey)
+	defer unlock()
+
+	desired, err := toMembers(ctx, model.Members)
+	if err != nil {
+		core.LogAndAddError(ctx, diags, errorSummary, err.Error())
+		return
+	}
+
+	listResp, err := r.authorizationClient.DefaultAPI.ListMembers(ctx, r.apiName, resourceId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, diags, errorSummary, fmt.Sprintf("Listing current members: %v", err))
+		return
+	}
+	current := filterMembers(listResp.Members, role)
+
+	add, remove := diffMembers(current, desired)
+	if err := checkRemovals(current, add, remove, r.providerData.ServiceAccountEmail); err != nil {
+		core.LogAndAddError(ctx, diags, errorSummary, err.Error())
+		return
+	}
+
+	// members are added first, so a replaced owner never leaves the container without owner
+	if len(add) > 0 {
+		payload := authorization.AddMembersPayload{
+			ResourceType: r.apiName,
+			Members:      add,
+		}
+		_, err = r.authorizationClient.DefaultAPI.AddMembers(ctx, resourceId).AddMembersPayload(payload).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, diags, errorSummary, fmt.Sprintf("Adding members: %v", err))
+			return
+		}
+	}
+	if len(remove) > 0 {
+		payload := authorization.RemoveMembersPayload{
+			ResourceType: r.apiName,
+			Members:      remove,
+		}
+		_, err = r.authorizationClient.DefaultAPI.RemoveMembers(ctx, resourceId).RemoveMembersPayload(payload).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, diags, errorSummary, fmt.Sprintf("Removing members: %v", err))
+			return
+		}
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	if len(add) > 0 || len(remove) > 0 {
+		// sleep to ensure that cache window has passed
+		time.Sleep(10 * time.Second)
+	}
+
+	model.Id = buildMembersId(resourceId, role)
+	tflog.Info(ctx, "role assignments applied", map[string]any{"added": len(add), "removed": len(remove)})
+}
+
+// checkRemovals prevents removing the owner role of the caller and the last owner of a container.
+// The owners which remain are the current owners without the removed ones, plus the added ones.
+func checkRemovals(current, add, remove []authorization.Member, caller string) error {
+	owners := 0
+	for _, m := range current {
+		if m.Role == ownerRole {
+			owners++
+		}
+	}
+	for _, m := range add {
+		if m.Role == ownerRole {
+			owners++
+		}
+	}
+
+	for _, m := range remove {
+		if isCallerOwner(m, caller) {
+			return fmt.Errorf("removing the %q role of %q is not allowed, since the provider uses this service account. Add it to the members", m.Role, m.Subject)
+		}
+		if m.Role == ownerRole {
+			owners--
+		}
+	}
+
+	if owners == 0 && slices.ContainsFunc(remove, func(m authorization.Member) bool { return m.Role == ownerRole }) {
+		return fmt.Errorf("removing all members with role %q is not allowed, at least one owner must remain", ownerRole)
+	}
+	return nil
+}
+
+func isCallerOwner(member authorization.Member, caller string) bool {
+	return caller != "" && member.Role == ownerRole && strings.EqualFold(member.Subject, caller)
+}
+
+// diffMembers returns the members which have to be added and removed to get from current to desired.
+func diffMembers(current, desired []authorization.Member) (add, remove []authorization.Member) {
+	add = []authorization.Member{}
+	remove = []authorization.Member{}
+	for _, m := range desired {
+		if !containsMember(current, m) {
+			add = append(add, m)
+		}
+	}
+	for _, m := range current {
+		if !containsMember(desired, m) {
+			remove = append(remove, m)
+		}
+	}
+	return add, remove
+}
+
+func containsMember(members []authorization.Member, member authorization.Member) bool {
+	return slices.ContainsFunc(members, func(m authorization.Member) bool {
+		return m.Role == member.Role && m.Subject == member.Subject
+	})
+}
+
+// filterMembers returns the members of the given role, or all members if role is empty.
+func filterMembers(members []authorization.Member, role string) []authorization.Member {
+	filtered := []authorization.Member{}
+	for _, m := range members {
+		if role == "" || m.Role == role {
+			filtered = append(filtered, authorization.Member{Role: m.Role, Subject: m.Subject})
+		}
+	}
+	return filtered
+}
+
+func toMembers(ctx context.Context, set types.Set) ([]authorization.Member, error) {
+	if utils.IsUndefined(set) {
+		return nil, fmt.Errorf("members are not defined")
+	}
+	membersModel := []memberModel{}
+	diags := set.ElementsAs(ctx, &membersModel, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("converting members: %w", core.DiagsToError(diags))
+	}
+
+	members := []authorization.Member{}
+	for _, m := range membersModel {
+		if utils.IsUndefined(m.Role) || utils.IsUndefined(m.Subject) {
+			return nil, fmt.Errorf("members contain undefined values")
+		}
+		members = append(members, authorization.Member{
+			Role:    m.Role.ValueString(),
+			Subject: m.Subject.ValueString(),
+		})
+	}
+	return members, nil
+}
+
+func mapMembersFields(resp *authorization.ListMembersResponse, model *MembersModel) error {
+	if resp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	resourceId := resp.ResourceId
+	if resourceId == "" {
+		resourceId = model.ResourceId.ValueString()
+	}
+	model.ResourceId = types.StringValue(resourceId)
+	model.Id = buildMembersId(resourceId, model.Role.ValueString())
+
+	members := []attr.Value{}
+	for _, m := range filterMembers(resp.Members, model.Role.ValueString()) {
+		member, diags := types.ObjectValue(memberTypes, map[string]attr.Value{
+			"role":    types.StringValue(m.Role),
+			"subject": types.StringValue(m.Subject),
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping member: %w", core.DiagsToError(diags))
+		}
+		members = append(members, member)
+	}
+
+	membersSet, diags := types.SetValue(types.ObjectType{AttrTypes: memberTypes}, members)
+	if diags.HasError() {
+		return fmt.Errorf("mapping members: %w", core.DiagsToError(diags))
+	}
+	model.Members = membersSet
+	return nil
+}
+
+func buildMembersId(resourceId, role string) types.String {
+	if role == "" {
+		return utils.BuildInternalTerraformId(resourceId)
+	}
+	return utils.BuildInternalTerraformId(resourceId, role)
+}
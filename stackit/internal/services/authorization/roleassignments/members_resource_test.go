@@ -0,0 +1,334 @@
+package roleassignments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	authorization "github.com/stackitcloud/stackit-sdk-go/services/authorization/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+const callerEmail = "terraform@sa.stackit.cloud"
+
+func TestDiffMembers(t *testing.T) {
+	tests := []struct {
+		name           string
+		current        []authorization.Member
+		desired        []authorization.Member
+		expectedAdd    []authorization.Member
+		expectedRemove []authorization.Member
+	}{
+		{
+			name:    "add and remove",
+			current: []authorization.Member{{Role: "reader", Subject: "a@stackit.cloud"}, {Role: "editor", Subject: "b@stackit.cloud"}},
+			desired: []authorization.Member{{Role: "reader", Subject: "a@stackit.cloud"}, {Role: "editor", Subject: "c@stackit.cloud"}},
+			expectedAdd: []authorization.Member{
+				{Role: "editor", Subject: "c@stackit.cloud"},
+			},
+			expectedRemove: []authorization.Member{
+				{Role: "editor", Subject: "b@stackit.cloud"},
+			},
+		},
+		{
+			name:           "role change of subject",
+			current:        []authorization.Member{{Role: "reader", Subject: "a@stackit.cloud"}},
+			desired:        []authorization.Member{{Role: "editor", Subject: "a@stackit.cloud"}},
+			expectedAdd:    []authorization.Member{{Role: "editor", Subject: "a@stackit.cloud"}},
+			expectedRemove: []authorization.Member{{Role: "reader", Subject: "a@stackit.cloud"}},
+		},
+		{
+			name:           "no changes",
+			current:        []authorization.Member{{Role: "reader", Subject: "a@stackit.cloud"}},
+			desired:        []authorization.Member{{Role: "reader", Subject: "a@stackit.cloud"}},
+			expectedAdd:    []authorization.Member{},
+			expectedRemove: []authorization.Member{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, remove := diffMembers(tt.current, tt.desired)
+			if diff := cmp.Diff(tt.expectedAdd, add); diff != "" {
+				t.Errorf("added members mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.expectedRemove, remove); diff != "" {
+				t.Errorf("removed members mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckRemovals(t *testing.T) {
+	owner := authorization.Member{Role: ownerRole, Subject: "owner@stackit.cloud"}
+	callerOwner := authorization.Member{Role: ownerRole, Subject: callerEmail}
+	reader := authorization.Member{Role: "reader", Subject: "reader@stackit.cloud"}
+
+	tests := []struct {
+		name        string
+		current     []authorization.Member
+		add         []authorization.Member
+		remove      []authorization.Member
+		caller      string
+		expectError bool
+	}{
+		{
+			name:    "remove reader",
+			current: []authorization.Member{owner, reader},
+			remove:  []authorization.Member{reader},
+			caller:  callerEmail,
+		},
+		{
+			name:    "remove one of two owners",
+			current: []authorization.Member{owner, callerOwner},
+			remove:  []authorization.Member{owner},
+			caller:  callerEmail,
+		},
+		{
+			name:        "remove caller owner",
+			current:     []authorization.Member{owner, callerOwner},
+			remove:      []authorization.Member{callerOwner},
+			caller:      callerEmail,
+			expectError: true,
+		},
+		{
+			name:        "remove caller owner case insensitive",
+			current:     []authorization.Member{owner, callerOwner},
+			remove:      []authorization.Member{callerOwner},
+			caller:      "Terraform@SA.stackit.cloud",
+			expectError: true,
+		},
+		{
+			name:        "remove last owner",
+			current:     []authorization.Member{owner, reader},
+			remove:      []authorization.Member{owner},
+			caller:      "",
+			expectError: true,
+		},
+		{
+			name:    "replace only owner",
+			current: []authorization.Member{owner, reader},
+			add:     []authorization.Member{{Role: ownerRole, Subject: "new-owner@stackit.cloud"}},
+			remove:  []authorization.Member{owner},
+			caller:  "",
+		},
+		{
+			name:        "replace only owner by other role",
+			current:     []authorization.Member{owner},
+			add:         []authorization.Member{{Role: "reader", Subject: "new-owner@stackit.cloud"}},
+			remove:      []authorization.Member{owner},
+			caller:      "",
+			expectError: true,
+		},
+		{
+			name:        "replace caller owner",
+			current:     []authorization.Member{callerOwner},
+			add:         []authorization.Member{owner},
+			remove:      []authorization.Member{callerOwner},
+			caller:      callerEmail,
+			expectError: true,
+		},
+		{
+			name:    "remove other role of caller",
+			current: []authorization.Member{callerOwner, {Role: "reader", Subject: callerEmail}},
+			remove:  []authorization.Member{{Role: "reader", Subject: callerEmail}},
+			caller:  callerEmail,
+		},
+		{
+			name:    "nothing to remove",
+			current: []authorization.Member{owner},
+			remove:  []authorization.Member{},
+			caller:  callerEmail,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRemovals(tt.current, tt.add, tt.remove, tt.caller)
+			if tt.expectError && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestMapMembersFields(t *testing.T) {
+	member := func(role, subject string) attr.Value {
+		return types.ObjectValueMust(memberTypes, map[string]attr.Value{
+			"role":    types.StringValue(role),
+			"subject": types.StringValue(subject),
+		})
+	}
+
+	tests := []struct {
+		name        string
+		input       *authorization.ListMembersResponse
+		model       *MembersModel
+		expected    *MembersModel
+		expectError bool
+	}{
+		{
+			name: "all roles",
+			input: &authorization.ListMembersResponse{
+				ResourceId: "rid",
+				Members: []authorization.Member{
+					{Role: ownerRole, Subject: "a@stackit.cloud"},
+					{Role: "reader", Subject: "b@stackit.cloud"},
+				},
+			},
+			model: &MembersModel{
+				ResourceId: types.StringValue("rid"),
+				Role:       types.StringNull(),
+			},
+			expected: &MembersModel{
+				Id:         types.StringValue("rid"),
+				ResourceId: types.StringValue("rid"),
+				Role:       types.StringNull(),
+				Members: types.SetValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{
+					member(ownerRole, "a@stackit.cloud"),
+					member("reader", "b@stackit.cloud"),
+				}),
+			},
+		},
+		{
+			name: "single role",
+			input: &authorization.ListMembersResponse{
+				ResourceId: "rid",
+				Members: []authorization.Member{
+					{Role: ownerRole, Subject: "a@stackit.cloud"},
+					{Role: "reader", Subject: "b@stackit.cloud"},
+				},
+			},
+			model: &MembersModel{
+				ResourceId: types.StringValue("rid"),
+				Role:       types.StringValue("reader"),
+			},
+			expected: &MembersModel{
+				Id:         types.StringValue("rid,reader"),
+				ResourceId: types.StringValue("rid"),
+				Role:       types.StringValue("reader"),
+				Members: types.SetValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{
+					member("reader", "b@stackit.cloud"),
+				}),
+			},
+		},
+		{
+			name: "no members",
+			input: &authorization.ListMembersResponse{
+				ResourceId: "rid",
+			},
+			model: &MembersModel{
+				ResourceId: types.StringValue("rid"),
+				Role:       types.StringValue("reader"),
+			},
+			expected: &MembersModel{
+				Id:         types.StringValue("rid,reader"),
+				ResourceId: types.StringValue("rid"),
+				Role:       types.StringValue("reader"),
+				Members:    types.SetValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{}),
+			},
+		},
+		{
+			name:        "nil response",
+			input:       nil,
+			model:       &MembersModel{},
+			expectError: true,
+		},
+		{
+			name:        "nil model",
+			input:       &authorization.ListMembersResponse{},
+			model:       nil,
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mapMembersFields(tt.input, tt.model)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, tt.model); diff != "" {
+				t.Errorf("model mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestModifyPlanCallerOwner(t *testing.T) {
+	ctx := context.Background()
+	r := &roleAssignmentsResource{
+		apiName:      "project",
+		providerData: core.ProviderData{ServiceAccountEmail: callerEmail},
+	}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	membersModel := func(members ...authorization.Member) MembersModel {
+		values := []attr.Value{}
+		for _, m := range members {
+			values = append(values, types.ObjectValueMust(memberTypes, map[string]attr.Value{
+				"role":    types.StringValue(m.Role),
+				"subject": types.StringValue(m.Subject),
+			}))
+		}
+		return MembersModel{
+			Id:         types.StringValue("rid"),
+			ResourceId: types.StringValue("rid"),
+			Role:       types.StringNull(),
+			Members:    types.SetValueMust(types.ObjectType{AttrTypes: memberTypes}, values),
+		}
+	}
+	owner := authorization.Member{Role: ownerRole, Subject: "owner@stackit.cloud"}
+	callerOwner := authorization.Member{Role: ownerRole, Subject: callerEmail}
+
+	tests := []struct {
+		name        string
+		state       MembersModel
+		plan        MembersModel
+		expectError bool
+	}{
+		{
+			name:        "caller owner removed",
+			state:       membersModel(owner, callerOwner),
+			plan:        membersModel(owner),
+			expectError: true,
+		},
+		{
+			name:  "other owner removed",
+			state: membersModel(owner, callerOwner),
+			plan:  membersModel(callerOwner),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := state.Set(ctx, tt.state); diags.HasError() {
+				t.Fatalf("Setting state failed: %v", diags)
+			}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, tt.plan); diags.HasError() {
+				t.Fatalf("Setting plan failed: %v", diags)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+			if tt.expectError && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if !tt.expectError && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -69,7 +69,7 @@ var schemaDescriptions = map[string]string{
 	"config_backend_geofencing":                    "The configured type http to configure countries where content is allowed. A map of URLs to a list of countries",
 	"config_blocked_countries":                     "The configured countries where distribution of content is blocked",
 	"config_blocked_ips":                           "Restricts access to your content by specifying a list of blocked IPv4 addresses. This feature enhances security and privacy by preventing these addresses from accessing your distribution. Note: once a value is set, removing the attribute from your configuration will retain the last known value in state; to clear it explicitly, set it to an empty list.",
-	"config_default_cache_duration":                "Sets the default cache duration for the distribution. The default cache duration is applied when a 'Cache-Control' header is not presented in the origin's response. We use ISO8601 duration format for cache duration (e.g. P1DT2H30M). Note: once a value is set, removing the attribute from your configuration will retain the last known value in state.",
+	"config_default_cache_duration":                "Sets the default cache duration for the distribution. The default cache duration is applied when a 'Cache-Control' header is not presented in the origin's response. We use ISO8601 duration format for cache duration (e.g. P1DT2H30M). Note: once a value is set, removing the attribute from your configuration will retain the last known value in state. The cache duration applies to the whole distribution.",
 	"config_monthly_limit_bytes":                   "Sets the monthly limit of bandwidth in bytes that the pullzone is allowed to use. Note: once a value is set, removing the attribute from your configuration will retain the last known value in state.",
 	"config_redirects":                             "A wrapper for a list of redirect rules that allows for redirect settings on a distribution",
 	"config_redirects_rules":                       "A list of redirect rules. The order of rules matters for evaluation",
@@ -730,6 +730,8 @@ func (r *distributionResource) Schema(_ context.Context, _ resource.SchemaReques
 						Description: schemaDescriptions["config_blocked_ips"],
 						ElementType: types.StringType,
 					},
+					// the CDN API provides neither cache rules per path nor custom response headers (e.g. HSTS, CSP or CORS
+					// headers), so the cache duration can only be set for the whole distribution
 					"default_cache_duration": schema.StringAttribute{
 						Optional:    true,
 						Computed:    true,
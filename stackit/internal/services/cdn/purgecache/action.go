@@ -0,0 +1,212 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	cdnSdk "github.com/stackitcloud/stackit-sdk-go/services/cdn/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	cdnUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/cdn/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+const purgeTimeout = 15 * time.Minute
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &purgeCacheAction{}
+	_ action.ActionWithConfigure = &purgeCacheAction{}
+)
+
+type Model struct {
+	ProjectId      types.String `tfsdk:"project_id"`
+	DistributionId types.String `tfsdk:"distribution_id"`
+	Paths          types.List   `tfsdk:"paths"`
+}
+
+// NewPurgeCacheAction is a helper function to simplify the provider implementation.
+func NewPurgeCacheAction() action.Action {
+	return &purgeCacheAction{}
+}
+
+// purgeCacheAction is the action implementation.
+type purgeCacheAction struct {
+	client *cdnSdk.APIClient
+}
+
+// Metadata returns the action type name.
+func (a *purgeCacheAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdn_purge_cache"
+}
+
+// Configure adds the provider configured client to the action.
+func (a *purgeCacheAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_cdn_purge_cache", core.Action)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := cdnUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	a.client = apiClient
+	tflog.Info(ctx, "CDN client configured")
+}
+
+// Schema defines the schema for the action.
+func (a *purgeCacheAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "Purges the cache of a CDN distribution and waits until the purge is finished. " +
+			"Use it in an `action_trigger` of a `lifecycle` block, e.g. to purge the cache after the objects in the origin bucket were changed.",
+		"project_id":      "STACKIT project ID associated with the distribution.",
+		"distribution_id": "CDN distribution ID.",
+		"paths":           "Paths to purge, e.g. `/index.html` or `/assets/*`. If not set, the whole cache of the distribution is purged.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: features.AddBetaDescription(descriptions["main"], core.Action),
+		Description:         descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"distribution_id": schema.StringAttribute{
+				Description: descriptions["distribution_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"paths": schema.ListAttribute{
+				Description: descriptions["paths"],
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+		},
+	}
+}
+
+// Invoke purges the cache.
+func (a *purgeCacheAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	distributionId := model.DistributionId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "distribution_id", distributionId)
+
+	var paths []string
+	if !model.Paths.IsNull() && !model.Paths.IsUnknown() {
+		diags = model.Paths.ElementsAs(ctx, &paths, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := purgeCache(ctx, a.client.DefaultAPI, projectId, distributionId, paths, func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error purging CDN cache", err.Error())
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	tflog.Info(ctx, "CDN cache purged")
+}
+
+// purgeCache purges the given paths one after another. If no path is given, the whole cache is purged.
+// Each purge is awaited before the next one is started.
+func purgeCache(ctx context.Context, client cdnSdk.DefaultAPI, projectId, distributionId string, paths []string, progress func(string)) error {
+	// a nil path purges the whole cache
+	purgePaths := []*string{nil}
+	if len(paths) > 0 {
+		purgePaths = make([]*string, 0, len(paths))
+		for i := range paths {
+			purgePaths = append(purgePaths, &paths[i])
+		}
+	}
+
+	for _, path := range purgePaths {
+		target := "whole cache"
+		if path != nil {
+			target = fmt.Sprintf("path %q", *path)
+		}
+
+		cacheInfo, err := client.GetCacheInfo(ctx, projectId, distributionId).Execute()
+		if err != nil {
+			return fmt.Errorf("reading cache info: %w", err)
+		}
+		lastPurgeTime := cacheInfo.GetLastPurgeTime()
+
+		progress(fmt.Sprintf("Purging %s of CDN distribution %s", target, distributionId))
+		_, err = client.PurgeCache(ctx, projectId, distributionId).PurgeCachePayload(cdnSdk.PurgeCachePayload{Path: path}).Execute()
+		if err != nil {
+			return fmt.Errorf("purging %s: %w", target, err)
+		}
+
+		_, err = purgeCacheWaitHandler(ctx, client, projectId, distributionId, lastPurgeTime).WaitWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("waiting for purge of %s: %w", target, err)
+		}
+	}
+	progress(fmt.Sprintf("Purge of CDN distribution %s finished", distributionId))
+	return nil
+}
+
+// purgeCacheWaitHandler waits until the last purge time of the distribution is after the given time.
+// Comparing with a time reported by the API avoids problems with clock skew of the local machine.
+func purgeCacheWaitHandler(ctx context.Context, client cdnSdk.DefaultAPI, projectId, distributionId string, lastPurgeTime time.Time) *wait.AsyncActionHandler[cdnSdk.GetCacheInfoResponse] {
+	handler := wait.New(func() (waitFinished bool, response *cdnSdk.GetCacheInfoResponse, err error) {
+		cacheInfo, err := client.GetCacheInfo(ctx, projectId, distributionId).Execute()
+		if err != nil {
+			return false, nil, err
+		}
+		if cacheInfo.GetLastPurgeTime().After(lastPurgeTime) {
+			return true, cacheInfo, nil
+		}
+		return false, cacheInfo, nil
+	})
+	handler.SetTimeout(purgeTimeout)
+	return handler
+}
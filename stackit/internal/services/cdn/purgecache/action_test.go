@@ -0,0 +1,95 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	cdnSdk "github.com/stackitcloud/stackit-sdk-go/services/cdn/v1api"
+)
+
+func TestPurgeCache(t *testing.T) {
+	const (
+		projectId      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+		distributionId = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+	)
+
+	tests := []struct {
+		description      string
+		paths            []string
+		getError         error
+		purgeError       error
+		expectedPurges   int
+		expectedMessages int
+		expectError      bool
+	}{
+		{
+			description:      "whole cache",
+			expectedPurges:   1,
+			expectedMessages: 2,
+		},
+		{
+			description:      "paths",
+			paths:            []string{"/index.html", "/assets/*"},
+			expectedPurges:   2,
+			expectedMessages: 3,
+		},
+		{
+			description: "get error",
+			getError:    fmt.Errorf("get error"),
+			expectError: true,
+		},
+		{
+			description:      "purge error",
+			paths:            []string{"/index.html"},
+			purgeError:       fmt.Errorf("purge error"),
+			expectedPurges:   1,
+			expectedMessages: 1,
+			expectError:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			lastPurgeTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			purges := 0
+
+			getCacheInfoFn := func(_ cdnSdk.ApiGetCacheInfoRequest) (*cdnSdk.GetCacheInfoResponse, error) {
+				if tt.getError != nil {
+					return nil, tt.getError
+				}
+				resp := &cdnSdk.GetCacheInfoResponse{}
+				resp.SetLastPurgeTime(lastPurgeTime)
+				return resp, nil
+			}
+			purgeCacheFn := func(_ cdnSdk.ApiPurgeCacheRequest) (map[string]interface{}, error) {
+				purges++
+				if tt.purgeError != nil {
+					return nil, tt.purgeError
+				}
+				// the API finishes the purge immediately
+				lastPurgeTime = lastPurgeTime.Add(time.Minute)
+				return map[string]interface{}{}, nil
+			}
+			client := &cdnSdk.DefaultAPIServiceMock{
+				GetCacheInfoExecuteMock: &getCacheInfoFn,
+				PurgeCacheExecuteMock:   &purgeCacheFn,
+			}
+
+			messages := 0
+			err := purgeCache(context.Background(), client, projectId, distributionId, tt.paths, func(_ string) {
+				messages++
+			})
+			if (err != nil) != tt.expectError {
+				t.Fatalf("purgeCache() error = %v, expectError %v", err, tt.expectError)
+			}
+			if purges != tt.expectedPurges {
+				t.Errorf("expected %d purges, got %d", tt.expectedPurges, purges)
+			}
+			if messages != tt.expectedMessages {
+				t.Errorf("expected %d progress messages, got %d", tt.expectedMessages, messages)
+			}
+		})
+	}
+}
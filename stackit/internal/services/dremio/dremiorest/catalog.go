@@ -0,0 +1,97 @@
+package dremiorest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+const (
+	EntityTypeSource = "source"
+	EntityTypeSpace  = "space"
+
+	GranteeTypeUser = "USER"
+	GranteeTypeRole = "ROLE"
+)
+
+// CatalogEntity is a source or space in the catalog of the Dremio instance.
+type CatalogEntity struct {
+	EntityType string `json:"entityType"`
+	Id         string `json:"id,omitempty"`
+	Name       string `json:"name"`
+	// Tag is the version of the entity. Updates and deletions must pass the current tag.
+	Tag string `json:"tag,omitempty"`
+	// Type and Config are only set for sources. Secret values in the config are masked in responses.
+	Type   string          `json:"type,omitempty"`
+	Config json.RawMessage `json:"config,omitempty"`
+}
+
+// Grant are the privileges of a user or role on a catalog entity.
+type Grant struct {
+	GranteeType string   `json:"granteeType"`
+	Id          string   `json:"id"`
+	Name        string   `json:"name,omitempty"`
+	Privileges  []string `json:"privileges"`
+}
+
+// Grants are all grants on a catalog entity.
+type Grants struct {
+	Id                  string   `json:"id,omitempty"`
+	AvailablePrivileges []string `json:"availablePrivileges,omitempty"`
+	Grants              []Grant  `json:"grants"`
+}
+
+// CreateCatalogEntity creates a source or space.
+func (c *Client) CreateCatalogEntity(ctx context.Context, entity *CatalogEntity) (*CatalogEntity, error) {
+	var created CatalogEntity
+	err := c.do(ctx, http.MethodPost, "/catalog", entity, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetCatalogEntity reads a source or space.
+func (c *Client) GetCatalogEntity(ctx context.Context, id string) (*CatalogEntity, error) {
+	var entity CatalogEntity
+	err := c.do(ctx, http.MethodGet, escapePath("catalog", id), nil, &entity)
+	if err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// UpdateCatalogEntity updates a source. The tag of the entity must match the current version.
+func (c *Client) UpdateCatalogEntity(ctx context.Context, entity *CatalogEntity) (*CatalogEntity, error) {
+	var updated CatalogEntity
+	err := c.do(ctx, http.MethodPut, escapePath("catalog", entity.Id), entity, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteCatalogEntity deletes a source or space, including all of its contents.
+func (c *Client) DeleteCatalogEntity(ctx context.Context, id, tag string) error {
+	path := escapePath("catalog", id)
+	if tag != "" {
+		path += "?" + url.Values{"tag": {tag}}.Encode()
+	}
+	return c.do(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// GetGrants reads all grants on a catalog entity.
+func (c *Client) GetGrants(ctx context.Context, id string) (*Grants, error) {
+	var grants Grants
+	err := c.do(ctx, http.MethodGet, escapePath("catalog", id, "grants"), nil, &grants)
+	if err != nil {
+		return nil, err
+	}
+	return &grants, nil
+}
+
+// SetGrants replaces all grants on a catalog entity.
+func (c *Client) SetGrants(ctx context.Context, id string, grants []Grant) error {
+	return c.do(ctx, http.MethodPut, escapePath("catalog", id, "grants"), &Grants{Grants: grants}, nil)
+}
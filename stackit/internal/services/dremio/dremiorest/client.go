@@ -0,0 +1,218 @@
+// Package dremiorest implements a minimal client for the REST API of STACKIT Dremio instances.
+// The API is served at /api/v3 below the UI endpoint of the instance. Requests are authenticated with a Dremio access token,
+// which is obtained by exchanging a token of the OAuth identity provider configured for the instance.
+package dremiorest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	apiPath        = "/api/v3"
+	tokenPath      = "/oauth/token"
+	discoveryPath  = "/.well-known/openid-configuration"
+	defaultTimeout = 30 * time.Second
+
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	jwtTokenType           = "urn:ietf:params:oauth:token-type:jwt"
+	dremioScope            = "dremio.all"
+)
+
+// Error is returned for failed requests to the Dremio API or the identity provider.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("Dremio API returned status code %d", e.StatusCode)
+	}
+	return fmt.Sprintf("Dremio API returned status code %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound returns whether the error is a 404 response of the Dremio API.
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// OAuthConfig are the settings of the OAuth client, which is used to authenticate against the Dremio instance.
+// They correspond to the `authentication.oauth` settings of the instance. The client must be allowed to use the client credentials grant.
+type OAuthConfig struct {
+	AuthorityURL string
+	ClientID     string
+	ClientSecret string
+	// Scope is requested from the identity provider. If empty, "openid" is used.
+	Scope string
+}
+
+type Client struct {
+	instanceURL string
+	oauth       OAuthConfig
+	httpClient  *http.Client
+	token       string
+}
+
+// NewClient creates a client for the Dremio instance at instanceURL, which authenticates with the given OAuth client.
+// If httpClient is nil, a client with a default timeout is used.
+func NewClient(instanceURL string, oauth OAuthConfig, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Client{
+		instanceURL: strings.TrimSuffix(instanceURL, "/"),
+		oauth:       oauth,
+		httpClient:  httpClient,
+	}
+}
+
+// escapePath joins the path segments, escaping each of them.
+func escapePath(segments ...string) string {
+	escaped := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		escaped = append(escaped, url.PathEscape(segment))
+	}
+	return "/" + strings.Join(escaped, "/")
+}
+
+// accessToken returns the Dremio access token, requesting it on first use.
+func (c *Client) accessToken(ctx context.Context) (string, error) {
+	if c.token != "" {
+		return c.token, nil
+	}
+
+	var discovery struct {
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+	err := c.send(ctx, http.MethodGet, strings.TrimSuffix(c.oauth.AuthorityURL, "/")+discoveryPath, nil, "", &discovery)
+	if err != nil {
+		return "", fmt.Errorf("discovering identity provider: %w", err)
+	}
+	if discovery.TokenEndpoint == "" {
+		return "", fmt.Errorf("discovering identity provider: no token endpoint in OpenID configuration")
+	}
+
+	scope := c.oauth.Scope
+	if scope == "" {
+		scope = "openid"
+	}
+	var idpToken tokenResponse
+	err = c.postForm(ctx, discovery.TokenEndpoint, url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {c.oauth.ClientID},
+		"client_secret": {c.oauth.ClientSecret},
+		"scope":         {scope},
+	}, &idpToken)
+	if err != nil {
+		return "", fmt.Errorf("requesting token from identity provider: %w", err)
+	}
+	if idpToken.AccessToken == "" {
+		return "", fmt.Errorf("requesting token from identity provider: no access token in response")
+	}
+
+	var dremioToken tokenResponse
+	err = c.postForm(ctx, c.instanceURL+tokenPath, url.Values{
+		"grant_type":         {tokenExchangeGrantType},
+		"subject_token":      {idpToken.AccessToken},
+		"subject_token_type": {jwtTokenType},
+		"scope":              {dremioScope},
+	}, &dremioToken)
+	if err != nil {
+		return "", fmt.Errorf("exchanging token with Dremio: %w", err)
+	}
+	if dremioToken.AccessToken == "" {
+		return "", fmt.Errorf("exchanging token with Dremio: no access token in response")
+	}
+
+	c.token = dremioToken.AccessToken
+	return c.token, nil
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+}
+
+func (c *Client) postForm(ctx context.Context, endpoint string, form url.Values, out any) error {
+	return c.send(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()), "application/x-www-form-urlencoded", out)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	var reqBody io.Reader
+	contentType := ""
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+		contentType = "application/json"
+	}
+	return c.sendWithToken(ctx, method, c.instanceURL+apiPath+path, reqBody, contentType, token, out)
+}
+
+func (c *Client) send(ctx context.Context, method, endpoint string, body io.Reader, contentType string, out any) error {
+	return c.sendWithToken(ctx, method, endpoint, body, contentType, "", out)
+}
+
+func (c *Client) sendWithToken(ctx context.Context, method, endpoint string, body io.Reader, contentType, token string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling %s: %w", endpoint, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &Error{StatusCode: resp.StatusCode}
+		var errResp struct {
+			ErrorMessage     string `json:"errorMessage"`
+			ErrorDescription string `json:"error_description"`
+		}
+		if json.Unmarshal(respBody, &errResp) == nil {
+			apiErr.Message = errResp.ErrorMessage
+			if apiErr.Message == "" {
+				apiErr.Message = errResp.ErrorDescription
+			}
+		}
+		return apiErr
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package dremiorest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest/dremiotest"
+)
+
+const (
+	clientId     = "terraform"
+	clientSecret = "client-secret"
+)
+
+func newClient(server *dremiotest.Server) *dremiorest.Client {
+	return dremiorest.NewClient(server.URL+"/", dremiorest.OAuthConfig{
+		AuthorityURL: server.AuthorityURL(),
+		ClientID:     clientId,
+		ClientSecret: clientSecret,
+	}, server.Client())
+}
+
+func isStatus(err error, statusCode int) bool {
+	var apiErr *dremiorest.Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+func TestAuthentication(t *testing.T) {
+	server := dremiotest.NewServer(clientId, clientSecret)
+	defer server.Close()
+
+	tests := []struct {
+		description  string
+		authorityURL string
+		clientSecret string
+		isValid      bool
+	}{
+		{"valid client", server.AuthorityURL(), clientSecret, true},
+		{"wrong secret", server.AuthorityURL(), "wrong", false},
+		{"unknown identity provider", server.URL + "/unknown", clientSecret, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := dremiorest.NewClient(server.URL, dremiorest.OAuthConfig{
+				AuthorityURL: tt.authorityURL,
+				ClientID:     clientId,
+				ClientSecret: tt.clientSecret,
+				Scope:        "openid profile",
+			}, nil)
+			_, err := client.GetRole(context.Background(), "unknown")
+			if !tt.isValid && (err == nil || dremiorest.IsNotFound(err)) {
+				t.Fatalf("Expected authentication error, got: %v", err)
+			}
+			if tt.isValid && !dremiorest.IsNotFound(err) {
+				t.Fatalf("Expected not found, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestSourceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server := dremiotest.NewServer(clientId, clientSecret)
+	defer server.Close()
+	client := newClient(server)
+
+	created, err := client.CreateCatalogEntity(ctx, &dremiorest.CatalogEntity{
+		EntityType: dremiorest.EntityTypeSource,
+		Name:       "lakehouse",
+		Type:       "S3",
+		Config:     json.RawMessage(`{"accessKey":"key","secretAccessKey":"secret"}`),
+	})
+	if err != nil {
+		t.Fatalf("Creating source: %v", err)
+	}
+	if created.Id == "" || created.Tag == "" {
+		t.Fatalf("Expected ID and tag, got: %+v", created)
+	}
+
+	got, err := client.GetCatalogEntity(ctx, created.Id)
+	if err != nil {
+		t.Fatalf("Reading source: %v", err)
+	}
+	var config map[string]any
+	if err := json.Unmarshal(got.Config, &config); err != nil {
+		t.Fatalf("Decoding config: %v", err)
+	}
+	if diff := cmp.Diff(map[string]any{"accessKey": "key", "secretAccessKey": "$DREMIO_EXISTING_VALUE$"}, config); diff != "" {
+		t.Fatalf("Config does not match: %s", diff)
+	}
+
+	_, err = client.CreateCatalogEntity(ctx, &dremiorest.CatalogEntity{
+		EntityType: dremiorest.EntityTypeSource,
+		Name:       "lakehouse",
+		Type:       "S3",
+	})
+	if !isStatus(err, 409) {
+		t.Fatalf("Expected conflict for duplicate name, got: %v", err)
+	}
+
+	updated, err := client.UpdateCatalogEntity(ctx, &dremiorest.CatalogEntity{
+		EntityType: dremiorest.EntityTypeSource,
+		Id:         got.Id,
+		Name:       got.Name,
+		Tag:        got.Tag,
+		Type:       got.Type,
+		Config:     json.RawMessage(`{"accessKey":"key2","secretAccessKey":"secret2"}`),
+	})
+	if err != nil {
+		t.Fatalf("Updating source: %v", err)
+	}
+	if updated.Tag == got.Tag {
+		t.Fatalf("Expected new tag after update")
+	}
+	stored, _ := server.SourceConfig(created.Id)
+	if diff := cmp.Diff(map[string]any{"accessKey": "key2", "secretAccessKey": "secret2"}, stored); diff != "" {
+		t.Fatalf("Stored config does not match: %s", diff)
+	}
+
+	_, err = client.UpdateCatalogEntity(ctx, &dremiorest.CatalogEntity{
+		EntityType: dremiorest.EntityTypeSource,
+		Id:         got.Id,
+		Name:       got.Name,
+		Tag:        got.Tag,
+		Type:       got.Type,
+	})
+	if !isStatus(err, 409) {
+		t.Fatalf("Expected conflict for outdated tag, got: %v", err)
+	}
+
+	if err := client.DeleteCatalogEntity(ctx, created.Id, updated.Tag); err != nil {
+		t.Fatalf("Deleting source: %v", err)
+	}
+	if _, err := client.GetCatalogEntity(ctx, created.Id); !dremiorest.IsNotFound(err) {
+		t.Fatalf("Expected not found after deletion, got: %v", err)
+	}
+}
+
+func TestSpaceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server := dremiotest.NewServer(clientId, clientSecret)
+	defer server.Close()
+	client := newClient(server)
+
+	created, err := client.CreateCatalogEntity(ctx, &dremiorest.CatalogEntity{
+		EntityType: dremiorest.EntityTypeSpace,
+		Name:       "analytics",
+	})
+	if err != nil {
+		t.Fatalf("Creating space: %v", err)
+	}
+
+	got, err := client.GetCatalogEntity(ctx, created.Id)
+	if err != nil {
+		t.Fatalf("Reading space: %v", err)
+	}
+	if got.EntityType != dremiorest.EntityTypeSpace || got.Name != "analytics" {
+		t.Fatalf("Unexpected space: %+v", got)
+	}
+
+	if err := client.DeleteCatalogEntity(ctx, created.Id, ""); err != nil {
+		t.Fatalf("Deleting space: %v", err)
+	}
+	if _, err := client.GetCatalogEntity(ctx, created.Id); !dremiorest.IsNotFound(err) {
+		t.Fatalf("Expected not found after deletion, got: %v", err)
+	}
+}
+
+func TestRoleAndGrants(t *testing.T) {
+	ctx := context.Background()
+	server := dremiotest.NewServer(clientId, clientSecret)
+	defer server.Close()
+	client := newClient(server)
+
+	role, err := client.CreateRole(ctx, &dremiorest.Role{Name: "analysts", Description: "read only"})
+	if err != nil {
+		t.Fatalf("Creating role: %v", err)
+	}
+	role.Name = "data-analysts"
+	role.Description = ""
+	updated, err := client.UpdateRole(ctx, role)
+	if err != nil {
+		t.Fatalf("Updating role: %v", err)
+	}
+	if diff := cmp.Diff(&dremiorest.Role{Id: role.Id, Name: "data-analysts", Type: "INTERNAL"}, updated); diff != "" {
+		t.Fatalf("Role does not match: %s", diff)
+	}
+
+	space, err := client.CreateCatalogEntity(ctx, &dremiorest.CatalogEntity{EntityType: dremiorest.EntityTypeSpace, Name: "analytics"})
+	if err != nil {
+		t.Fatalf("Creating space: %v", err)
+	}
+
+	grants := []dremiorest.Grant{
+		{GranteeType: dremiorest.GranteeTypeRole, Id: role.Id, Privileges: []string{"SELECT"}},
+		{GranteeType: dremiorest.GranteeTypeUser, Id: "user-1", Privileges: []string{"ALTER", "SELECT"}},
+	}
+	if err := client.SetGrants(ctx, space.Id, grants); err != nil {
+		t.Fatalf("Setting grants: %v", err)
+	}
+	got, err := client.GetGrants(ctx, space.Id)
+	if err != nil {
+		t.Fatalf("Reading grants: %v", err)
+	}
+	if diff := cmp.Diff(grants, got.Grants); diff != "" {
+		t.Fatalf("Grants do not match: %s", diff)
+	}
+
+	err = client.SetGrants(ctx, space.Id, []dremiorest.Grant{{GranteeType: dremiorest.GranteeTypeRole, Id: "unknown", Privileges: []string{"SELECT"}}})
+	if !isStatus(err, 400) {
+		t.Fatalf("Expected bad request for unknown role, got: %v", err)
+	}
+
+	if err := client.DeleteRole(ctx, role.Id); err != nil {
+		t.Fatalf("Deleting role: %v", err)
+	}
+	got, err = client.GetGrants(ctx, space.Id)
+	if err != nil {
+		t.Fatalf("Reading grants: %v", err)
+	}
+	if diff := cmp.Diff(grants[1:], got.Grants); diff != "" {
+		t.Fatalf("Grants of deleted role were not removed: %s", diff)
+	}
+	if _, err := client.GetRole(ctx, role.Id); !dremiorest.IsNotFound(err) {
+		t.Fatalf("Expected not found after deletion, got: %v", err)
+	}
+}
@@ -0,0 +1,406 @@
+// Package dremiotest provides an in-memory stand-in for the REST API of STACKIT Dremio instances and their OAuth identity provider, to be used in tests.
+package dremiotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const (
+	idpPath     = "/idp"
+	idpToken    = "idp-access-token"
+	dremioToken = "dremio-access-token"
+
+	// maskedValue replaces secret values of source configs in responses.
+	maskedValue = "$DREMIO_EXISTING_VALUE$"
+)
+
+// secretConfigKeys are the keys of source configs, whose values are masked in responses.
+var secretConfigKeys = []string{"secretAccessKey", "password"}
+
+type entity struct {
+	EntityType string         `json:"entityType"`
+	Id         string         `json:"id"`
+	Name       string         `json:"name"`
+	Tag        string         `json:"tag"`
+	Type       string         `json:"type,omitempty"`
+	Config     map[string]any `json:"config,omitempty"`
+	version    int
+}
+
+type grant struct {
+	GranteeType string   `json:"granteeType"`
+	Id          string   `json:"id"`
+	Privileges  []string `json:"privileges"`
+}
+
+type role struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+// Server is an in-memory Dremio API with sources, spaces, grants and roles.
+// Requests must be authenticated with a Dremio token, which is issued in exchange for a client credentials token of the identity provider.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	clientId     string
+	clientSecret string
+	nextId       int
+	entities     map[string]*entity
+	grants       map[string][]grant
+	roles        map[string]*role
+}
+
+// NewServer starts a stand-in server whose identity provider accepts the given OAuth client.
+// The caller must call Close when finished.
+func NewServer(clientId, clientSecret string) *Server {
+	s := &Server{
+		clientId:     clientId,
+		clientSecret: clientSecret,
+		entities:     map[string]*entity{},
+		grants:       map[string][]grant{},
+		roles:        map[string]*role{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// AuthorityURL returns the URL of the identity provider of the server.
+func (s *Server) AuthorityURL() string {
+	return s.URL + idpPath
+}
+
+// SourceConfig returns the unmasked config of a source.
+func (s *Server) SourceConfig(id string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entities[id]
+	if !ok {
+		return nil, false
+	}
+	return e.Config, true
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.URL.Path == idpPath+"/.well-known/openid-configuration" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]string{"token_endpoint": s.URL + idpPath + "/token"})
+		return
+	case r.URL.Path == idpPath+"/token" && r.Method == http.MethodPost:
+		s.issueIdpToken(w, r)
+		return
+	case r.URL.Path == "/oauth/token" && r.Method == http.MethodPost:
+		s.exchangeToken(w, r)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+dremioToken {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	p, ok := strings.CutPrefix(r.URL.EscapedPath(), "/api/v3/")
+	if !ok {
+		writeError(w, http.StatusNotFound, "")
+		return
+	}
+	segments := strings.Split(p, "/")
+	for i := range segments {
+		segment, err := url.PathUnescape(segments[i])
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		segments[i] = segment
+	}
+
+	switch {
+	case match(segments, "catalog") && r.Method == http.MethodPost:
+		s.createEntity(w, r)
+	case match(segments, "catalog", "*"):
+		s.handleEntity(w, r, segments[1])
+	case match(segments, "catalog", "*", "grants"):
+		s.handleGrants(w, r, segments[1])
+	case match(segments, "role") && r.Method == http.MethodPost:
+		s.createRole(w, r)
+	case match(segments, "role", "*"):
+		s.handleRole(w, r, segments[1])
+	default:
+		writeError(w, http.StatusNotFound, "")
+	}
+}
+
+// match returns whether the path segments match the pattern, where "*" matches any segment.
+func match(segments []string, pattern ...string) bool {
+	if len(segments) != len(pattern) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != "*" && pattern[i] != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) id() string {
+	s.nextId++
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", s.nextId)
+}
+
+func (s *Server) issueIdpToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if r.PostForm.Get("grant_type") != "client_credentials" {
+		writeOAuthError(w, http.StatusBadRequest, "unsupported grant type")
+		return
+	}
+	if r.PostForm.Get("client_id") != s.clientId || r.PostForm.Get("client_secret") != s.clientSecret {
+		writeOAuthError(w, http.StatusUnauthorized, "invalid client credentials")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"access_token": idpToken, "token_type": "Bearer"})
+}
+
+func (s *Server) exchangeToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if r.PostForm.Get("grant_type") != "urn:ietf:params:oauth:grant-type:token-exchange" ||
+		r.PostForm.Get("subject_token_type") != "urn:ietf:params:oauth:token-type:jwt" ||
+		r.PostForm.Get("scope") != "dremio.all" {
+		writeOAuthError(w, http.StatusBadRequest, "invalid token exchange request")
+		return
+	}
+	if r.PostForm.Get("subject_token") != idpToken {
+		writeOAuthError(w, http.StatusUnauthorized, "invalid subject token")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"access_token": dremioToken, "token_type": "Bearer"})
+}
+
+func (s *Server) createEntity(w http.ResponseWriter, r *http.Request) {
+	var e entity
+	if !decode(w, r, &e) {
+		return
+	}
+	if e.EntityType != "source" && e.EntityType != "space" {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported entity type %q", e.EntityType))
+		return
+	}
+	if e.Name == "" || e.EntityType == "source" && e.Type == "" {
+		writeError(w, http.StatusBadRequest, "name and source type are required")
+		return
+	}
+	for _, existing := range s.entities {
+		if strings.EqualFold(existing.Name, e.Name) {
+			writeError(w, http.StatusConflict, fmt.Sprintf("an entity named %q already exists", e.Name))
+			return
+		}
+	}
+	e.Id = s.id()
+	e.version = 1
+	e.Tag = fmt.Sprintf("tag-%d", e.version)
+	s.entities[e.Id] = &e
+	writeJSON(w, http.StatusOK, e.masked())
+}
+
+func (s *Server) handleEntity(w http.ResponseWriter, r *http.Request, id string) {
+	e, ok := s.entities[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("entity %q does not exist", id))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, e.masked())
+	case http.MethodPut:
+		var update entity
+		if !decode(w, r, &update) {
+			return
+		}
+		if e.EntityType != "source" {
+			writeError(w, http.StatusBadRequest, "only sources can be updated")
+			return
+		}
+		if update.Tag != e.Tag {
+			writeError(w, http.StatusConflict, "tag does not match the current version")
+			return
+		}
+		if update.Name != e.Name || update.Type != e.Type {
+			writeError(w, http.StatusBadRequest, "name and type of a source can't be changed")
+			return
+		}
+		for key, value := range update.Config {
+			if value == maskedValue {
+				update.Config[key] = e.Config[key]
+			}
+		}
+		e.Config = update.Config
+		e.version++
+		e.Tag = fmt.Sprintf("tag-%d", e.version)
+		writeJSON(w, http.StatusOK, e.masked())
+	case http.MethodDelete:
+		if tag := r.URL.Query().Get("tag"); tag != "" && tag != e.Tag {
+			writeError(w, http.StatusConflict, "tag does not match the current version")
+			return
+		}
+		delete(s.entities, id)
+		delete(s.grants, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+// masked returns a copy of the entity with masked secret config values.
+func (e *entity) masked() *entity {
+	c := *e
+	if e.Config != nil {
+		c.Config = make(map[string]any, len(e.Config))
+		for key, value := range e.Config {
+			c.Config[key] = value
+		}
+		for _, key := range secretConfigKeys {
+			if _, ok := c.Config[key]; ok {
+				c.Config[key] = maskedValue
+			}
+		}
+	}
+	return &c
+}
+
+func (s *Server) handleGrants(w http.ResponseWriter, r *http.Request, id string) {
+	if _, ok := s.entities[id]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("entity %q does not exist", id))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		grants := s.grants[id]
+		if grants == nil {
+			grants = []grant{}
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"id":                  id,
+			"availablePrivileges": []string{"ALTER", "SELECT", "MODIFY", "MANAGE_GRANTS"},
+			"grants":              grants,
+		})
+	case http.MethodPut:
+		var body struct {
+			Grants []grant `json:"grants"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+		for _, g := range body.Grants {
+			if g.GranteeType != "USER" && g.GranteeType != "ROLE" {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported grantee type %q", g.GranteeType))
+				return
+			}
+			if g.GranteeType == "ROLE" && s.roles[g.Id] == nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("role %q does not exist", g.Id))
+				return
+			}
+		}
+		s.grants[id] = body.Grants
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+func (s *Server) createRole(w http.ResponseWriter, r *http.Request) {
+	var ro role
+	if !decode(w, r, &ro) {
+		return
+	}
+	if ro.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+	for _, existing := range s.roles {
+		if existing.Name == ro.Name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("role %q already exists", ro.Name))
+			return
+		}
+	}
+	ro.Id = s.id()
+	ro.Type = "INTERNAL"
+	s.roles[ro.Id] = &ro
+	writeJSON(w, http.StatusOK, ro)
+}
+
+func (s *Server) handleRole(w http.ResponseWriter, r *http.Request, id string) {
+	ro, ok := s.roles[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("role %q does not exist", id))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, ro)
+	case http.MethodPut:
+		var update role
+		if !decode(w, r, &update) {
+			return
+		}
+		ro.Name = update.Name
+		ro.Description = update.Description
+		writeJSON(w, http.StatusOK, ro)
+	case http.MethodDelete:
+		delete(s.roles, id)
+		for entityId, grants := range s.grants {
+			kept := grants[:0]
+			for _, g := range grants {
+				if g.GranteeType != "ROLE" || g.Id != id {
+					kept = append(kept, g)
+				}
+			}
+			s.grants[entityId] = kept
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+func decode(w http.ResponseWriter, r *http.Request, body any) bool {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	if message == "" {
+		message = http.StatusText(statusCode)
+	}
+	writeJSON(w, statusCode, map[string]any{"errorMessage": message})
+}
+
+func writeOAuthError(w http.ResponseWriter, statusCode int, description string) {
+	writeJSON(w, statusCode, map[string]string{"error": "invalid_request", "error_description": description})
+}
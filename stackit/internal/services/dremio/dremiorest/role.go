@@ -0,0 +1,49 @@
+package dremiorest
+
+import (
+	"context"
+	"net/http"
+)
+
+// Role is an internal role of the Dremio instance.
+type Role struct {
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description"`
+}
+
+// CreateRole creates an internal role.
+func (c *Client) CreateRole(ctx context.Context, role *Role) (*Role, error) {
+	var created Role
+	err := c.do(ctx, http.MethodPost, "/role", role, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetRole reads a role.
+func (c *Client) GetRole(ctx context.Context, id string) (*Role, error) {
+	var role Role
+	err := c.do(ctx, http.MethodGet, escapePath("role", id), nil, &role)
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// UpdateRole updates the name and description of a role.
+func (c *Client) UpdateRole(ctx context.Context, role *Role) (*Role, error) {
+	var updated Role
+	err := c.do(ctx, http.MethodPut, escapePath("role", role.Id), role, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteRole deletes a role. Grants of the role are removed as well.
+func (c *Client) DeleteRole(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, escapePath("role", id), nil, nil)
+}
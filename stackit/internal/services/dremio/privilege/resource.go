@@ -0,0 +1,382 @@
+package dremio
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest"
+	dremioUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/utils"
+)
+
+var (
+	_ resource.Resource              = &privilegeResource{}
+	_ resource.ResourceWithConfigure = &privilegeResource{}
+)
+
+type Model struct {
+	Id             types.String                         `tfsdk:"id"`
+	Url            types.String                         `tfsdk:"url"`
+	Authentication *dremioUtils.RESTAuthenticationModel `tfsdk:"authentication"`
+
+	CatalogId   types.String `tfsdk:"catalog_id"`
+	GranteeType types.String `tfsdk:"grantee_type"`
+	GranteeId   types.String `tfsdk:"grantee_id"`
+	Privileges  types.Set    `tfsdk:"privileges"`
+}
+
+var granteeTypes = []string{dremiorest.GranteeTypeUser, dremiorest.GranteeTypeRole}
+
+var descriptions = map[string]string{
+	"main": "Manages the privileges of a user or role on a source or space of a STACKIT Dremio instance. " +
+		"Grants of other users and roles on the same catalog entity are kept.",
+	"id":           "Terraform's internal resource identifier. It is structured as \"`catalog_id`,`grantee_type`,`grantee_id`\".",
+	"catalog_id":   "The ID of the source or space in the Dremio catalog, e.g. from `stackit_dremio_source.source_id`.",
+	"grantee_type": "The type of the grantee. " + utils.FormatPossibleValues(granteeTypes...),
+	"grantee_id":   "The ID of the user or role the privileges are granted to, e.g. from `stackit_dremio_role.role_id`.",
+	"privileges":   "The privileges granted on the catalog entity, e.g. `SELECT`, `ALTER` or `MANAGE_GRANTS`. The available privileges depend on the type of the catalog entity.",
+}
+
+func NewPrivilegeResource() resource.Resource {
+	return &privilegeResource{}
+}
+
+type privilegeResource struct{}
+
+func (r *privilegeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dremio_privilege"
+}
+
+func (r *privilegeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckExperimentEnabled(ctx, &providerData, features.DremioExperiment, "stackit_dremio_privilege", core.Resource, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio privilege configured")
+}
+
+func (r *privilegeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := dremioUtils.RESTAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: descriptions["id"],
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["catalog_id"] = schema.StringAttribute{
+		Description: descriptions["catalog_id"],
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["grantee_type"] = schema.StringAttribute{
+		Description: descriptions["grantee_type"],
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.OneOf(granteeTypes...),
+		},
+	}
+	attributes["grantee_id"] = schema.StringAttribute{
+		Description: descriptions["grantee_id"],
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["privileges"] = schema.SetAttribute{
+		Description: descriptions["privileges"],
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: features.AddExperimentDescription(descriptions["main"], features.DremioExperiment, core.Resource),
+		Attributes:  attributes,
+	}
+}
+
+func (r *privilegeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	catalogId := model.CatalogId.ValueString()
+	ctx = tflog.SetField(ctx, "catalog_id", catalogId)
+	ctx = tflog.SetField(ctx, "grantee_type", model.GranteeType.ValueString())
+	ctx = tflog.SetField(ctx, "grantee_id", model.GranteeId.ValueString())
+
+	grant, err := toGrant(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Dremio privilege", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	unlock := dremioUtils.LockGrants(model.Url.ValueString(), catalogId)
+	defer unlock()
+
+	grants, err := client.GetGrants(ctx, catalogId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Dremio privilege", fmt.Sprintf("Reading grants: %v", err))
+		return
+	}
+	err = client.SetGrants(ctx, catalogId, setGrant(grants.Grants, grant))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Dremio privilege", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(grant, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Dremio privilege", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio privilege created")
+}
+
+func (r *privilegeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	catalogId := model.CatalogId.ValueString()
+	granteeType := model.GranteeType.ValueString()
+	granteeId := model.GranteeId.ValueString()
+	ctx = tflog.SetField(ctx, "catalog_id", catalogId)
+	ctx = tflog.SetField(ctx, "grantee_type", granteeType)
+	ctx = tflog.SetField(ctx, "grantee_id", granteeId)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	grants, err := client.GetGrants(ctx, catalogId)
+	if err != nil {
+		if dremiorest.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Dremio privilege", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	i := findGrant(grants.Grants, granteeType, granteeId)
+	if i < 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapFields(&grants.Grants[i], &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Dremio privilege", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio privilege read")
+}
+
+func (r *privilegeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	catalogId := model.CatalogId.ValueString()
+	ctx = tflog.SetField(ctx, "catalog_id", catalogId)
+	ctx = tflog.SetField(ctx, "grantee_type", model.GranteeType.ValueString())
+	ctx = tflog.SetField(ctx, "grantee_id", model.GranteeId.ValueString())
+
+	grant, err := toGrant(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Dremio privilege", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	unlock := dremioUtils.LockGrants(model.Url.ValueString(), catalogId)
+	defer unlock()
+
+	grants, err := client.GetGrants(ctx, catalogId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Dremio privilege", fmt.Sprintf("Reading grants: %v", err))
+		return
+	}
+	err = client.SetGrants(ctx, catalogId, setGrant(grants.Grants, grant))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Dremio privilege", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(grant, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Dremio privilege", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio privilege updated")
+}
+
+func (r *privilegeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	catalogId := model.CatalogId.ValueString()
+	granteeType := model.GranteeType.ValueString()
+	granteeId := model.GranteeId.ValueString()
+	ctx = tflog.SetField(ctx, "catalog_id", catalogId)
+	ctx = tflog.SetField(ctx, "grantee_type", granteeType)
+	ctx = tflog.SetField(ctx, "grantee_id", granteeId)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	unlock := dremioUtils.LockGrants(model.Url.ValueString(), catalogId)
+	defer unlock()
+
+	grants, err := client.GetGrants(ctx, catalogId)
+	if err == nil && findGrant(grants.Grants, granteeType, granteeId) >= 0 {
+		err = client.SetGrants(ctx, catalogId, removeGrant(grants.Grants, granteeType, granteeId))
+	}
+	if err != nil && !dremiorest.IsNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Dremio privilege", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Dremio privilege deleted")
+}
+
+func mapFields(grant *dremiorest.Grant, model *Model) error {
+	if grant == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	catalogId := model.CatalogId.ValueString()
+	if catalogId == "" {
+		return fmt.Errorf("catalog id not present")
+	}
+	if grant.GranteeType == "" || grant.Id == "" {
+		return fmt.Errorf("grantee not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(catalogId, grant.GranteeType, grant.Id)
+	model.GranteeType = types.StringValue(grant.GranteeType)
+	model.GranteeId = types.StringValue(grant.Id)
+
+	privileges := []attr.Value{}
+	for _, privilege := range grant.Privileges {
+		privileges = append(privileges, types.StringValue(privilege))
+	}
+	privilegesSet, diags := types.SetValue(types.StringType, privileges)
+	if diags.HasError() {
+		return fmt.Errorf("mapping privileges: %w", core.DiagsToError(diags))
+	}
+	model.Privileges = privilegesSet
+	return nil
+}
+
+func toGrant(ctx context.Context, model *Model) (*dremiorest.Grant, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	privileges := []string{}
+	if !(model.Privileges.IsNull() || model.Privileges.IsUnknown()) {
+		diags := model.Privileges.ElementsAs(ctx, &privileges, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting privileges: %w", core.DiagsToError(diags))
+		}
+	}
+	slices.Sort(privileges)
+
+	return &dremiorest.Grant{
+		GranteeType: model.GranteeType.ValueString(),
+		Id:          model.GranteeId.ValueString(),
+		Privileges:  privileges,
+	}, nil
+}
+
+// findGrant returns the index of the grant of the grantee, or -1 if the grantee has no grant.
+func findGrant(grants []dremiorest.Grant, granteeType, granteeId string) int {
+	return slices.IndexFunc(grants, func(grant dremiorest.Grant) bool {
+		return grant.GranteeType == granteeType && grant.Id == granteeId
+	})
+}
+
+// setGrant replaces the grant of the grantee, or adds it if the grantee has no grant yet.
+func setGrant(grants []dremiorest.Grant, grant *dremiorest.Grant) []dremiorest.Grant {
+	result := slices.Clone(grants)
+	if i := findGrant(result, grant.GranteeType, grant.Id); i >= 0 {
+		result[i] = *grant
+		return result
+	}
+	return append(result, *grant)
+}
+
+// removeGrant removes the grant of the grantee.
+func removeGrant(grants []dremiorest.Grant, granteeType, granteeId string) []dremiorest.Grant {
+	return slices.DeleteFunc(slices.Clone(grants), func(grant dremiorest.Grant) bool {
+		return grant.GranteeType == granteeType && grant.Id == granteeId
+	})
+}
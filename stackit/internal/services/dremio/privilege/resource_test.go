@@ -0,0 +1,318 @@
+package dremio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest/dremiotest"
+	dremioUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/utils"
+)
+
+func privilegesSet(privileges ...string) types.Set {
+	values := []attr.Value{}
+	for _, privilege := range privileges {
+		values = append(values, types.StringValue(privilege))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       *Model
+		input       *dremiorest.Grant
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"simple_values",
+			&Model{
+				CatalogId: types.StringValue("cid"),
+			},
+			&dremiorest.Grant{
+				GranteeType: dremiorest.GranteeTypeRole,
+				Id:          "rid",
+				Name:        "analysts",
+				Privileges:  []string{"ALTER", "SELECT"},
+			},
+			&Model{
+				Id:          types.StringValue("cid,ROLE,rid"),
+				CatalogId:   types.StringValue("cid"),
+				GranteeType: types.StringValue(dremiorest.GranteeTypeRole),
+				GranteeId:   types.StringValue("rid"),
+				Privileges:  privilegesSet("ALTER", "SELECT"),
+			},
+			true,
+		},
+		{
+			"no_privileges",
+			&Model{
+				CatalogId: types.StringValue("cid"),
+			},
+			&dremiorest.Grant{
+				GranteeType: dremiorest.GranteeTypeUser,
+				Id:          "uid",
+			},
+			&Model{
+				Id:          types.StringValue("cid,USER,uid"),
+				CatalogId:   types.StringValue("cid"),
+				GranteeType: types.StringValue(dremiorest.GranteeTypeUser),
+				GranteeId:   types.StringValue("uid"),
+				Privileges:  privilegesSet(),
+			},
+			true,
+		},
+		{
+			"no_catalog_id",
+			&Model{},
+			&dremiorest.Grant{GranteeType: dremiorest.GranteeTypeUser, Id: "uid"},
+			nil,
+			false,
+		},
+		{
+			"no_grantee",
+			&Model{
+				CatalogId: types.StringValue("cid"),
+			},
+			&dremiorest.Grant{Privileges: []string{"SELECT"}},
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			&dremiorest.Grant{GranteeType: dremiorest.GranteeTypeUser, Id: "uid"},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToGrant(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *dremiorest.Grant
+		isValid     bool
+	}{
+		{
+			"simple_values",
+			&Model{
+				GranteeType: types.StringValue(dremiorest.GranteeTypeRole),
+				GranteeId:   types.StringValue("rid"),
+				Privileges:  privilegesSet("SELECT", "ALTER"),
+			},
+			&dremiorest.Grant{
+				GranteeType: dremiorest.GranteeTypeRole,
+				Id:          "rid",
+				Privileges:  []string{"ALTER", "SELECT"},
+			},
+			true,
+		},
+		{
+			"null_privileges",
+			&Model{
+				GranteeType: types.StringValue(dremiorest.GranteeTypeUser),
+				GranteeId:   types.StringValue("uid"),
+				Privileges:  types.SetNull(types.StringType),
+			},
+			&dremiorest.Grant{
+				GranteeType: dremiorest.GranteeTypeUser,
+				Id:          "uid",
+				Privileges:  []string{},
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toGrant(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSetGrant(t *testing.T) {
+	user := dremiorest.Grant{GranteeType: dremiorest.GranteeTypeUser, Id: "uid", Privileges: []string{"SELECT"}}
+	role := dremiorest.Grant{GranteeType: dremiorest.GranteeTypeRole, Id: "rid", Privileges: []string{"SELECT"}}
+	// a user and a role can have the same ID
+	userWithRoleId := dremiorest.Grant{GranteeType: dremiorest.GranteeTypeUser, Id: "rid", Privileges: []string{"ALTER"}}
+
+	tests := []struct {
+		description string
+		grants      []dremiorest.Grant
+		grant       dremiorest.Grant
+		expected    []dremiorest.Grant
+	}{
+		{
+			"add_to_empty",
+			nil,
+			role,
+			[]dremiorest.Grant{role},
+		},
+		{
+			"add",
+			[]dremiorest.Grant{user},
+			role,
+			[]dremiorest.Grant{user, role},
+		},
+		{
+			"replace",
+			[]dremiorest.Grant{user, role},
+			dremiorest.Grant{GranteeType: dremiorest.GranteeTypeRole, Id: "rid", Privileges: []string{"ALTER", "SELECT"}},
+			[]dremiorest.Grant{user, {GranteeType: dremiorest.GranteeTypeRole, Id: "rid", Privileges: []string{"ALTER", "SELECT"}}},
+		},
+		{
+			"same_id_other_type",
+			[]dremiorest.Grant{role},
+			userWithRoleId,
+			[]dremiorest.Grant{role, userWithRoleId},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := setGrant(tt.grants, &tt.grant)
+			if diff := cmp.Diff(output, tt.expected); diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveGrant(t *testing.T) {
+	user := dremiorest.Grant{GranteeType: dremiorest.GranteeTypeUser, Id: "rid", Privileges: []string{"SELECT"}}
+	role := dremiorest.Grant{GranteeType: dremiorest.GranteeTypeRole, Id: "rid", Privileges: []string{"SELECT"}}
+
+	grants := []dremiorest.Grant{user, role}
+	output := removeGrant(grants, dremiorest.GranteeTypeRole, "rid")
+	if diff := cmp.Diff(output, []dremiorest.Grant{user}); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+	if diff := cmp.Diff(grants, []dremiorest.Grant{user, role}); diff != "" {
+		t.Fatalf("Input was modified: %s", diff)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	server := dremiotest.NewServer("terraform", "secret")
+	defer server.Close()
+
+	auth := &dremioUtils.RESTAuthenticationModel{
+		AuthorityUrl: types.StringValue(server.AuthorityURL()),
+		ClientId:     types.StringValue("terraform"),
+		ClientSecret: types.StringValue("secret"),
+		Scope:        types.StringNull(),
+	}
+	client := dremioUtils.NewRESTClient(types.StringValue(server.URL), auth)
+
+	space, err := client.CreateCatalogEntity(ctx, &dremiorest.CatalogEntity{EntityType: dremiorest.EntityTypeSpace, Name: "analytics"})
+	if err != nil {
+		t.Fatalf("Creating space failed: %v", err)
+	}
+	role, err := client.CreateRole(ctx, &dremiorest.Role{Name: "analysts"})
+	if err != nil {
+		t.Fatalf("Creating role failed: %v", err)
+	}
+	// grants managed outside of the resource must be kept
+	other := dremiorest.Grant{GranteeType: dremiorest.GranteeTypeUser, Id: "uid", Privileges: []string{"ALTER"}}
+	if err := client.SetGrants(ctx, space.Id, []dremiorest.Grant{other}); err != nil {
+		t.Fatalf("Setting grants failed: %v", err)
+	}
+
+	model := &Model{
+		Url:            types.StringValue(server.URL),
+		Authentication: auth,
+		CatalogId:      types.StringValue(space.Id),
+		GranteeType:    types.StringValue(dremiorest.GranteeTypeRole),
+		GranteeId:      types.StringValue(role.Id),
+		Privileges:     privilegesSet("SELECT", "ALTER"),
+	}
+	grant, err := toGrant(ctx, model)
+	if err != nil {
+		t.Fatalf("Creating grant failed: %v", err)
+	}
+	grants, err := client.GetGrants(ctx, space.Id)
+	if err != nil {
+		t.Fatalf("Reading grants failed: %v", err)
+	}
+	if err := client.SetGrants(ctx, space.Id, setGrant(grants.Grants, grant)); err != nil {
+		t.Fatalf("Setting grants failed: %v", err)
+	}
+	if err := mapFields(grant, model); err != nil {
+		t.Fatalf("Mapping grant failed: %v", err)
+	}
+
+	grants, err = client.GetGrants(ctx, space.Id)
+	if err != nil {
+		t.Fatalf("Reading grants failed: %v", err)
+	}
+	i := findGrant(grants.Grants, dremiorest.GranteeTypeRole, role.Id)
+	if i < 0 {
+		t.Fatalf("Grant of role not found")
+	}
+	read := *model
+	if err := mapFields(&grants.Grants[i], &read); err != nil {
+		t.Fatalf("Mapping grant failed: %v", err)
+	}
+	if diff := cmp.Diff(&read, model); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	if err := client.SetGrants(ctx, space.Id, removeGrant(grants.Grants, dremiorest.GranteeTypeRole, role.Id)); err != nil {
+		t.Fatalf("Removing grant failed: %v", err)
+	}
+	grants, err = client.GetGrants(ctx, space.Id)
+	if err != nil {
+		t.Fatalf("Reading grants failed: %v", err)
+	}
+	if diff := cmp.Diff(grants.Grants, []dremiorest.Grant{other}); diff != "" {
+		t.Fatalf("Grants do not match: %s", diff)
+	}
+}
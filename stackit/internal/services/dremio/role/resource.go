@@ -0,0 +1,253 @@
+package dremio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest"
+	dremioUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/utils"
+)
+
+var (
+	_ resource.Resource              = &roleResource{}
+	_ resource.ResourceWithConfigure = &roleResource{}
+)
+
+type Model struct {
+	Id             types.String                         `tfsdk:"id"`
+	Url            types.String                         `tfsdk:"url"`
+	Authentication *dremioUtils.RESTAuthenticationModel `tfsdk:"authentication"`
+
+	RoleId      types.String `tfsdk:"role_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+var descriptions = map[string]string{
+	"main":        "Manages an internal role of a STACKIT Dremio instance. Privileges can be granted to the role with `stackit_dremio_privilege`.",
+	"id":          "Terraform's internal resource identifier. It is structured as \"`role_id`\".",
+	"role_id":     "The ID of the role.",
+	"name":        "The name of the role.",
+	"description": "The description of the role.",
+}
+
+func NewRoleResource() resource.Resource {
+	return &roleResource{}
+}
+
+type roleResource struct{}
+
+func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dremio_role"
+}
+
+func (r *roleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckExperimentEnabled(ctx, &providerData, features.DremioExperiment, "stackit_dremio_role", core.Resource, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio role configured")
+}
+
+func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := dremioUtils.RESTAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: descriptions["id"],
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["role_id"] = schema.StringAttribute{
+		Description: descriptions["role_id"],
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: descriptions["name"],
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["description"] = schema.StringAttribute{
+		Description: descriptions["description"],
+		Optional:    true,
+		Computed:    true, // Must be computed if a default is applied
+		Default:     stringdefault.StaticString(""),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: features.AddExperimentDescription(descriptions["main"], features.DremioExperiment, core.Resource),
+		Attributes:  attributes,
+	}
+}
+
+func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "name", name)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	role, err := client.CreateRole(ctx, toPayload(&model))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Dremio role", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(role, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Dremio role", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio role created")
+}
+
+func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	roleId := model.RoleId.ValueString()
+	ctx = tflog.SetField(ctx, "role_id", roleId)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	role, err := client.GetRole(ctx, roleId)
+	if err != nil {
+		if dremiorest.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Dremio role", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(role, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Dremio role", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio role read")
+}
+
+func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	roleId := model.RoleId.ValueString()
+	ctx = tflog.SetField(ctx, "role_id", roleId)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	role, err := client.UpdateRole(ctx, toPayload(&model))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Dremio role", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(role, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Dremio role", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio role updated")
+}
+
+func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	roleId := model.RoleId.ValueString()
+	ctx = tflog.SetField(ctx, "role_id", roleId)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	err := client.DeleteRole(ctx, roleId)
+	if err != nil && !dremiorest.IsNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Dremio role", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Dremio role deleted")
+}
+
+func mapFields(role *dremiorest.Role, model *Model) error {
+	if role == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if role.Id == "" {
+		return fmt.Errorf("role id not present")
+	}
+
+	model.Id = types.StringValue(role.Id)
+	model.RoleId = types.StringValue(role.Id)
+	model.Name = types.StringValue(role.Name)
+	model.Description = types.StringValue(role.Description)
+	return nil
+}
+
+func toPayload(model *Model) *dremiorest.Role {
+	return &dremiorest.Role{
+		Id:          model.RoleId.ValueString(),
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+	}
+}
@@ -0,0 +1,140 @@
+package dremio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest/dremiotest"
+	dremioUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/utils"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       *Model
+		input       *dremiorest.Role
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&Model{},
+			&dremiorest.Role{
+				Id:   "rid",
+				Name: "analysts",
+			},
+			&Model{
+				Id:          types.StringValue("rid"),
+				RoleId:      types.StringValue("rid"),
+				Name:        types.StringValue("analysts"),
+				Description: types.StringValue(""),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&Model{
+				Url: types.StringValue("https://dremio.example.com"),
+			},
+			&dremiorest.Role{
+				Id:          "rid",
+				Name:        "analysts",
+				Type:        "INTERNAL",
+				Description: "read only",
+			},
+			&Model{
+				Id:          types.StringValue("rid"),
+				Url:         types.StringValue("https://dremio.example.com"),
+				RoleId:      types.StringValue("rid"),
+				Name:        types.StringValue("analysts"),
+				Description: types.StringValue("read only"),
+			},
+			true,
+		},
+		{
+			"no_id",
+			&Model{},
+			&dremiorest.Role{Name: "analysts"},
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			&dremiorest.Role{Id: "rid"},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	server := dremiotest.NewServer("terraform", "secret")
+	defer server.Close()
+
+	model := &Model{
+		Url: types.StringValue(server.URL),
+		Authentication: &dremioUtils.RESTAuthenticationModel{
+			AuthorityUrl: types.StringValue(server.AuthorityURL()),
+			ClientId:     types.StringValue("terraform"),
+			ClientSecret: types.StringValue("secret"),
+			Scope:        types.StringNull(),
+		},
+		RoleId:      types.StringUnknown(),
+		Name:        types.StringValue("analysts"),
+		Description: types.StringValue("read only"),
+	}
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	role, err := client.CreateRole(ctx, toPayload(model))
+	if err != nil {
+		t.Fatalf("Creating role failed: %v", err)
+	}
+	if err := mapFields(role, model); err != nil {
+		t.Fatalf("Mapping role failed: %v", err)
+	}
+
+	// roles can be renamed in place
+	model.Name = types.StringValue("data-analysts")
+	model.Description = types.StringValue("")
+	role, err = client.UpdateRole(ctx, toPayload(model))
+	if err != nil {
+		t.Fatalf("Updating role failed: %v", err)
+	}
+	updated := *model
+	if err := mapFields(role, &updated); err != nil {
+		t.Fatalf("Mapping role failed: %v", err)
+	}
+	if diff := cmp.Diff(&updated, model); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
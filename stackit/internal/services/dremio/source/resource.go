@@ -0,0 +1,311 @@
+package dremio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest"
+	dremioUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/utils"
+)
+
+var (
+	_ resource.Resource              = &sourceResource{}
+	_ resource.ResourceWithConfigure = &sourceResource{}
+)
+
+type Model struct {
+	Id             types.String                         `tfsdk:"id"`
+	Url            types.String                         `tfsdk:"url"`
+	Authentication *dremioUtils.RESTAuthenticationModel `tfsdk:"authentication"`
+
+	SourceId types.String `tfsdk:"source_id"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Config   types.String `tfsdk:"config"`
+}
+
+var descriptions = map[string]string{
+	"main":      "Manages a source in the catalog of a STACKIT Dremio instance, e.g. an object storage bucket holding the data of the lakehouse. Deleting the source removes it and the metadata of its datasets from Dremio, the data itself is not deleted.",
+	"id":        "Terraform's internal resource identifier. It is structured as \"`source_id`\".",
+	"source_id": "The ID of the source in the Dremio catalog.",
+	"name":      "The name of the source. Dremio does not allow to rename sources, changing it replaces the source.",
+	"type":      "The type of the source, e.g. `S3` for STACKIT Object Storage, `NESSIE` or `POSTGRES`. Changing it replaces the source.",
+	"config": "The JSON encoded configuration of the source, as expected by the Dremio catalog API for the `type`, e.g. created with `jsonencode`. " +
+		"As Dremio does not return secret values of the configuration, changes made outside of Terraform are not detected.",
+}
+
+func NewSourceResource() resource.Resource {
+	return &sourceResource{}
+}
+
+type sourceResource struct{}
+
+func (r *sourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dremio_source"
+}
+
+func (r *sourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckExperimentEnabled(ctx, &providerData, features.DremioExperiment, "stackit_dremio_source", core.Resource, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio source configured")
+}
+
+func (r *sourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := dremioUtils.RESTAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: descriptions["id"],
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["source_id"] = schema.StringAttribute{
+		Description: descriptions["source_id"],
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: descriptions["name"],
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["type"] = schema.StringAttribute{
+		Description: descriptions["type"],
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["config"] = schema.StringAttribute{
+		Description: descriptions["config"],
+		Required:    true,
+		// the configuration usually contains credentials of the source
+		Sensitive: true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: features.AddExperimentDescription(descriptions["main"], features.DremioExperiment, core.Resource),
+		Attributes:  attributes,
+	}
+}
+
+func (r *sourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "name", name)
+
+	payload, err := toPayload(&model, "")
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Dremio source", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	source, err := client.CreateCatalogEntity(ctx, payload)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Dremio source", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(source, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Dremio source", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio source created")
+}
+
+func (r *sourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	sourceId := model.SourceId.ValueString()
+	ctx = tflog.SetField(ctx, "source_id", sourceId)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	source, err := client.GetCatalogEntity(ctx, sourceId)
+	if err != nil {
+		if dremiorest.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Dremio source", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(source, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Dremio source", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio source read")
+}
+
+func (r *sourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	sourceId := model.SourceId.ValueString()
+	ctx = tflog.SetField(ctx, "source_id", sourceId)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	// updates must pass the current version of the source
+	current, err := client.GetCatalogEntity(ctx, sourceId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Dremio source", fmt.Sprintf("Reading current version: %v", err))
+		return
+	}
+
+	payload, err := toPayload(&model, current.Tag)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Dremio source", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	source, err := client.UpdateCatalogEntity(ctx, payload)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Dremio source", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(source, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Dremio source", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio source updated")
+}
+
+func (r *sourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	sourceId := model.SourceId.ValueString()
+	ctx = tflog.SetField(ctx, "source_id", sourceId)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	// the current version of the source must be passed on deletion
+	source, err := client.GetCatalogEntity(ctx, sourceId)
+	if err == nil {
+		err = client.DeleteCatalogEntity(ctx, sourceId, source.Tag)
+	}
+	if err != nil && !dremiorest.IsNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Dremio source", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Dremio source deleted")
+}
+
+// mapFields maps the source to the model. The config is kept, as secret values are masked in the response.
+func mapFields(source *dremiorest.CatalogEntity, model *Model) error {
+	if source == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if source.Id == "" {
+		return fmt.Errorf("source id not present")
+	}
+	if source.EntityType != dremiorest.EntityTypeSource {
+		return fmt.Errorf("catalog entity %q is a %s, not a source", source.Id, source.EntityType)
+	}
+
+	model.Id = types.StringValue(source.Id)
+	model.SourceId = types.StringValue(source.Id)
+	model.Name = types.StringValue(source.Name)
+	model.Type = types.StringValue(source.Type)
+	return nil
+}
+
+func toPayload(model *Model, tag string) (*dremiorest.CatalogEntity, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	var config map[string]any
+	if err := json.Unmarshal([]byte(model.Config.ValueString()), &config); err != nil {
+		return nil, fmt.Errorf("config must be a JSON object: %w", err)
+	}
+	if config == nil {
+		return nil, fmt.Errorf("config must be a JSON object")
+	}
+
+	return &dremiorest.CatalogEntity{
+		EntityType: dremiorest.EntityTypeSource,
+		Id:         model.SourceId.ValueString(),
+		Name:       model.Name.ValueString(),
+		Tag:        tag,
+		Type:       model.Type.ValueString(),
+		Config:     json.RawMessage(model.Config.ValueString()),
+	}, nil
+}
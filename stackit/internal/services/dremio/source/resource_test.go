@@ -0,0 +1,255 @@
+package dremio
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest/dremiotest"
+	dremioUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/utils"
+)
+
+const testConfig = `{"accessKey":"key","secretAccessKey":"secret"}`
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       *Model
+		input       *dremiorest.CatalogEntity
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"masked_config",
+			&Model{
+				Url:    types.StringValue("https://dremio.example.com"),
+				Config: types.StringValue(testConfig),
+			},
+			&dremiorest.CatalogEntity{
+				EntityType: dremiorest.EntityTypeSource,
+				Id:         "sid",
+				Name:       "lakehouse",
+				Tag:        "tag-1",
+				Type:       "S3",
+				Config:     json.RawMessage(`{"accessKey":"key","secretAccessKey":"$DREMIO_EXISTING_VALUE$"}`),
+			},
+			&Model{
+				Id:       types.StringValue("sid"),
+				Url:      types.StringValue("https://dremio.example.com"),
+				SourceId: types.StringValue("sid"),
+				Name:     types.StringValue("lakehouse"),
+				Type:     types.StringValue("S3"),
+				Config:   types.StringValue(testConfig),
+			},
+			true,
+		},
+		{
+			"not_a_source",
+			&Model{},
+			&dremiorest.CatalogEntity{
+				EntityType: dremiorest.EntityTypeSpace,
+				Id:         "sid",
+				Name:       "analytics",
+			},
+			nil,
+			false,
+		},
+		{
+			"no_id",
+			&Model{},
+			&dremiorest.CatalogEntity{
+				EntityType: dremiorest.EntityTypeSource,
+				Name:       "lakehouse",
+			},
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			&dremiorest.CatalogEntity{EntityType: dremiorest.EntityTypeSource, Id: "sid"},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToPayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		tag         string
+		expected    *dremiorest.CatalogEntity
+		isValid     bool
+	}{
+		{
+			"create",
+			&Model{
+				SourceId: types.StringUnknown(),
+				Name:     types.StringValue("lakehouse"),
+				Type:     types.StringValue("S3"),
+				Config:   types.StringValue(testConfig),
+			},
+			"",
+			&dremiorest.CatalogEntity{
+				EntityType: dremiorest.EntityTypeSource,
+				Name:       "lakehouse",
+				Type:       "S3",
+				Config:     json.RawMessage(testConfig),
+			},
+			true,
+		},
+		{
+			"update",
+			&Model{
+				SourceId: types.StringValue("sid"),
+				Name:     types.StringValue("lakehouse"),
+				Type:     types.StringValue("S3"),
+				Config:   types.StringValue(testConfig),
+			},
+			"tag-1",
+			&dremiorest.CatalogEntity{
+				EntityType: dremiorest.EntityTypeSource,
+				Id:         "sid",
+				Name:       "lakehouse",
+				Tag:        "tag-1",
+				Type:       "S3",
+				Config:     json.RawMessage(testConfig),
+			},
+			true,
+		},
+		{
+			"config_no_object",
+			&Model{
+				Name:   types.StringValue("lakehouse"),
+				Type:   types.StringValue("S3"),
+				Config: types.StringValue(`["key"]`),
+			},
+			"",
+			nil,
+			false,
+		},
+		{
+			"config_null",
+			&Model{
+				Name:   types.StringValue("lakehouse"),
+				Type:   types.StringValue("S3"),
+				Config: types.StringValue("null"),
+			},
+			"",
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			"",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toPayload(tt.input, tt.tag)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	server := dremiotest.NewServer("terraform", "secret")
+	defer server.Close()
+
+	model := &Model{
+		Url: types.StringValue(server.URL),
+		Authentication: &dremioUtils.RESTAuthenticationModel{
+			AuthorityUrl: types.StringValue(server.AuthorityURL()),
+			ClientId:     types.StringValue("terraform"),
+			ClientSecret: types.StringValue("secret"),
+			Scope:        types.StringNull(),
+		},
+		SourceId: types.StringUnknown(),
+		Name:     types.StringValue("lakehouse"),
+		Type:     types.StringValue("S3"),
+		Config:   types.StringValue(testConfig),
+	}
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	payload, err := toPayload(model, "")
+	if err != nil {
+		t.Fatalf("Creating payload failed: %v", err)
+	}
+	source, err := client.CreateCatalogEntity(ctx, payload)
+	if err != nil {
+		t.Fatalf("Creating source failed: %v", err)
+	}
+	if err := mapFields(source, model); err != nil {
+		t.Fatalf("Mapping source failed: %v", err)
+	}
+
+	// the secret is masked in the response, reading the source must keep the configured value
+	source, err = client.GetCatalogEntity(ctx, model.SourceId.ValueString())
+	if err != nil {
+		t.Fatalf("Reading source failed: %v", err)
+	}
+	read := *model
+	if err := mapFields(source, &read); err != nil {
+		t.Fatalf("Mapping source failed: %v", err)
+	}
+	if diff := cmp.Diff(&read, model); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	model.Config = types.StringValue(`{"accessKey":"key2","secretAccessKey":"secret2"}`)
+	payload, err = toPayload(model, source.Tag)
+	if err != nil {
+		t.Fatalf("Creating payload failed: %v", err)
+	}
+	if _, err := client.UpdateCatalogEntity(ctx, payload); err != nil {
+		t.Fatalf("Updating source failed: %v", err)
+	}
+	stored, _ := server.SourceConfig(model.SourceId.ValueString())
+	if diff := cmp.Diff(map[string]any{"accessKey": "key2", "secretAccessKey": "secret2"}, stored); diff != "" {
+		t.Fatalf("Stored config does not match: %s", diff)
+	}
+}
@@ -0,0 +1,234 @@
+package dremio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest"
+	dremioUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/utils"
+)
+
+var (
+	_ resource.Resource              = &spaceResource{}
+	_ resource.ResourceWithConfigure = &spaceResource{}
+)
+
+type Model struct {
+	Id             types.String                         `tfsdk:"id"`
+	Url            types.String                         `tfsdk:"url"`
+	Authentication *dremioUtils.RESTAuthenticationModel `tfsdk:"authentication"`
+
+	SpaceId types.String `tfsdk:"space_id"`
+	Name    types.String `tfsdk:"name"`
+}
+
+var descriptions = map[string]string{
+	"main":     "Manages a space in the catalog of a STACKIT Dremio instance. Spaces organize the views of the lakehouse. Deleting the space also deletes all of its folders and views.",
+	"id":       "Terraform's internal resource identifier. It is structured as \"`space_id`\".",
+	"space_id": "The ID of the space in the Dremio catalog.",
+	"name":     "The name of the space. Dremio does not allow to rename spaces, changing it replaces the space.",
+}
+
+func NewSpaceResource() resource.Resource {
+	return &spaceResource{}
+}
+
+type spaceResource struct{}
+
+func (r *spaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dremio_space"
+}
+
+func (r *spaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckExperimentEnabled(ctx, &providerData, features.DremioExperiment, "stackit_dremio_space", core.Resource, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio space configured")
+}
+
+func (r *spaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := dremioUtils.RESTAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: descriptions["id"],
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["space_id"] = schema.StringAttribute{
+		Description: descriptions["space_id"],
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: descriptions["name"],
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: features.AddExperimentDescription(descriptions["main"], features.DremioExperiment, core.Resource),
+		Attributes:  attributes,
+	}
+}
+
+func (r *spaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "name", name)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	space, err := client.CreateCatalogEntity(ctx, toCreatePayload(&model))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Dremio space", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(space, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Dremio space", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio space created")
+}
+
+func (r *spaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	spaceId := model.SpaceId.ValueString()
+	ctx = tflog.SetField(ctx, "space_id", spaceId)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	space, err := client.GetCatalogEntity(ctx, spaceId)
+	if err != nil {
+		if dremiorest.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Dremio space", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(space, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Dremio space", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio space read")
+}
+
+func (r *spaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// The name of a space can't be changed, only the connection settings are updated in the state.
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Dremio space updated")
+}
+
+func (r *spaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	spaceId := model.SpaceId.ValueString()
+	ctx = tflog.SetField(ctx, "space_id", spaceId)
+
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	// the current version of the space must be passed on deletion
+	space, err := client.GetCatalogEntity(ctx, spaceId)
+	if err == nil {
+		err = client.DeleteCatalogEntity(ctx, spaceId, space.Tag)
+	}
+	if err != nil && !dremiorest.IsNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Dremio space", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Dremio space deleted")
+}
+
+func mapFields(space *dremiorest.CatalogEntity, model *Model) error {
+	if space == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if space.Id == "" {
+		return fmt.Errorf("space id not present")
+	}
+	if space.EntityType != dremiorest.EntityTypeSpace {
+		return fmt.Errorf("catalog entity %q is a %s, not a space", space.Id, space.EntityType)
+	}
+
+	model.Id = types.StringValue(space.Id)
+	model.SpaceId = types.StringValue(space.Id)
+	model.Name = types.StringValue(space.Name)
+	return nil
+}
+
+func toCreatePayload(model *Model) *dremiorest.CatalogEntity {
+	return &dremiorest.CatalogEntity{
+		EntityType: dremiorest.EntityTypeSpace,
+		Name:       model.Name.ValueString(),
+	}
+}
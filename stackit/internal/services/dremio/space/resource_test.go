@@ -0,0 +1,134 @@
+package dremio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest/dremiotest"
+	dremioUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/utils"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       *Model
+		input       *dremiorest.CatalogEntity
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"simple_values",
+			&Model{
+				Url: types.StringValue("https://dremio.example.com"),
+			},
+			&dremiorest.CatalogEntity{
+				EntityType: dremiorest.EntityTypeSpace,
+				Id:         "sid",
+				Name:       "analytics",
+				Tag:        "tag-1",
+			},
+			&Model{
+				Id:      types.StringValue("sid"),
+				Url:     types.StringValue("https://dremio.example.com"),
+				SpaceId: types.StringValue("sid"),
+				Name:    types.StringValue("analytics"),
+			},
+			true,
+		},
+		{
+			"not_a_space",
+			&Model{},
+			&dremiorest.CatalogEntity{
+				EntityType: dremiorest.EntityTypeSource,
+				Id:         "sid",
+				Name:       "lakehouse",
+			},
+			nil,
+			false,
+		},
+		{
+			"no_id",
+			&Model{},
+			&dremiorest.CatalogEntity{
+				EntityType: dremiorest.EntityTypeSpace,
+				Name:       "analytics",
+			},
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			&dremiorest.CatalogEntity{EntityType: dremiorest.EntityTypeSpace, Id: "sid"},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	server := dremiotest.NewServer("terraform", "secret")
+	defer server.Close()
+
+	model := &Model{
+		Url: types.StringValue(server.URL),
+		Authentication: &dremioUtils.RESTAuthenticationModel{
+			AuthorityUrl: types.StringValue(server.AuthorityURL()),
+			ClientId:     types.StringValue("terraform"),
+			ClientSecret: types.StringValue("secret"),
+			Scope:        types.StringNull(),
+		},
+		SpaceId: types.StringUnknown(),
+		Name:    types.StringValue("analytics"),
+	}
+	client := dremioUtils.NewRESTClient(model.Url, model.Authentication)
+
+	space, err := client.CreateCatalogEntity(ctx, toCreatePayload(model))
+	if err != nil {
+		t.Fatalf("Creating space failed: %v", err)
+	}
+	if err := mapFields(space, model); err != nil {
+		t.Fatalf("Mapping space failed: %v", err)
+	}
+
+	space, err = client.GetCatalogEntity(ctx, model.SpaceId.ValueString())
+	if err != nil {
+		t.Fatalf("Reading space failed: %v", err)
+	}
+	read := *model
+	if err := mapFields(space, &read); err != nil {
+		t.Fatalf("Mapping space failed: %v", err)
+	}
+	if diff := cmp.Diff(&read, model); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
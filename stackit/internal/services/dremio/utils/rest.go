@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/dremiorest"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+// RESTAuthenticationModel maps the OAuth client used to authenticate against the REST API of a Dremio instance.
+type RESTAuthenticationModel struct {
+	AuthorityUrl types.String `tfsdk:"authority_url"`
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scope        types.String `tfsdk:"scope"`
+}
+
+// RESTAttributes returns the `url` and `authentication` attributes of resources managed via the REST API of a Dremio instance.
+func RESTAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"url": schema.StringAttribute{
+			Description: "URL of the Dremio instance, e.g. from `stackit_dremio_instance.endpoints.ui`. The REST API of the instance is used to manage the resource.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"authentication": schema.SingleNestedAttribute{
+			Description: "OAuth client used to authenticate against the Dremio instance, usually the one from `stackit_dremio_instance.authentication.oauth`. " +
+				"The client must be allowed to use the client credentials grant and the user name claim of its tokens must belong to a Dremio user with the required privileges.",
+			Required: true,
+			Attributes: map[string]schema.Attribute{
+				"authority_url": schema.StringAttribute{
+					Description: "The Issuer location URI, where the OIDC provider configuration can be found.",
+					Required:    true,
+				},
+				"client_id": schema.StringAttribute{
+					Description: "The client ID assigned by the Identity Provider.",
+					Required:    true,
+				},
+				"client_secret": schema.StringAttribute{
+					Description: "The client secret generated by the Identity Provider.",
+					Required:    true,
+					Sensitive:   true,
+				},
+				"scope": schema.StringAttribute{
+					Description: "A list of space-separated scopes requested from the Identity Provider. Defaults to `openid`.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// NewRESTClient creates a client for the REST API of the Dremio instance at url.
+func NewRESTClient(url types.String, auth *RESTAuthenticationModel) *dremiorest.Client {
+	var oauth dremiorest.OAuthConfig
+	if auth != nil {
+		oauth = dremiorest.OAuthConfig{
+			AuthorityURL: auth.AuthorityUrl.ValueString(),
+			ClientID:     auth.ClientId.ValueString(),
+			ClientSecret: auth.ClientSecret.ValueString(),
+			Scope:        auth.Scope.ValueString(),
+		}
+	}
+	return dremiorest.NewClient(url.ValueString(), oauth, nil)
+}
+
+// Global map to hold locks for the grants of specific catalog entities
+// This ensures that parallel changes of the privileges on one catalog entity don't overwrite each other
+var (
+	grantLocksMu sync.Mutex
+	grantLocks   = make(map[string]*sync.Mutex)
+)
+
+// LockGrants acquires a lock for the grants of a catalog entity of the Dremio instance at url.
+// It returns an unlock function that must be deferred.
+func LockGrants(url, catalogId string) func() {
+	key := utils.BuildInternalTerraformId(url, catalogId).ValueString()
+
+	grantLocksMu.Lock()
+	mu, ok := grantLocks[key]
+	if !ok {
+		mu = &sync.Mutex{}
+		grantLocks[key] = mu
+	}
+	grantLocksMu.Unlock()
+
+	mu.Lock()
+	return mu.Unlock
+}
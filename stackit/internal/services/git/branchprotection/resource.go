@@ -0,0 +1,425 @@
+package branchprotection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &branchProtectionResource{}
+	_ resource.ResourceWithConfigure = &branchProtectionResource{}
+)
+
+type Model struct {
+	Id                     types.String `tfsdk:"id"` // needed by TF
+	Url                    types.String `tfsdk:"url"`
+	Token                  types.String `tfsdk:"token"`
+	Organization           types.String `tfsdk:"organization"`
+	Repository             types.String `tfsdk:"repository"`
+	RuleName               types.String `tfsdk:"rule_name"`
+	EnablePush             types.Bool   `tfsdk:"enable_push"`
+	RequiredApprovals      types.Int64  `tfsdk:"required_approvals"`
+	StatusCheckContexts    types.List   `tfsdk:"status_check_contexts"`
+	BlockOnRejectedReviews types.Bool   `tfsdk:"block_on_rejected_reviews"`
+	DismissStaleApprovals  types.Bool   `tfsdk:"dismiss_stale_approvals"`
+	RequireSignedCommits   types.Bool   `tfsdk:"require_signed_commits"`
+	ProtectedFilePatterns  types.String `tfsdk:"protected_file_patterns"`
+}
+
+// NewBranchProtectionResource is a helper function to simplify the provider implementation.
+func NewBranchProtectionResource() resource.Resource {
+	return &branchProtectionResource{}
+}
+
+// branchProtectionResource is the resource implementation.
+type branchProtectionResource struct{}
+
+// Metadata returns the resource type name.
+func (r *branchProtectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_branch_protection"
+}
+
+// Configure checks that beta resources are enabled.
+func (r *branchProtectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_git_branch_protection", "resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git branch protection configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *branchProtectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":                      "Git branch protection resource schema. Manages a branch protection rule of a repository inside a STACKIT Git instance via its Forgejo-compatible API.",
+		"id":                        "Terraform's internal resource identifier. It is structured as \"`organization`,`repository`,`rule_name`\".",
+		"url":                       "URL of the Git instance, e.g. from `stackit_git.url`.",
+		"token":                     "Access token of an instance user, which is used to authenticate against the Forgejo API of the instance.",
+		"organization":              "Name of the organization which owns the repository.",
+		"repository":                "Name of the repository, e.g. from `stackit_git_repository.name`.",
+		"rule_name":                 "Name of the protected branch. May contain glob patterns, e.g. `release/*`.",
+		"enable_push":               "Whether pushing to the protected branches is allowed. If `false`, changes can only be merged via pull requests.",
+		"required_approvals":        "Number of approvals which are required to merge a pull request.",
+		"status_check_contexts":     "Status checks which must succeed before a pull request can be merged. Status checks are only required if this list is not empty.",
+		"block_on_rejected_reviews": "Whether merging is blocked if a reviewer requested changes.",
+		"dismiss_stale_approvals":   "Whether approvals are dismissed when new commits are pushed.",
+		"require_signed_commits":    "Whether all commits of the protected branches must be signed.",
+		"protected_file_patterns":   "Semicolon-separated glob patterns of files which can't be changed, even if pushing is allowed.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         descriptions["main"],
+		MarkdownDescription: features.AddBetaDescription(descriptions["main"], core.Resource),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: descriptions["url"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: descriptions["token"],
+				Required:    true,
+				Sensitive:   true,
+			},
+			"organization": schema.StringAttribute{
+				Description: descriptions["organization"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				Description: descriptions["repository"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"rule_name": schema.StringAttribute{
+				Description: descriptions["rule_name"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"enable_push": schema.BoolAttribute{
+				Description: descriptions["enable_push"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"required_approvals": schema.Int64Attribute{
+				Description: descriptions["required_approvals"],
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"status_check_contexts": schema.ListAttribute{
+				Description: descriptions["status_check_contexts"],
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"block_on_rejected_reviews": schema.BoolAttribute{
+				Description: descriptions["block_on_rejected_reviews"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"dismiss_stale_approvals": schema.BoolAttribute{
+				Description: descriptions["dismiss_stale_approvals"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"require_signed_commits": schema.BoolAttribute{
+				Description: descriptions["require_signed_commits"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"protected_file_patterns": schema.StringAttribute{
+				Description: descriptions["protected_file_patterns"],
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *branchProtectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	repository := model.Repository.ValueString()
+	ruleName := model.RuleName.ValueString()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "repository", repository)
+	ctx = tflog.SetField(ctx, "rule_name", ruleName)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	payload, err := toPayload(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git branch protection", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	payload.RuleName = ruleName
+
+	protection, err := client.CreateBranchProtection(ctx, organization, repository, payload)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git branch protection", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(protection, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git branch protection", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git branch protection created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *branchProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	repository := model.Repository.ValueString()
+	ruleName := model.RuleName.ValueString()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "repository", repository)
+	ctx = tflog.SetField(ctx, "rule_name", ruleName)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	protection, err := client.GetBranchProtection(ctx, organization, repository, ruleName)
+	if err != nil {
+		if forgejo.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Git branch protection", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(protection, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Git branch protection", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git branch protection read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *branchProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	repository := model.Repository.ValueString()
+	ruleName := model.RuleName.ValueString()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "repository", repository)
+	ctx = tflog.SetField(ctx, "rule_name", ruleName)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	payload, err := toPayload(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Git branch protection", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	protection, err := client.EditBranchProtection(ctx, organization, repository, ruleName, payload)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Git branch protection", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(protection, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Git branch protection", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git branch protection updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *branchProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	repository := model.Repository.ValueString()
+	ruleName := model.RuleName.ValueString()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "repository", repository)
+	ctx = tflog.SetField(ctx, "rule_name", ruleName)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	err := client.DeleteBranchProtection(ctx, organization, repository, ruleName)
+	if err != nil && !forgejo.IsNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Git branch protection", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Git branch protection deleted")
+}
+
+func mapFields(protection *forgejo.BranchProtection, model *Model) error {
+	if protection == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if protection.RuleName == "" {
+		return fmt.Errorf("rule name not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.Organization.ValueString(), model.Repository.ValueString(), protection.RuleName)
+	model.RuleName = types.StringValue(protection.RuleName)
+	model.EnablePush = types.BoolValue(protection.EnablePush)
+	model.RequiredApprovals = types.Int64Value(protection.RequiredApprovals)
+	model.BlockOnRejectedReviews = types.BoolValue(protection.BlockOnRejectedReviews)
+	model.DismissStaleApprovals = types.BoolValue(protection.DismissStaleApprovals)
+	model.RequireSignedCommits = types.BoolValue(protection.RequireSignedCommits)
+	model.ProtectedFilePatterns = types.StringValue(protection.ProtectedFilePatterns)
+
+	// status checks are only enforced if they are enabled, the contexts of disabled status checks are ignored
+	if !protection.EnableStatusCheck || len(protection.StatusCheckContexts) == 0 {
+		model.StatusCheckContexts = types.ListNull(types.StringType)
+		return nil
+	}
+	contexts := make([]attr.Value, 0, len(protection.StatusCheckContexts))
+	for _, statusCheckContext := range protection.StatusCheckContexts {
+		contexts = append(contexts, types.StringValue(statusCheckContext))
+	}
+	var diags diag.Diagnostics
+	model.StatusCheckContexts, diags = types.ListValue(types.StringType, contexts)
+	if diags.HasError() {
+		return fmt.Errorf("mapping status check contexts: %w", core.DiagsToError(diags))
+	}
+	return nil
+}
+
+// toPayload creates the payload to create or update a branch protection. The rule name is only set on creation.
+func toPayload(ctx context.Context, model *Model) (*forgejo.BranchProtectionOptions, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	contexts := []string{}
+	if !utils.IsUndefined(model.StatusCheckContexts) {
+		diags := model.StatusCheckContexts.ElementsAs(ctx, &contexts, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting status check contexts: %w", core.DiagsToError(diags))
+		}
+	}
+
+	return &forgejo.BranchProtectionOptions{
+		EnablePush:             model.EnablePush.ValueBool(),
+		RequiredApprovals:      model.RequiredApprovals.ValueInt64(),
+		EnableStatusCheck:      len(contexts) > 0,
+		StatusCheckContexts:    contexts,
+		BlockOnRejectedReviews: model.BlockOnRejectedReviews.ValueBool(),
+		DismissStaleApprovals:  model.DismissStaleApprovals.ValueBool(),
+		RequireSignedCommits:   model.RequireSignedCommits.ValueBool(),
+		ProtectedFilePatterns:  model.ProtectedFilePatterns.ValueString(),
+	}, nil
+}
@@ -0,0 +1,216 @@
+package branchprotection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       *Model
+		input       *forgejo.BranchProtection
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("service"),
+			},
+			&forgejo.BranchProtection{
+				RuleName: "main",
+			},
+			&Model{
+				Id:                     types.StringValue("team,service,main"),
+				Organization:           types.StringValue("team"),
+				Repository:             types.StringValue("service"),
+				RuleName:               types.StringValue("main"),
+				EnablePush:             types.BoolValue(false),
+				RequiredApprovals:      types.Int64Value(0),
+				StatusCheckContexts:    types.ListNull(types.StringType),
+				BlockOnRejectedReviews: types.BoolValue(false),
+				DismissStaleApprovals:  types.BoolValue(false),
+				RequireSignedCommits:   types.BoolValue(false),
+				ProtectedFilePatterns:  types.StringValue(""),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("service"),
+			},
+			&forgejo.BranchProtection{
+				RuleName:               "release/*",
+				EnablePush:             true,
+				RequiredApprovals:      2,
+				EnableStatusCheck:      true,
+				StatusCheckContexts:    []string{"ci/build", "ci/test"},
+				BlockOnRejectedReviews: true,
+				DismissStaleApprovals:  true,
+				RequireSignedCommits:   true,
+				ProtectedFilePatterns:  "*.lock;.forgejo/**",
+			},
+			&Model{
+				Id:                     types.StringValue("team,service,release/*"),
+				Organization:           types.StringValue("team"),
+				Repository:             types.StringValue("service"),
+				RuleName:               types.StringValue("release/*"),
+				EnablePush:             types.BoolValue(true),
+				RequiredApprovals:      types.Int64Value(2),
+				StatusCheckContexts:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ci/build"), types.StringValue("ci/test")}),
+				BlockOnRejectedReviews: types.BoolValue(true),
+				DismissStaleApprovals:  types.BoolValue(true),
+				RequireSignedCommits:   types.BoolValue(true),
+				ProtectedFilePatterns:  types.StringValue("*.lock;.forgejo/**"),
+			},
+			true,
+		},
+		{
+			"status_checks_disabled",
+			&Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("service"),
+			},
+			&forgejo.BranchProtection{
+				RuleName:            "main",
+				StatusCheckContexts: []string{"ci/build"},
+			},
+			&Model{
+				Id:                     types.StringValue("team,service,main"),
+				Organization:           types.StringValue("team"),
+				Repository:             types.StringValue("service"),
+				RuleName:               types.StringValue("main"),
+				EnablePush:             types.BoolValue(false),
+				RequiredApprovals:      types.Int64Value(0),
+				StatusCheckContexts:    types.ListNull(types.StringType),
+				BlockOnRejectedReviews: types.BoolValue(false),
+				DismissStaleApprovals:  types.BoolValue(false),
+				RequireSignedCommits:   types.BoolValue(false),
+				ProtectedFilePatterns:  types.StringValue(""),
+			},
+			true,
+		},
+		{
+			"no_rule_name",
+			&Model{},
+			&forgejo.BranchProtection{},
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			&forgejo.BranchProtection{RuleName: "main"},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToPayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *forgejo.BranchProtectionOptions
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&Model{
+				RuleName:               types.StringValue("main"),
+				EnablePush:             types.BoolValue(false),
+				RequiredApprovals:      types.Int64Value(0),
+				StatusCheckContexts:    types.ListNull(types.StringType),
+				BlockOnRejectedReviews: types.BoolValue(false),
+				DismissStaleApprovals:  types.BoolValue(false),
+				RequireSignedCommits:   types.BoolValue(false),
+				ProtectedFilePatterns:  types.StringValue(""),
+			},
+			&forgejo.BranchProtectionOptions{
+				StatusCheckContexts: []string{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&Model{
+				RuleName:               types.StringValue("main"),
+				EnablePush:             types.BoolValue(true),
+				RequiredApprovals:      types.Int64Value(1),
+				StatusCheckContexts:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ci/build")}),
+				BlockOnRejectedReviews: types.BoolValue(true),
+				DismissStaleApprovals:  types.BoolValue(true),
+				RequireSignedCommits:   types.BoolValue(true),
+				ProtectedFilePatterns:  types.StringValue("*.lock"),
+			},
+			&forgejo.BranchProtectionOptions{
+				EnablePush:             true,
+				RequiredApprovals:      1,
+				EnableStatusCheck:      true,
+				StatusCheckContexts:    []string{"ci/build"},
+				BlockOnRejectedReviews: true,
+				DismissStaleApprovals:  true,
+				RequireSignedCommits:   true,
+				ProtectedFilePatterns:  "*.lock",
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toPayload(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,349 @@
+package deploykey
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &deployKeyResource{}
+	_ resource.ResourceWithConfigure = &deployKeyResource{}
+)
+
+type Model struct {
+	Id           types.String `tfsdk:"id"` // needed by TF
+	Url          types.String `tfsdk:"url"`
+	Token        types.String `tfsdk:"token"`
+	Organization types.String `tfsdk:"organization"`
+	Repository   types.String `tfsdk:"repository"`
+	KeyId        types.Int64  `tfsdk:"key_id"`
+	Title        types.String `tfsdk:"title"`
+	Key          types.String `tfsdk:"key"`
+	ReadOnly     types.Bool   `tfsdk:"read_only"`
+	Fingerprint  types.String `tfsdk:"fingerprint"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+// NewDeployKeyResource is a helper function to simplify the provider implementation.
+func NewDeployKeyResource() resource.Resource {
+	return &deployKeyResource{}
+}
+
+// deployKeyResource is the resource implementation.
+type deployKeyResource struct{}
+
+// Metadata returns the resource type name.
+func (r *deployKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_deploy_key"
+}
+
+// Configure checks that beta resources are enabled.
+func (r *deployKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_git_deploy_key", "resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git deploy key configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *deployKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":         "Git deploy key resource schema. Adds an SSH public key with access to a single repository inside a STACKIT Git instance via its Forgejo-compatible API. Deploy keys can't be updated, changing any argument creates a new key.",
+		"id":           "Terraform's internal resource identifier. It is structured as \"`organization`,`repository`,`key_id`\".",
+		"url":          "URL of the Git instance, e.g. from `stackit_git.url`.",
+		"token":        "Access token of an instance user, which is used to authenticate against the Forgejo API of the instance.",
+		"organization": "Name of the organization which owns the repository.",
+		"repository":   "Name of the repository, e.g. from `stackit_git_repository.name`.",
+		"key_id":       "ID of the deploy key within the Git instance.",
+		"title":        "Title of the deploy key.",
+		"key":          "SSH public key in OpenSSH format, e.g. `ssh-ed25519 AAAA...`.",
+		"read_only":    "Whether the key can only be used to pull. If `false`, the key can also be used to push.",
+		"fingerprint":  "Fingerprint of the SSH public key.",
+		"created_at":   "Date and time when the deploy key was added.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         descriptions["main"],
+		MarkdownDescription: features.AddBetaDescription(descriptions["main"], core.Resource),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: descriptions["url"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: descriptions["token"],
+				Required:    true,
+				Sensitive:   true,
+			},
+			"organization": schema.StringAttribute{
+				Description: descriptions["organization"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				Description: descriptions["repository"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"key_id": schema.Int64Attribute{
+				Description: descriptions["key_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: descriptions["title"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: descriptions["key"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				Description: descriptions["read_only"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Description: descriptions["fingerprint"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: descriptions["created_at"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deployKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	repository := model.Repository.ValueString()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "repository", repository)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	key, err := client.CreateDeployKey(ctx, organization, repository, toCreatePayload(&model))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git deploy key", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	ctx = tflog.SetField(ctx, "key_id", key.ID)
+
+	err = mapFields(key, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git deploy key", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git deploy key created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deployKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	repository := model.Repository.ValueString()
+	keyId := model.KeyId.ValueInt64()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "repository", repository)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	key, err := client.GetDeployKey(ctx, organization, repository, keyId)
+	if err != nil {
+		if forgejo.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Git deploy key", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(key, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Git deploy key", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git deploy key read")
+}
+
+// Update updates the access token, all other changes create a new deploy key.
+func (r *deployKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git deploy key updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deployKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	repository := model.Repository.ValueString()
+	keyId := model.KeyId.ValueInt64()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "repository", repository)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	err := client.DeleteDeployKey(ctx, organization, repository, keyId)
+	if err != nil && !forgejo.IsNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Git deploy key", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Git deploy key deleted")
+}
+
+func mapFields(key *forgejo.DeployKey, model *Model) error {
+	if key == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if key.ID == 0 {
+		return fmt.Errorf("key id not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.Organization.ValueString(), model.Repository.ValueString(), strconv.FormatInt(key.ID, 10))
+	model.KeyId = types.Int64Value(key.ID)
+	model.Title = types.StringValue(key.Title)
+	model.ReadOnly = types.BoolValue(key.ReadOnly)
+	model.Fingerprint = types.StringValue(key.Fingerprint)
+	model.CreatedAt = types.StringValue(key.CreatedAt)
+
+	// the API may normalize the key, e.g. strip the comment, so the configured key is kept if it is still the same key
+	if !sameKey(model.Key.ValueString(), key.Key) {
+		model.Key = types.StringValue(key.Key)
+	}
+	return nil
+}
+
+// sameKey compares the type and the base64 encoded key of two OpenSSH public keys, ignoring their comments.
+func sameKey(first, second string) bool {
+	firstFields := strings.Fields(first)
+	secondFields := strings.Fields(second)
+	if len(firstFields) < 2 || len(secondFields) < 2 {
+		return strings.TrimSpace(first) == strings.TrimSpace(second)
+	}
+	return firstFields[0] == secondFields[0] && firstFields[1] == secondFields[1]
+}
+
+func toCreatePayload(model *Model) *forgejo.CreateDeployKeyOptions {
+	return &forgejo.CreateDeployKeyOptions{
+		Title:    model.Title.ValueString(),
+		Key:      model.Key.ValueString(),
+		ReadOnly: model.ReadOnly.ValueBool(),
+	}
+}
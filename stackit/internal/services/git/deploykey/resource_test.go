@@ -0,0 +1,180 @@
+package deploykey
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+)
+
+const testKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBaq1d2Dk0tS2gS7iV+0bJc2hAg3rc1zQn4Lzvq4x4Ph"
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       *Model
+		input       *forgejo.DeployKey
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"simple_values",
+			&Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("service"),
+				Key:          types.StringValue(testKey),
+			},
+			&forgejo.DeployKey{
+				ID:          3,
+				Title:       "deploy",
+				Key:         testKey,
+				Fingerprint: "SHA256:fingerprint",
+				ReadOnly:    true,
+				CreatedAt:   "2026-01-02T03:04:05Z",
+			},
+			&Model{
+				Id:           types.StringValue("team,service,3"),
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("service"),
+				KeyId:        types.Int64Value(3),
+				Title:        types.StringValue("deploy"),
+				Key:          types.StringValue(testKey),
+				ReadOnly:     types.BoolValue(true),
+				Fingerprint:  types.StringValue("SHA256:fingerprint"),
+				CreatedAt:    types.StringValue("2026-01-02T03:04:05Z"),
+			},
+			true,
+		},
+		{
+			"configured_comment_is_kept",
+			&Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("service"),
+				Key:          types.StringValue(testKey + " ci@example.com\n"),
+			},
+			&forgejo.DeployKey{
+				ID:  3,
+				Key: testKey,
+			},
+			&Model{
+				Id:           types.StringValue("team,service,3"),
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("service"),
+				KeyId:        types.Int64Value(3),
+				Title:        types.StringValue(""),
+				Key:          types.StringValue(testKey + " ci@example.com\n"),
+				ReadOnly:     types.BoolValue(false),
+				Fingerprint:  types.StringValue(""),
+				CreatedAt:    types.StringValue(""),
+			},
+			true,
+		},
+		{
+			"changed_key",
+			&Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("service"),
+				Key:          types.StringValue("ssh-ed25519 AAAAother"),
+			},
+			&forgejo.DeployKey{
+				ID:  3,
+				Key: testKey,
+			},
+			&Model{
+				Id:           types.StringValue("team,service,3"),
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("service"),
+				KeyId:        types.Int64Value(3),
+				Title:        types.StringValue(""),
+				Key:          types.StringValue(testKey),
+				ReadOnly:     types.BoolValue(false),
+				Fingerprint:  types.StringValue(""),
+				CreatedAt:    types.StringValue(""),
+			},
+			true,
+		},
+		{
+			"no_id",
+			&Model{},
+			&forgejo.DeployKey{Key: testKey},
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			&forgejo.DeployKey{ID: 3},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *forgejo.CreateDeployKeyOptions
+	}{
+		{
+			"simple_values",
+			&Model{
+				Title:    types.StringValue("deploy"),
+				Key:      types.StringValue(testKey),
+				ReadOnly: types.BoolValue(true),
+			},
+			&forgejo.CreateDeployKeyOptions{
+				Title:    "deploy",
+				Key:      testKey,
+				ReadOnly: true,
+			},
+		},
+		{
+			"write_access",
+			&Model{
+				Title:    types.StringValue("deploy"),
+				Key:      types.StringValue(testKey),
+				ReadOnly: types.BoolValue(false),
+			},
+			&forgejo.CreateDeployKeyOptions{
+				Title: "deploy",
+				Key:   testKey,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toCreatePayload(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
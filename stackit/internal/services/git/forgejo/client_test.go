@@ -0,0 +1,386 @@
+package forgejo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo/forgejotest"
+)
+
+const (
+	token     = "access-token"
+	org       = "team"
+	repo      = "service"
+	publicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBaq1d2Dk0tS2gS7iV+0bJc2hAg3rc1zQn4Lzvq4x4Ph deploy"
+)
+
+func TestAuthentication(t *testing.T) {
+	server := forgejotest.NewServer(token)
+	defer server.Close()
+
+	tests := []struct {
+		description string
+		token       string
+		isValid     bool
+	}{
+		{"valid token", token, true},
+		{"wrong token", "wrong", false},
+		{"no token", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := forgejo.NewClient(server.URL, tt.token, nil)
+			_, err := client.GetOrganization(context.Background(), org)
+			if !tt.isValid && !isStatus(err, 401) {
+				t.Fatalf("Expected unauthorized, got: %v", err)
+			}
+			if tt.isValid && !forgejo.IsNotFound(err) {
+				t.Fatalf("Expected not found, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestOrganizationLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server := forgejotest.NewServer(token)
+	defer server.Close()
+
+	client := forgejo.NewClient(server.URL+"/", token, server.Client())
+
+	created, err := client.CreateOrganization(ctx, &forgejo.CreateOrganizationOptions{
+		Name:        org,
+		FullName:    "Team",
+		Description: "description",
+		Visibility:  "private",
+	})
+	if err != nil {
+		t.Fatalf("Creating organization failed: %v", err)
+	}
+
+	_, err = client.CreateOrganization(ctx, &forgejo.CreateOrganizationOptions{Name: org})
+	if err == nil {
+		t.Fatalf("Creating an existing organization should have failed")
+	}
+
+	read, err := client.GetOrganization(ctx, org)
+	if err != nil {
+		t.Fatalf("Reading organization failed: %v", err)
+	}
+	if diff := cmp.Diff(read, created); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	updated, err := client.EditOrganization(ctx, org, &forgejo.EditOrganizationOptions{
+		FullName:   "Team",
+		Website:    "https://example.com",
+		Visibility: "limited",
+	})
+	if err != nil {
+		t.Fatalf("Updating organization failed: %v", err)
+	}
+	expected := &forgejo.Organization{
+		ID:         created.ID,
+		Name:       org,
+		FullName:   "Team",
+		Website:    "https://example.com",
+		Visibility: "limited",
+	}
+	if diff := cmp.Diff(updated, expected); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	if err := client.DeleteOrganization(ctx, org); err != nil {
+		t.Fatalf("Deleting organization failed: %v", err)
+	}
+	_, err = client.GetOrganization(ctx, org)
+	if !forgejo.IsNotFound(err) {
+		t.Fatalf("Expected not found, got: %v", err)
+	}
+}
+
+func TestRepositoryLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server := forgejotest.NewServer(token)
+	defer server.Close()
+
+	client := forgejo.NewClient(server.URL, token, nil)
+
+	_, err := client.CreateOrganizationRepository(ctx, org, &forgejo.CreateRepositoryOptions{Name: repo})
+	if !forgejo.IsNotFound(err) {
+		t.Fatalf("Expected not found for missing organization, got: %v", err)
+	}
+
+	if _, err := client.CreateOrganization(ctx, &forgejo.CreateOrganizationOptions{Name: org}); err != nil {
+		t.Fatalf("Creating organization failed: %v", err)
+	}
+
+	created, err := client.CreateOrganizationRepository(ctx, org, &forgejo.CreateRepositoryOptions{
+		Name:          repo,
+		Description:   "description",
+		Private:       true,
+		AutoInit:      true,
+		DefaultBranch: "develop",
+	})
+	if err != nil {
+		t.Fatalf("Creating repository failed: %v", err)
+	}
+	if created.FullName != org+"/"+repo || created.Owner == nil || created.Owner.Login != org || created.DefaultBranch != "develop" {
+		t.Fatalf("Unexpected repository: %+v", created)
+	}
+
+	if err := client.DeleteOrganization(ctx, org); err == nil {
+		t.Fatalf("Deleting an organization with repositories should have failed")
+	}
+
+	updated, err := client.EditRepository(ctx, org, repo, &forgejo.EditRepositoryOptions{
+		Description: new(""),
+		Private:     new(false),
+	})
+	if err != nil {
+		t.Fatalf("Updating repository failed: %v", err)
+	}
+	if updated.Description != "" || updated.Private || updated.DefaultBranch != "develop" {
+		t.Fatalf("Unexpected repository: %+v", updated)
+	}
+
+	read, err := client.GetRepository(ctx, org, repo)
+	if err != nil {
+		t.Fatalf("Reading repository failed: %v", err)
+	}
+	if diff := cmp.Diff(read, updated); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	if err := client.DeleteRepository(ctx, org, repo); err != nil {
+		t.Fatalf("Deleting repository failed: %v", err)
+	}
+	_, err = client.GetRepository(ctx, org, repo)
+	if !forgejo.IsNotFound(err) {
+		t.Fatalf("Expected not found, got: %v", err)
+	}
+}
+
+func TestBranchProtectionLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server := forgejotest.NewServer(token)
+	defer server.Close()
+
+	client := forgejo.NewClient(server.URL, token, nil)
+	createRepository(t, client)
+
+	// rule names may contain glob patterns and slashes
+	ruleName := "release/*"
+	created, err := client.CreateBranchProtection(ctx, org, repo, &forgejo.BranchProtectionOptions{
+		RuleName:            ruleName,
+		RequiredApprovals:   2,
+		EnableStatusCheck:   true,
+		StatusCheckContexts: []string{"ci/build"},
+	})
+	if err != nil {
+		t.Fatalf("Creating branch protection failed: %v", err)
+	}
+
+	_, err = client.CreateBranchProtection(ctx, org, repo, &forgejo.BranchProtectionOptions{RuleName: ruleName})
+	if err == nil {
+		t.Fatalf("Creating an existing branch protection should have failed")
+	}
+
+	read, err := client.GetBranchProtection(ctx, org, repo, ruleName)
+	if err != nil {
+		t.Fatalf("Reading branch protection failed: %v", err)
+	}
+	if diff := cmp.Diff(read, created); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	updated, err := client.EditBranchProtection(ctx, org, repo, ruleName, &forgejo.BranchProtectionOptions{
+		EnablePush:           true,
+		RequireSignedCommits: true,
+	})
+	if err != nil {
+		t.Fatalf("Updating branch protection failed: %v", err)
+	}
+	expected := &forgejo.BranchProtection{
+		RuleName:             ruleName,
+		EnablePush:           true,
+		RequireSignedCommits: true,
+	}
+	if diff := cmp.Diff(updated, expected); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	if err := client.DeleteBranchProtection(ctx, org, repo, ruleName); err != nil {
+		t.Fatalf("Deleting branch protection failed: %v", err)
+	}
+	_, err = client.GetBranchProtection(ctx, org, repo, ruleName)
+	if !forgejo.IsNotFound(err) {
+		t.Fatalf("Expected not found, got: %v", err)
+	}
+}
+
+func TestDeployKeyLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server := forgejotest.NewServer(token)
+	defer server.Close()
+
+	client := forgejo.NewClient(server.URL, token, nil)
+	createRepository(t, client)
+
+	_, err := client.CreateDeployKey(ctx, org, repo, &forgejo.CreateDeployKeyOptions{Title: "invalid", Key: "not a key"})
+	if err == nil {
+		t.Fatalf("Creating an invalid deploy key should have failed")
+	}
+
+	created, err := client.CreateDeployKey(ctx, org, repo, &forgejo.CreateDeployKeyOptions{
+		Title:    "deploy",
+		Key:      publicKey,
+		ReadOnly: true,
+	})
+	if err != nil {
+		t.Fatalf("Creating deploy key failed: %v", err)
+	}
+	if created.ID == 0 || created.Fingerprint == "" || !created.ReadOnly {
+		t.Fatalf("Unexpected deploy key: %+v", created)
+	}
+
+	read, err := client.GetDeployKey(ctx, org, repo, created.ID)
+	if err != nil {
+		t.Fatalf("Reading deploy key failed: %v", err)
+	}
+	if diff := cmp.Diff(read, created); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	if err := client.DeleteDeployKey(ctx, org, repo, created.ID); err != nil {
+		t.Fatalf("Deleting deploy key failed: %v", err)
+	}
+	_, err = client.GetDeployKey(ctx, org, repo, created.ID)
+	if !forgejo.IsNotFound(err) {
+		t.Fatalf("Expected not found, got: %v", err)
+	}
+}
+
+func TestUserLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server := forgejotest.NewServer(token)
+	defer server.Close()
+
+	client := forgejo.NewClient(server.URL, token, nil)
+
+	created, err := client.CreateUser(ctx, &forgejo.CreateUserOptions{
+		Username:           "jane",
+		Email:              "jane@example.com",
+		FullName:           "Jane Doe",
+		Password:           "initial-password",
+		MustChangePassword: true,
+	})
+	if err != nil {
+		t.Fatalf("Creating user failed: %v", err)
+	}
+	if password, mustChange, _ := server.UserPassword("jane"); password != "initial-password" || !mustChange {
+		t.Fatalf("Unexpected password %q, must change: %t", password, mustChange)
+	}
+
+	updated, err := client.EditUser(ctx, "jane", &forgejo.EditUserOptions{
+		LoginName:          "jane",
+		Email:              new("jane.doe@example.com"),
+		Password:           new("new-password"),
+		MustChangePassword: new(false),
+		Admin:              new(true),
+	})
+	if err != nil {
+		t.Fatalf("Updating user failed: %v", err)
+	}
+	expected := &forgejo.User{
+		ID:       created.ID,
+		Login:    "jane",
+		FullName: "Jane Doe",
+		Email:    "jane.doe@example.com",
+		IsAdmin:  true,
+	}
+	if diff := cmp.Diff(updated, expected); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+	if password, mustChange, _ := server.UserPassword("jane"); password != "new-password" || mustChange {
+		t.Fatalf("Unexpected password %q, must change: %t", password, mustChange)
+	}
+
+	read, err := client.GetUser(ctx, "jane")
+	if err != nil {
+		t.Fatalf("Reading user failed: %v", err)
+	}
+	if diff := cmp.Diff(read, expected); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	if err := client.DeleteUser(ctx, "jane"); err != nil {
+		t.Fatalf("Deleting user failed: %v", err)
+	}
+	_, err = client.GetUser(ctx, "jane")
+	if !forgejo.IsNotFound(err) {
+		t.Fatalf("Expected not found, got: %v", err)
+	}
+}
+
+func TestGetRunnerRegistrationToken(t *testing.T) {
+	ctx := context.Background()
+	server := forgejotest.NewServer(token)
+	defer server.Close()
+
+	client := forgejo.NewClient(server.URL, token, nil)
+	createRepository(t, client)
+
+	tests := []struct {
+		description string
+		org         string
+		repo        string
+		isValid     bool
+	}{
+		{"organization", org, "", true},
+		{"repository", org, repo, true},
+		{"unknown organization", "unknown", "", false},
+		{"unknown repository", org, "unknown", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			first, err := client.GetRunnerRegistrationToken(ctx, tt.org, tt.repo)
+			if !tt.isValid {
+				if !forgejo.IsNotFound(err) {
+					t.Fatalf("Expected not found, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			second, err := client.GetRunnerRegistrationToken(ctx, tt.org, tt.repo)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if first == "" || first != second {
+				t.Fatalf("Expected the same token on every request, got %q and %q", first, second)
+			}
+		})
+	}
+}
+
+func createRepository(t *testing.T, client *forgejo.Client) {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := client.CreateOrganization(ctx, &forgejo.CreateOrganizationOptions{Name: org}); err != nil {
+		t.Fatalf("Creating organization failed: %v", err)
+	}
+	if _, err := client.CreateOrganizationRepository(ctx, org, &forgejo.CreateRepositoryOptions{Name: repo}); err != nil {
+		t.Fatalf("Creating repository failed: %v", err)
+	}
+}
+
+func isStatus(err error, statusCode int) bool {
+	var apiErr *forgejo.Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
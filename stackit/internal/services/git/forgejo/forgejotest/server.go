@@ -0,0 +1,578 @@
+// Package forgejotest provides an in-memory stand-in for the Forgejo-compatible API of STACKIT Git instances, to be used in tests.
+package forgejotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type organization struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"username"`
+	FullName    string `json:"full_name"`
+	Description string `json:"description"`
+	Website     string `json:"website"`
+	Visibility  string `json:"visibility"`
+}
+
+type user struct {
+	Id                 int64  `json:"id"`
+	Login              string `json:"login"`
+	FullName           string `json:"full_name"`
+	Email              string `json:"email"`
+	IsAdmin            bool   `json:"is_admin"`
+	Restricted         bool   `json:"restricted"`
+	ProhibitLogin      bool   `json:"prohibit_login"`
+	password           string
+	mustChangePassword bool
+}
+
+type repository struct {
+	Id            int64  `json:"id"`
+	Owner         *user  `json:"owner"`
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	Description   string `json:"description"`
+	Private       bool   `json:"private"`
+	Archived      bool   `json:"archived"`
+	DefaultBranch string `json:"default_branch"`
+	HTMLURL       string `json:"html_url"`
+	CloneURL      string `json:"clone_url"`
+	SSHURL        string `json:"ssh_url"`
+}
+
+type branchProtection struct {
+	RuleName               string   `json:"rule_name"`
+	EnablePush             bool     `json:"enable_push"`
+	RequiredApprovals      int64    `json:"required_approvals"`
+	EnableStatusCheck      bool     `json:"enable_status_check"`
+	StatusCheckContexts    []string `json:"status_check_contexts"`
+	BlockOnRejectedReviews bool     `json:"block_on_rejected_reviews"`
+	DismissStaleApprovals  bool     `json:"dismiss_stale_approvals"`
+	RequireSignedCommits   bool     `json:"require_signed_commits"`
+	ProtectedFilePatterns  string   `json:"protected_file_patterns"`
+}
+
+type deployKey struct {
+	Id          int64  `json:"id"`
+	Title       string `json:"title"`
+	Key         string `json:"key"`
+	Fingerprint string `json:"fingerprint"`
+	ReadOnly    bool   `json:"read_only"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// Server is an in-memory Forgejo API with organizations, repositories, branch protections, deploy keys, users and runner registration tokens.
+// All requests must be authenticated with the access token of the server, which belongs to an administrator.
+type Server struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	token         string
+	nextId        int64
+	organizations map[string]*organization
+	users         map[string]*user
+	repositories  map[string]*repository
+	protections   map[string][]*branchProtection
+	deployKeys    map[string][]*deployKey
+	runnerTokens  map[string]string
+}
+
+// NewServer starts a stand-in server which accepts the given access token.
+// The caller must call Close when finished.
+func NewServer(token string) *Server {
+	s := &Server{
+		token:         token,
+		organizations: map[string]*organization{},
+		users:         map[string]*user{},
+		repositories:  map[string]*repository{},
+		protections:   map[string][]*branchProtection{},
+		deployKeys:    map[string][]*deployKey{},
+		runnerTokens:  map[string]string{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// UserPassword returns the password of a user and whether it must be changed on the next login.
+func (s *Server) UserPassword(username string) (password string, mustChange, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.users[username]
+	if !ok {
+		return "", false, false
+	}
+	return u.password, u.mustChangePassword, true
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "token "+s.token {
+		writeError(w, http.StatusUnauthorized, "token is required")
+		return
+	}
+
+	// the escaped path is split, so that segments like branch protection rule names may contain slashes
+	p, ok := strings.CutPrefix(r.URL.EscapedPath(), "/api/v1/")
+	if !ok {
+		writeError(w, http.StatusNotFound, "")
+		return
+	}
+	segments := strings.Split(p, "/")
+	for i := range segments {
+		segment, err := url.PathUnescape(segments[i])
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		segments[i] = segment
+	}
+
+	switch {
+	case match(segments, "orgs"):
+		s.handleOrganizations(w, r)
+	case match(segments, "orgs", "*"):
+		s.handleOrganization(w, r, segments[1])
+	case match(segments, "orgs", "*", "repos") && r.Method == http.MethodPost:
+		s.createRepository(w, r, segments[1])
+	case match(segments, "orgs", "*", "actions", "runners", "registration-token") && r.Method == http.MethodGet:
+		s.runnerToken(w, segments[1], "")
+	case match(segments, "repos", "*", "*"):
+		s.handleRepository(w, r, segments[1], segments[2])
+	case match(segments, "repos", "*", "*", "branch_protections"):
+		s.handleBranchProtections(w, r, segments[1], segments[2], "")
+	case match(segments, "repos", "*", "*", "branch_protections", "*"):
+		s.handleBranchProtections(w, r, segments[1], segments[2], segments[4])
+	case match(segments, "repos", "*", "*", "keys"):
+		s.handleDeployKeys(w, r, segments[1], segments[2], "")
+	case match(segments, "repos", "*", "*", "keys", "*"):
+		s.handleDeployKeys(w, r, segments[1], segments[2], segments[4])
+	case match(segments, "repos", "*", "*", "actions", "runners", "registration-token") && r.Method == http.MethodGet:
+		s.runnerToken(w, segments[1], segments[2])
+	case match(segments, "admin", "users") && r.Method == http.MethodPost:
+		s.createUser(w, r)
+	case match(segments, "admin", "users", "*"):
+		s.handleUser(w, r, segments[2])
+	case match(segments, "users", "*") && r.Method == http.MethodGet:
+		u, ok := s.users[segments[1]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "user does not exist")
+			return
+		}
+		writeJSON(w, http.StatusOK, u)
+	default:
+		writeError(w, http.StatusNotFound, "")
+	}
+}
+
+// match returns whether the path segments match the pattern, where "*" matches any segment.
+func match(segments []string, pattern ...string) bool {
+	if len(segments) != len(pattern) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != "*" && pattern[i] != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) id() int64 {
+	s.nextId++
+	return s.nextId
+}
+
+func (s *Server) handleOrganizations(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "")
+		return
+	}
+	var org organization
+	if !decode(w, r, &org) {
+		return
+	}
+	if org.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "username is required")
+		return
+	}
+	if _, ok := s.organizations[org.Name]; ok {
+		writeError(w, http.StatusUnprocessableEntity, "organization already exists")
+		return
+	}
+	if org.Visibility == "" {
+		org.Visibility = "public"
+	}
+	org.Id = s.id()
+	s.organizations[org.Name] = &org
+	writeJSON(w, http.StatusCreated, org)
+}
+
+func (s *Server) handleOrganization(w http.ResponseWriter, r *http.Request, name string) {
+	org, ok := s.organizations[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "organization does not exist")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, org)
+	case http.MethodPatch:
+		var opts struct {
+			FullName    string `json:"full_name"`
+			Description string `json:"description"`
+			Website     string `json:"website"`
+			Visibility  string `json:"visibility"`
+		}
+		if !decode(w, r, &opts) {
+			return
+		}
+		org.FullName = opts.FullName
+		org.Description = opts.Description
+		org.Website = opts.Website
+		if opts.Visibility != "" {
+			org.Visibility = opts.Visibility
+		}
+		writeJSON(w, http.StatusOK, org)
+	case http.MethodDelete:
+		for _, repo := range s.repositories {
+			if repo.Owner.Login == name {
+				writeError(w, http.StatusUnprocessableEntity, "organization still owns repositories")
+				return
+			}
+		}
+		delete(s.organizations, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+func (s *Server) createRepository(w http.ResponseWriter, r *http.Request, orgName string) {
+	org, ok := s.organizations[orgName]
+	if !ok {
+		writeError(w, http.StatusNotFound, "organization does not exist")
+		return
+	}
+	var opts struct {
+		Name          string `json:"name"`
+		Description   string `json:"description"`
+		Private       bool   `json:"private"`
+		AutoInit      bool   `json:"auto_init"`
+		DefaultBranch string `json:"default_branch"`
+	}
+	if !decode(w, r, &opts) {
+		return
+	}
+	key := orgName + "/" + opts.Name
+	if _, ok := s.repositories[key]; ok {
+		writeError(w, http.StatusConflict, "repository already exists")
+		return
+	}
+	if opts.DefaultBranch == "" {
+		opts.DefaultBranch = "main"
+	}
+	repo := &repository{
+		Id:            s.id(),
+		Owner:         &user{Id: org.Id, Login: org.Name, FullName: org.FullName},
+		Name:          opts.Name,
+		FullName:      key,
+		Description:   opts.Description,
+		Private:       opts.Private,
+		DefaultBranch: opts.DefaultBranch,
+		HTMLURL:       fmt.Sprintf("%s/%s", s.URL, key),
+		CloneURL:      fmt.Sprintf("%s/%s.git", s.URL, key),
+		SSHURL:        fmt.Sprintf("git@%s:%s.git", strings.TrimPrefix(s.URL, "http://"), key),
+	}
+	s.repositories[key] = repo
+	writeJSON(w, http.StatusCreated, repo)
+}
+
+func (s *Server) handleRepository(w http.ResponseWriter, r *http.Request, owner, name string) {
+	key := owner + "/" + name
+	repo, ok := s.repositories[key]
+	if !ok {
+		writeError(w, http.StatusNotFound, "repository does not exist")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, repo)
+	case http.MethodPatch:
+		var opts struct {
+			Description   *string `json:"description"`
+			Private       *bool   `json:"private"`
+			Archived      *bool   `json:"archived"`
+			DefaultBranch *string `json:"default_branch"`
+		}
+		if !decode(w, r, &opts) {
+			return
+		}
+		if opts.Description != nil {
+			repo.Description = *opts.Description
+		}
+		if opts.Private != nil {
+			repo.Private = *opts.Private
+		}
+		if opts.Archived != nil {
+			repo.Archived = *opts.Archived
+		}
+		if opts.DefaultBranch != nil {
+			repo.DefaultBranch = *opts.DefaultBranch
+		}
+		writeJSON(w, http.StatusOK, repo)
+	case http.MethodDelete:
+		delete(s.repositories, key)
+		delete(s.protections, key)
+		delete(s.deployKeys, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+func (s *Server) handleBranchProtections(w http.ResponseWriter, r *http.Request, owner, repo, ruleName string) {
+	key := owner + "/" + repo
+	if _, ok := s.repositories[key]; !ok {
+		writeError(w, http.StatusNotFound, "repository does not exist")
+		return
+	}
+	protections := s.protections[key]
+
+	if ruleName == "" {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "")
+			return
+		}
+		var protection branchProtection
+		if !decode(w, r, &protection) {
+			return
+		}
+		for _, p := range protections {
+			if p.RuleName == protection.RuleName {
+				writeError(w, http.StatusForbidden, "branch protection already exists")
+				return
+			}
+		}
+		s.protections[key] = append(protections, &protection)
+		writeJSON(w, http.StatusCreated, protection)
+		return
+	}
+
+	index := -1
+	for i, p := range protections {
+		if p.RuleName == ruleName {
+			index = i
+		}
+	}
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "branch protection does not exist")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, protections[index])
+	case http.MethodPatch:
+		var protection branchProtection
+		if !decode(w, r, &protection) {
+			return
+		}
+		protection.RuleName = ruleName
+		protections[index] = &protection
+		writeJSON(w, http.StatusOK, protection)
+	case http.MethodDelete:
+		s.protections[key] = append(protections[:index], protections[index+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+func (s *Server) handleDeployKeys(w http.ResponseWriter, r *http.Request, owner, repo, id string) {
+	key := owner + "/" + repo
+	if _, ok := s.repositories[key]; !ok {
+		writeError(w, http.StatusNotFound, "repository does not exist")
+		return
+	}
+	keys := s.deployKeys[key]
+
+	if id == "" {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "")
+			return
+		}
+		var deployKey deployKey
+		if !decode(w, r, &deployKey) {
+			return
+		}
+		if !strings.HasPrefix(deployKey.Key, "ssh-") {
+			writeError(w, http.StatusUnprocessableEntity, "invalid key content")
+			return
+		}
+		deployKey.Id = s.id()
+		deployKey.Fingerprint = fmt.Sprintf("SHA256:fingerprint-%d", deployKey.Id)
+		deployKey.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+		s.deployKeys[key] = append(keys, &deployKey)
+		writeJSON(w, http.StatusCreated, deployKey)
+		return
+	}
+
+	keyId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "deploy key does not exist")
+		return
+	}
+	index := -1
+	for i, k := range keys {
+		if k.Id == keyId {
+			index = i
+		}
+	}
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "deploy key does not exist")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, keys[index])
+	case http.MethodDelete:
+		s.deployKeys[key] = append(keys[:index], keys[index+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	var opts struct {
+		Username           string `json:"username"`
+		Email              string `json:"email"`
+		FullName           string `json:"full_name"`
+		Password           string `json:"password"`
+		MustChangePassword *bool  `json:"must_change_password"`
+		Restricted         bool   `json:"restricted"`
+	}
+	if !decode(w, r, &opts) {
+		return
+	}
+	if opts.Username == "" || opts.Email == "" {
+		writeError(w, http.StatusUnprocessableEntity, "username and email are required")
+		return
+	}
+	if _, ok := s.users[opts.Username]; ok {
+		writeError(w, http.StatusUnprocessableEntity, "user already exists")
+		return
+	}
+	u := &user{
+		Id:         s.id(),
+		Login:      opts.Username,
+		FullName:   opts.FullName,
+		Email:      opts.Email,
+		Restricted: opts.Restricted,
+		password:   opts.Password,
+		// the API defaults to requiring a password change
+		mustChangePassword: opts.MustChangePassword == nil || *opts.MustChangePassword,
+	}
+	s.users[u.Login] = u
+	writeJSON(w, http.StatusCreated, u)
+}
+
+func (s *Server) handleUser(w http.ResponseWriter, r *http.Request, username string) {
+	u, ok := s.users[username]
+	if !ok {
+		writeError(w, http.StatusNotFound, "user does not exist")
+		return
+	}
+	switch r.Method {
+	case http.MethodPatch:
+		var opts struct {
+			Email              *string `json:"email"`
+			FullName           *string `json:"full_name"`
+			Password           *string `json:"password"`
+			MustChangePassword *bool   `json:"must_change_password"`
+			Admin              *bool   `json:"admin"`
+			Restricted         *bool   `json:"restricted"`
+			ProhibitLogin      *bool   `json:"prohibit_login"`
+		}
+		if !decode(w, r, &opts) {
+			return
+		}
+		if opts.Email != nil {
+			u.Email = *opts.Email
+		}
+		if opts.FullName != nil {
+			u.FullName = *opts.FullName
+		}
+		if opts.Password != nil {
+			u.password = *opts.Password
+		}
+		if opts.MustChangePassword != nil {
+			u.mustChangePassword = *opts.MustChangePassword
+		}
+		if opts.Admin != nil {
+			u.IsAdmin = *opts.Admin
+		}
+		if opts.Restricted != nil {
+			u.Restricted = *opts.Restricted
+		}
+		if opts.ProhibitLogin != nil {
+			u.ProhibitLogin = *opts.ProhibitLogin
+		}
+		writeJSON(w, http.StatusOK, u)
+	case http.MethodDelete:
+		delete(s.users, username)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+func (s *Server) runnerToken(w http.ResponseWriter, org, repo string) {
+	scope := org
+	if repo != "" {
+		scope = org + "/" + repo
+		if _, ok := s.repositories[scope]; !ok {
+			writeError(w, http.StatusNotFound, "repository does not exist")
+			return
+		}
+	} else if _, ok := s.organizations[org]; !ok {
+		writeError(w, http.StatusNotFound, "organization does not exist")
+		return
+	}
+	token, ok := s.runnerTokens[scope]
+	if !ok {
+		token = fmt.Sprintf("runner-token-%d", s.id())
+		s.runnerTokens[scope] = token
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"token": token})
+}
+
+func decode(w http.ResponseWriter, r *http.Request, body any) bool {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	if message == "" {
+		message = http.StatusText(statusCode)
+	}
+	writeJSON(w, statusCode, map[string]string{"message": message})
+}
@@ -0,0 +1,68 @@
+package forgejo
+
+import (
+	"context"
+	"net/http"
+)
+
+// Organization is an organization of the Git instance.
+type Organization struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"username"`
+	FullName    string `json:"full_name"`
+	Description string `json:"description"`
+	Website     string `json:"website"`
+	Visibility  string `json:"visibility"`
+}
+
+// CreateOrganizationOptions are the options to create an organization.
+type CreateOrganizationOptions struct {
+	Name        string `json:"username"`
+	FullName    string `json:"full_name"`
+	Description string `json:"description"`
+	Website     string `json:"website"`
+	Visibility  string `json:"visibility,omitempty"`
+}
+
+// EditOrganizationOptions are the options to update an organization. All fields are replaced.
+type EditOrganizationOptions struct {
+	FullName    string `json:"full_name"`
+	Description string `json:"description"`
+	Website     string `json:"website"`
+	Visibility  string `json:"visibility,omitempty"`
+}
+
+// CreateOrganization creates an organization, which is owned by the user of the access token.
+func (c *Client) CreateOrganization(ctx context.Context, opts *CreateOrganizationOptions) (*Organization, error) {
+	var org Organization
+	err := c.do(ctx, http.MethodPost, "/orgs", opts, &org)
+	if err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+// GetOrganization reads an organization.
+func (c *Client) GetOrganization(ctx context.Context, name string) (*Organization, error) {
+	var org Organization
+	err := c.do(ctx, http.MethodGet, escapePath("orgs", name), nil, &org)
+	if err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+// EditOrganization updates an organization.
+func (c *Client) EditOrganization(ctx context.Context, name string, opts *EditOrganizationOptions) (*Organization, error) {
+	var org Organization
+	err := c.do(ctx, http.MethodPatch, escapePath("orgs", name), opts, &org)
+	if err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+// DeleteOrganization deletes an organization. The API refuses to delete organizations which still own repositories.
+func (c *Client) DeleteOrganization(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, escapePath("orgs", name), nil, nil)
+}
@@ -0,0 +1,178 @@
+package forgejo
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// Repository is a repository of the Git instance.
+type Repository struct {
+	ID            int64  `json:"id"`
+	Owner         *User  `json:"owner"`
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	Description   string `json:"description"`
+	Private       bool   `json:"private"`
+	Archived      bool   `json:"archived"`
+	DefaultBranch string `json:"default_branch"`
+	HTMLURL       string `json:"html_url"`
+	CloneURL      string `json:"clone_url"`
+	SSHURL        string `json:"ssh_url"`
+}
+
+// CreateRepositoryOptions are the options to create a repository.
+type CreateRepositoryOptions struct {
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Private       bool   `json:"private"`
+	AutoInit      bool   `json:"auto_init"`
+	DefaultBranch string `json:"default_branch,omitempty"`
+}
+
+// EditRepositoryOptions are the options to update a repository. Only set fields are updated.
+type EditRepositoryOptions struct {
+	Description   *string `json:"description,omitempty"`
+	Private       *bool   `json:"private,omitempty"`
+	Archived      *bool   `json:"archived,omitempty"`
+	DefaultBranch *string `json:"default_branch,omitempty"`
+}
+
+// BranchProtection is a branch protection rule of a repository.
+type BranchProtection struct {
+	RuleName               string   `json:"rule_name"`
+	EnablePush             bool     `json:"enable_push"`
+	RequiredApprovals      int64    `json:"required_approvals"`
+	EnableStatusCheck      bool     `json:"enable_status_check"`
+	StatusCheckContexts    []string `json:"status_check_contexts"`
+	BlockOnRejectedReviews bool     `json:"block_on_rejected_reviews"`
+	DismissStaleApprovals  bool     `json:"dismiss_stale_approvals"`
+	RequireSignedCommits   bool     `json:"require_signed_commits"`
+	ProtectedFilePatterns  string   `json:"protected_file_patterns"`
+}
+
+// BranchProtectionOptions are the options to create or update a branch protection rule. All fields are replaced.
+// RuleName is only used on creation and may contain glob patterns, e.g. "release/*".
+type BranchProtectionOptions struct {
+	RuleName               string   `json:"rule_name,omitempty"`
+	EnablePush             bool     `json:"enable_push"`
+	RequiredApprovals      int64    `json:"required_approvals"`
+	EnableStatusCheck      bool     `json:"enable_status_check"`
+	StatusCheckContexts    []string `json:"status_check_contexts"`
+	BlockOnRejectedReviews bool     `json:"block_on_rejected_reviews"`
+	DismissStaleApprovals  bool     `json:"dismiss_stale_approvals"`
+	RequireSignedCommits   bool     `json:"require_signed_commits"`
+	ProtectedFilePatterns  string   `json:"protected_file_patterns"`
+}
+
+// DeployKey is an SSH key with access to a single repository.
+type DeployKey struct {
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	Key         string `json:"key"`
+	Fingerprint string `json:"fingerprint"`
+	ReadOnly    bool   `json:"read_only"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// CreateDeployKeyOptions are the options to add a deploy key to a repository.
+type CreateDeployKeyOptions struct {
+	Title    string `json:"title"`
+	Key      string `json:"key"`
+	ReadOnly bool   `json:"read_only"`
+}
+
+// CreateOrganizationRepository creates a repository in an organization.
+func (c *Client) CreateOrganizationRepository(ctx context.Context, org string, opts *CreateRepositoryOptions) (*Repository, error) {
+	var repo Repository
+	err := c.do(ctx, http.MethodPost, escapePath("orgs", org, "repos"), opts, &repo)
+	if err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// GetRepository reads a repository.
+func (c *Client) GetRepository(ctx context.Context, owner, name string) (*Repository, error) {
+	var repo Repository
+	err := c.do(ctx, http.MethodGet, escapePath("repos", owner, name), nil, &repo)
+	if err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// EditRepository updates a repository.
+func (c *Client) EditRepository(ctx context.Context, owner, name string, opts *EditRepositoryOptions) (*Repository, error) {
+	var repo Repository
+	err := c.do(ctx, http.MethodPatch, escapePath("repos", owner, name), opts, &repo)
+	if err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// DeleteRepository deletes a repository including all of its content.
+func (c *Client) DeleteRepository(ctx context.Context, owner, name string) error {
+	return c.do(ctx, http.MethodDelete, escapePath("repos", owner, name), nil, nil)
+}
+
+// CreateBranchProtection creates a branch protection rule.
+func (c *Client) CreateBranchProtection(ctx context.Context, owner, repo string, opts *BranchProtectionOptions) (*BranchProtection, error) {
+	var protection BranchProtection
+	err := c.do(ctx, http.MethodPost, escapePath("repos", owner, repo, "branch_protections"), opts, &protection)
+	if err != nil {
+		return nil, err
+	}
+	return &protection, nil
+}
+
+// GetBranchProtection reads a branch protection rule.
+func (c *Client) GetBranchProtection(ctx context.Context, owner, repo, ruleName string) (*BranchProtection, error) {
+	var protection BranchProtection
+	err := c.do(ctx, http.MethodGet, escapePath("repos", owner, repo, "branch_protections", ruleName), nil, &protection)
+	if err != nil {
+		return nil, err
+	}
+	return &protection, nil
+}
+
+// EditBranchProtection updates a branch protection rule.
+func (c *Client) EditBranchProtection(ctx context.Context, owner, repo, ruleName string, opts *BranchProtectionOptions) (*BranchProtection, error) {
+	var protection BranchProtection
+	err := c.do(ctx, http.MethodPatch, escapePath("repos", owner, repo, "branch_protections", ruleName), opts, &protection)
+	if err != nil {
+		return nil, err
+	}
+	return &protection, nil
+}
+
+// DeleteBranchProtection deletes a branch protection rule.
+func (c *Client) DeleteBranchProtection(ctx context.Context, owner, repo, ruleName string) error {
+	return c.do(ctx, http.MethodDelete, escapePath("repos", owner, repo, "branch_protections", ruleName), nil, nil)
+}
+
+// CreateDeployKey adds a deploy key to a repository.
+func (c *Client) CreateDeployKey(ctx context.Context, owner, repo string, opts *CreateDeployKeyOptions) (*DeployKey, error) {
+	var key DeployKey
+	err := c.do(ctx, http.MethodPost, escapePath("repos", owner, repo, "keys"), opts, &key)
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// GetDeployKey reads a deploy key of a repository.
+func (c *Client) GetDeployKey(ctx context.Context, owner, repo string, id int64) (*DeployKey, error) {
+	var key DeployKey
+	err := c.do(ctx, http.MethodGet, escapePath("repos", owner, repo, "keys", strconv.FormatInt(id, 10)), nil, &key)
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// DeleteDeployKey removes a deploy key from a repository.
+func (c *Client) DeleteDeployKey(ctx context.Context, owner, repo string, id int64) error {
+	return c.do(ctx, http.MethodDelete, escapePath("repos", owner, repo, "keys", strconv.FormatInt(id, 10)), nil, nil)
+}
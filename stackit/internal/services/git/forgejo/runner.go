@@ -0,0 +1,24 @@
+package forgejo
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetRunnerRegistrationToken returns the token to register Forgejo Actions runners for an organization.
+// If repo is not empty, the runners are registered for that repository of the organization instead.
+// The token can be used for multiple runners, Forgejo returns the same token until it is reset.
+func (c *Client) GetRunnerRegistrationToken(ctx context.Context, org, repo string) (string, error) {
+	path := escapePath("orgs", org, "actions", "runners", "registration-token")
+	if repo != "" {
+		path = escapePath("repos", org, repo, "actions", "runners", "registration-token")
+	}
+	var resp struct {
+		Token string `json:"token"`
+	}
+	err := c.do(ctx, http.MethodGet, path, nil, &resp)
+	if err != nil {
+		return "", err
+	}
+	return resp.Token, nil
+}
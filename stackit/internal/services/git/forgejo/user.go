@@ -0,0 +1,77 @@
+package forgejo
+
+import (
+	"context"
+	"net/http"
+)
+
+// User is a user account of the Git instance.
+type User struct {
+	ID            int64  `json:"id"`
+	Login         string `json:"login"`
+	FullName      string `json:"full_name"`
+	Email         string `json:"email"`
+	IsAdmin       bool   `json:"is_admin"`
+	Restricted    bool   `json:"restricted"`
+	ProhibitLogin bool   `json:"prohibit_login"`
+}
+
+// CreateUserOptions are the options to create a user. Creating users requires an access token of an administrator.
+type CreateUserOptions struct {
+	Username           string `json:"username"`
+	Email              string `json:"email"`
+	FullName           string `json:"full_name"`
+	Password           string `json:"password"`
+	MustChangePassword bool   `json:"must_change_password"`
+	Restricted         bool   `json:"restricted"`
+	SendNotify         bool   `json:"send_notify"`
+}
+
+// EditUserOptions are the options to update a user. Only set fields are updated.
+type EditUserOptions struct {
+	// LoginName and SourceID are required by older API versions, for local users they are the username and 0.
+	LoginName          string  `json:"login_name"`
+	SourceID           int64   `json:"source_id"`
+	Email              *string `json:"email,omitempty"`
+	FullName           *string `json:"full_name,omitempty"`
+	Password           *string `json:"password,omitempty"`
+	MustChangePassword *bool   `json:"must_change_password,omitempty"`
+	Admin              *bool   `json:"admin,omitempty"`
+	Restricted         *bool   `json:"restricted,omitempty"`
+	ProhibitLogin      *bool   `json:"prohibit_login,omitempty"`
+}
+
+// CreateUser creates a local user.
+func (c *Client) CreateUser(ctx context.Context, opts *CreateUserOptions) (*User, error) {
+	var user User
+	err := c.do(ctx, http.MethodPost, "/admin/users", opts, &user)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetUser reads a user.
+func (c *Client) GetUser(ctx context.Context, username string) (*User, error) {
+	var user User
+	err := c.do(ctx, http.MethodGet, escapePath("users", username), nil, &user)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// EditUser updates a user.
+func (c *Client) EditUser(ctx context.Context, username string, opts *EditUserOptions) (*User, error) {
+	var user User
+	err := c.do(ctx, http.MethodPatch, escapePath("admin", "users", username), opts, &user)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// DeleteUser deletes a user. The API refuses to delete users which still own repositories or organizations.
+func (c *Client) DeleteUser(ctx context.Context, username string) error {
+	return c.do(ctx, http.MethodDelete, escapePath("admin", "users", username), nil, nil)
+}
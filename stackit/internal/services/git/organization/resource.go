@@ -0,0 +1,363 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &organizationResource{}
+	_ resource.ResourceWithConfigure   = &organizationResource{}
+	_ resource.ResourceWithImportState = &organizationResource{}
+)
+
+var nameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+type Model struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	Url            types.String `tfsdk:"url"`
+	Token          types.String `tfsdk:"token"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationId types.Int64  `tfsdk:"organization_id"`
+	FullName       types.String `tfsdk:"full_name"`
+	Description    types.String `tfsdk:"description"`
+	Website        types.String `tfsdk:"website"`
+	Visibility     types.String `tfsdk:"visibility"`
+}
+
+// NewOrganizationResource is a helper function to simplify the provider implementation.
+func NewOrganizationResource() resource.Resource {
+	return &organizationResource{}
+}
+
+// organizationResource is the resource implementation.
+type organizationResource struct{}
+
+// Metadata returns the resource type name.
+func (r *organizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_organization"
+}
+
+// Configure checks that beta resources are enabled.
+func (r *organizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_git_organization", "resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git organization configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "Git organization resource schema. Manages an organization inside a STACKIT Git instance via its Forgejo-compatible API. " +
+			"Existing organizations can be imported with the identifier \"`url`,`name`\". The `token` isn't part of the identifier, so the imported organization is only refreshed and updated with the configured `token` by the next apply.",
+		"id":              "Terraform's internal resource identifier. It is structured as \"`name`\".",
+		"url":             "URL of the Git instance, e.g. from `stackit_git.url`.",
+		"token":           "Access token of an instance user, which is used to authenticate against the Forgejo API of the instance.",
+		"name":            "Name of the organization, which is part of the repository URLs.",
+		"organization_id": "ID of the organization within the Git instance.",
+		"full_name":       "Display name of the organization.",
+		"description":     "Description of the organization.",
+		"website":         "Website of the organization.",
+		"visibility":      "Visibility of the organization. One of `public`, `limited` (visible to signed-in users) or `private` (visible to members only).",
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         descriptions["main"],
+		MarkdownDescription: features.AddBetaDescription(descriptions["main"], core.Resource),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: descriptions["url"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: descriptions["token"],
+				Required:    true,
+				Sensitive:   true,
+			},
+			"name": schema.StringAttribute{
+				Description: descriptions["name"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+					stringvalidator.LengthAtMost(40),
+					stringvalidator.RegexMatches(nameRegex, "must start with a letter or digit and only contain letters, digits, '_', '.' and '-'"),
+				},
+			},
+			"organization_id": schema.Int64Attribute{
+				Description: descriptions["organization_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				Description: descriptions["full_name"],
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"description": schema.StringAttribute{
+				Description: descriptions["description"],
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"website": schema.StringAttribute{
+				Description: descriptions["website"],
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"visibility": schema.StringAttribute{
+				Description: descriptions["visibility"],
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("public"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("public", "limited", "private"),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *organizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "name", name)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	org, err := client.CreateOrganization(ctx, toCreatePayload(&model))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git organization", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(org, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git organization", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git organization created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *organizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "name", name)
+
+	// after an import the token isn't known yet, it is set from the configuration by the next apply
+	if model.Token.IsNull() {
+		tflog.Info(ctx, "Git organization not refreshed, no token in state")
+		return
+	}
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	org, err := client.GetOrganization(ctx, name)
+	if err != nil {
+		if forgejo.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Git organization", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(org, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Git organization", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git organization read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "name", name)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	org, err := client.EditOrganization(ctx, name, toUpdatePayload(&model))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Git organization", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(org, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Git organization", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git organization updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "name", name)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	err := client.DeleteOrganization(ctx, name)
+	if err != nil && !forgejo.IsNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Git organization", fmt.Sprintf("Calling API: %v. Organizations can only be deleted once all of their repositories are deleted.", err))
+		return
+	}
+	tflog.Info(ctx, "Git organization deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: url,name
+func (r *organizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing Git organization",
+			fmt.Sprintf("Expected import identifier with format: [url],[name]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"id":   idParts[1],
+		"url":  idParts[0],
+		"name": idParts[1],
+	})
+	tflog.Info(ctx, "Git organization state imported")
+}
+
+func mapFields(org *forgejo.Organization, model *Model) error {
+	if org == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if org.Name == "" {
+		return fmt.Errorf("organization name not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(org.Name)
+	model.Name = types.StringValue(org.Name)
+	model.OrganizationId = types.Int64Value(org.ID)
+	model.FullName = types.StringValue(org.FullName)
+	model.Description = types.StringValue(org.Description)
+	model.Website = types.StringValue(org.Website)
+	model.Visibility = types.StringValue(org.Visibility)
+	return nil
+}
+
+func toCreatePayload(model *Model) *forgejo.CreateOrganizationOptions {
+	return &forgejo.CreateOrganizationOptions{
+		Name:        model.Name.ValueString(),
+		FullName:    model.FullName.ValueString(),
+		Description: model.Description.ValueString(),
+		Website:     model.Website.ValueString(),
+		Visibility:  model.Visibility.ValueString(),
+	}
+}
+
+func toUpdatePayload(model *Model) *forgejo.EditOrganizationOptions {
+	return &forgejo.EditOrganizationOptions{
+		FullName:    model.FullName.ValueString(),
+		Description: model.Description.ValueString(),
+		Website:     model.Website.ValueString(),
+		Visibility:  model.Visibility.ValueString(),
+	}
+}
@@ -0,0 +1,201 @@
+package organization
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       *Model
+		input       *forgejo.Organization
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&Model{
+				Url:   types.StringValue("https://git.example.com"),
+				Token: types.StringValue("token"),
+			},
+			&forgejo.Organization{
+				ID:         1,
+				Name:       "team",
+				Visibility: "public",
+			},
+			&Model{
+				Id:             types.StringValue("team"),
+				Url:            types.StringValue("https://git.example.com"),
+				Token:          types.StringValue("token"),
+				Name:           types.StringValue("team"),
+				OrganizationId: types.Int64Value(1),
+				FullName:       types.StringValue(""),
+				Description:    types.StringValue(""),
+				Website:        types.StringValue(""),
+				Visibility:     types.StringValue("public"),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&Model{},
+			&forgejo.Organization{
+				ID:          2,
+				Name:        "team",
+				FullName:    "Team",
+				Description: "description",
+				Website:     "https://example.com",
+				Visibility:  "private",
+			},
+			&Model{
+				Id:             types.StringValue("team"),
+				Name:           types.StringValue("team"),
+				OrganizationId: types.Int64Value(2),
+				FullName:       types.StringValue("Team"),
+				Description:    types.StringValue("description"),
+				Website:        types.StringValue("https://example.com"),
+				Visibility:     types.StringValue("private"),
+			},
+			true,
+		},
+		{
+			"no_name",
+			&Model{},
+			&forgejo.Organization{ID: 1},
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			&forgejo.Organization{Name: "team"},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *forgejo.CreateOrganizationOptions
+	}{
+		{
+			"default_values",
+			&Model{
+				Name:        types.StringValue("team"),
+				FullName:    types.StringValue(""),
+				Description: types.StringValue(""),
+				Website:     types.StringValue(""),
+				Visibility:  types.StringValue("public"),
+			},
+			&forgejo.CreateOrganizationOptions{
+				Name:       "team",
+				Visibility: "public",
+			},
+		},
+		{
+			"simple_values",
+			&Model{
+				Name:        types.StringValue("team"),
+				FullName:    types.StringValue("Team"),
+				Description: types.StringValue("description"),
+				Website:     types.StringValue("https://example.com"),
+				Visibility:  types.StringValue("limited"),
+			},
+			&forgejo.CreateOrganizationOptions{
+				Name:        "team",
+				FullName:    "Team",
+				Description: "description",
+				Website:     "https://example.com",
+				Visibility:  "limited",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toCreatePayload(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *forgejo.EditOrganizationOptions
+	}{
+		{
+			"cleared_values",
+			&Model{
+				Name:        types.StringValue("team"),
+				FullName:    types.StringValue(""),
+				Description: types.StringValue(""),
+				Website:     types.StringValue(""),
+				Visibility:  types.StringValue("private"),
+			},
+			&forgejo.EditOrganizationOptions{
+				Visibility: "private",
+			},
+		},
+		{
+			"simple_values",
+			&Model{
+				Name:        types.StringValue("team"),
+				FullName:    types.StringValue("Team"),
+				Description: types.StringValue("description"),
+				Website:     types.StringValue("https://example.com"),
+				Visibility:  types.StringValue("public"),
+			},
+			&forgejo.EditOrganizationOptions{
+				FullName:    "Team",
+				Description: "description",
+				Website:     "https://example.com",
+				Visibility:  "public",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toUpdatePayload(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
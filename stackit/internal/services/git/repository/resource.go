@@ -0,0 +1,423 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &repositoryResource{}
+	_ resource.ResourceWithConfigure = &repositoryResource{}
+)
+
+var nameRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+type Model struct {
+	Id            types.String `tfsdk:"id"` // needed by TF
+	Url           types.String `tfsdk:"url"`
+	Token         types.String `tfsdk:"token"`
+	Organization  types.String `tfsdk:"organization"`
+	Name          types.String `tfsdk:"name"`
+	RepositoryId  types.Int64  `tfsdk:"repository_id"`
+	Description   types.String `tfsdk:"description"`
+	Private       types.Bool   `tfsdk:"private"`
+	Archived      types.Bool   `tfsdk:"archived"`
+	AutoInit      types.Bool   `tfsdk:"auto_init"`
+	DefaultBranch types.String `tfsdk:"default_branch"`
+	FullName      types.String `tfsdk:"full_name"`
+	HtmlUrl       types.String `tfsdk:"html_url"`
+	CloneUrl      types.String `tfsdk:"clone_url"`
+	SshUrl        types.String `tfsdk:"ssh_url"`
+}
+
+// NewRepositoryResource is a helper function to simplify the provider implementation.
+func NewRepositoryResource() resource.Resource {
+	return &repositoryResource{}
+}
+
+// repositoryResource is the resource implementation.
+type repositoryResource struct{}
+
+// Metadata returns the resource type name.
+func (r *repositoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_repository"
+}
+
+// Configure checks that beta resources are enabled.
+func (r *repositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_git_repository", "resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git repository configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *repositoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":           "Git repository resource schema. Manages a repository of an organization inside a STACKIT Git instance via its Forgejo-compatible API.",
+		"id":             "Terraform's internal resource identifier. It is structured as \"`organization`,`name`\".",
+		"url":            "URL of the Git instance, e.g. from `stackit_git.url`.",
+		"token":          "Access token of an instance user, which is used to authenticate against the Forgejo API of the instance.",
+		"organization":   "Name of the organization which owns the repository, e.g. from `stackit_git_organization.name`.",
+		"name":           "Name of the repository.",
+		"repository_id":  "ID of the repository within the Git instance.",
+		"description":    "Description of the repository.",
+		"private":        "Whether the repository is only visible to members of the organization.",
+		"archived":       "Whether the repository is archived, i.e. read-only.",
+		"auto_init":      "Whether to initialize the repository with an initial commit on creation. Changing this value does not affect existing repositories.",
+		"default_branch": "Default branch of the repository. Defaults to the default branch of the Git instance.",
+		"full_name":      "Full name of the repository, structured as \"`organization`/`name`\".",
+		"html_url":       "URL of the repository in the web interface.",
+		"clone_url":      "HTTPS URL to clone the repository.",
+		"ssh_url":        "SSH URL to clone the repository.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         descriptions["main"],
+		MarkdownDescription: features.AddBetaDescription(descriptions["main"], core.Resource),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: descriptions["url"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: descriptions["token"],
+				Required:    true,
+				Sensitive:   true,
+			},
+			"organization": schema.StringAttribute{
+				Description: descriptions["organization"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: descriptions["name"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+					stringvalidator.LengthAtMost(100),
+					stringvalidator.RegexMatches(nameRegex, "must only contain letters, digits, '_', '.' and '-'"),
+				},
+			},
+			"repository_id": schema.Int64Attribute{
+				Description: descriptions["repository_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: descriptions["description"],
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"private": schema.BoolAttribute{
+				Description: descriptions["private"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"archived": schema.BoolAttribute{
+				Description: descriptions["archived"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"auto_init": schema.BoolAttribute{
+				Description: descriptions["auto_init"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_branch": schema.StringAttribute{
+				Description: descriptions["default_branch"],
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				Description: descriptions["full_name"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"html_url": schema.StringAttribute{
+				Description: descriptions["html_url"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"clone_url": schema.StringAttribute{
+				Description: descriptions["clone_url"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssh_url": schema.StringAttribute{
+				Description: descriptions["ssh_url"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	repo, err := client.CreateOrganizationRepository(ctx, organization, toCreatePayload(&model))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git repository", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// archiving is not part of the creation options
+	if model.Archived.ValueBool() {
+		repo, err = client.EditRepository(ctx, organization, name, &forgejo.EditRepositoryOptions{Archived: new(true)})
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git repository", fmt.Sprintf("Archiving repository: %v", err))
+			return
+		}
+	}
+
+	err = mapFields(repo, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git repository", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git repository created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	repo, err := client.GetRepository(ctx, organization, name)
+	if err != nil {
+		if forgejo.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Git repository", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(repo, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Git repository", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git repository read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	repo, err := client.EditRepository(ctx, organization, name, toUpdatePayload(&model))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Git repository", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(repo, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Git repository", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git repository updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	err := client.DeleteRepository(ctx, organization, name)
+	if err != nil && !forgejo.IsNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Git repository", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Git repository deleted")
+}
+
+func mapFields(repo *forgejo.Repository, model *Model) error {
+	if repo == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if repo.Name == "" {
+		return fmt.Errorf("repository name not present")
+	}
+
+	organization := model.Organization.ValueString()
+	if repo.Owner != nil && repo.Owner.Login != "" {
+		organization = repo.Owner.Login
+	}
+
+	model.Id = utils.BuildInternalTerraformId(organization, repo.Name)
+	model.Organization = types.StringValue(organization)
+	model.Name = types.StringValue(repo.Name)
+	model.RepositoryId = types.Int64Value(repo.ID)
+	model.Description = types.StringValue(repo.Description)
+	model.Private = types.BoolValue(repo.Private)
+	model.Archived = types.BoolValue(repo.Archived)
+	model.DefaultBranch = types.StringValue(repo.DefaultBranch)
+	model.FullName = types.StringValue(repo.FullName)
+	model.HtmlUrl = types.StringValue(repo.HTMLURL)
+	model.CloneUrl = types.StringValue(repo.CloneURL)
+	model.SshUrl = types.StringValue(repo.SSHURL)
+	return nil
+}
+
+func toCreatePayload(model *Model) *forgejo.CreateRepositoryOptions {
+	return &forgejo.CreateRepositoryOptions{
+		Name:          model.Name.ValueString(),
+		Description:   model.Description.ValueString(),
+		Private:       model.Private.ValueBool(),
+		AutoInit:      model.AutoInit.ValueBool(),
+		DefaultBranch: model.DefaultBranch.ValueString(),
+	}
+}
+
+func toUpdatePayload(model *Model) *forgejo.EditRepositoryOptions {
+	payload := &forgejo.EditRepositoryOptions{
+		Description: new(model.Description.ValueString()),
+		Private:     new(model.Private.ValueBool()),
+		Archived:    new(model.Archived.ValueBool()),
+	}
+	if !utils.IsUndefined(model.DefaultBranch) {
+		payload.DefaultBranch = new(model.DefaultBranch.ValueString())
+	}
+	return payload
+}
@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo/forgejotest"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       *Model
+		input       *forgejo.Repository
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&Model{
+				Organization: types.StringValue("team"),
+			},
+			&forgejo.Repository{
+				ID:   1,
+				Name: "service",
+			},
+			&Model{
+				Id:            types.StringValue("team,service"),
+				Organization:  types.StringValue("team"),
+				Name:          types.StringValue("service"),
+				RepositoryId:  types.Int64Value(1),
+				Description:   types.StringValue(""),
+				Private:       types.BoolValue(false),
+				Archived:      types.BoolValue(false),
+				DefaultBranch: types.StringValue(""),
+				FullName:      types.StringValue(""),
+				HtmlUrl:       types.StringValue(""),
+				CloneUrl:      types.StringValue(""),
+				SshUrl:        types.StringValue(""),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&Model{
+				Organization: types.StringValue("Team"),
+				AutoInit:     types.BoolValue(true),
+			},
+			&forgejo.Repository{
+				ID:            2,
+				Owner:         &forgejo.User{Login: "team"},
+				Name:          "service",
+				FullName:      "team/service",
+				Description:   "description",
+				Private:       true,
+				Archived:      true,
+				DefaultBranch: "main",
+				HTMLURL:       "https://git.example.com/team/service",
+				CloneURL:      "https://git.example.com/team/service.git",
+				SSHURL:        "git@git.example.com:team/service.git",
+			},
+			&Model{
+				Id:            types.StringValue("team,service"),
+				Organization:  types.StringValue("team"),
+				Name:          types.StringValue("service"),
+				RepositoryId:  types.Int64Value(2),
+				Description:   types.StringValue("description"),
+				Private:       types.BoolValue(true),
+				Archived:      types.BoolValue(true),
+				AutoInit:      types.BoolValue(true),
+				DefaultBranch: types.StringValue("main"),
+				FullName:      types.StringValue("team/service"),
+				HtmlUrl:       types.StringValue("https://git.example.com/team/service"),
+				CloneUrl:      types.StringValue("https://git.example.com/team/service.git"),
+				SshUrl:        types.StringValue("git@git.example.com:team/service.git"),
+			},
+			true,
+		},
+		{
+			"no_name",
+			&Model{},
+			&forgejo.Repository{ID: 1},
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			&forgejo.Repository{Name: "service"},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *forgejo.CreateRepositoryOptions
+	}{
+		{
+			"default_values",
+			&Model{
+				Name:          types.StringValue("service"),
+				Description:   types.StringValue(""),
+				Private:       types.BoolValue(false),
+				AutoInit:      types.BoolValue(false),
+				DefaultBranch: types.StringUnknown(),
+			},
+			&forgejo.CreateRepositoryOptions{
+				Name: "service",
+			},
+		},
+		{
+			"simple_values",
+			&Model{
+				Name:          types.StringValue("service"),
+				Description:   types.StringValue("description"),
+				Private:       types.BoolValue(true),
+				AutoInit:      types.BoolValue(true),
+				DefaultBranch: types.StringValue("develop"),
+			},
+			&forgejo.CreateRepositoryOptions{
+				Name:          "service",
+				Description:   "description",
+				Private:       true,
+				AutoInit:      true,
+				DefaultBranch: "develop",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toCreatePayload(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *forgejo.EditRepositoryOptions
+	}{
+		{
+			"default_branch_unknown",
+			&Model{
+				Description:   types.StringValue(""),
+				Private:       types.BoolValue(false),
+				Archived:      types.BoolValue(false),
+				DefaultBranch: types.StringUnknown(),
+			},
+			&forgejo.EditRepositoryOptions{
+				Description: new(""),
+				Private:     new(false),
+				Archived:    new(false),
+			},
+		},
+		{
+			"simple_values",
+			&Model{
+				Description:   types.StringValue("description"),
+				Private:       types.BoolValue(true),
+				Archived:      types.BoolValue(true),
+				DefaultBranch: types.StringValue("main"),
+			},
+			&forgejo.EditRepositoryOptions{
+				Description:   new("description"),
+				Private:       new(true),
+				Archived:      new(true),
+				DefaultBranch: new("main"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toUpdatePayload(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	server := forgejotest.NewServer("token")
+	defer server.Close()
+
+	client := forgejo.NewClient(server.URL, "token", nil)
+	if _, err := client.CreateOrganization(ctx, &forgejo.CreateOrganizationOptions{Name: "team"}); err != nil {
+		t.Fatalf("Creating organization failed: %v", err)
+	}
+
+	model := &Model{
+		Organization:  types.StringValue("team"),
+		Name:          types.StringValue("service"),
+		Description:   types.StringValue("description"),
+		Private:       types.BoolValue(true),
+		Archived:      types.BoolValue(false),
+		AutoInit:      types.BoolValue(true),
+		DefaultBranch: types.StringUnknown(),
+	}
+	repo, err := client.CreateOrganizationRepository(ctx, "team", toCreatePayload(model))
+	if err != nil {
+		t.Fatalf("Creating repository failed: %v", err)
+	}
+	if err := mapFields(repo, model); err != nil {
+		t.Fatalf("Mapping repository failed: %v", err)
+	}
+
+	// the default branch of the instance is set on creation, applying the same model again must not change anything
+	repo, err = client.EditRepository(ctx, "team", "service", toUpdatePayload(model))
+	if err != nil {
+		t.Fatalf("Updating repository failed: %v", err)
+	}
+	updated := *model
+	if err := mapFields(repo, &updated); err != nil {
+		t.Fatalf("Mapping repository failed: %v", err)
+	}
+	if diff := cmp.Diff(&updated, model); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+	if model.DefaultBranch.ValueString() != "main" {
+		t.Fatalf("Expected default branch main, got %q", model.DefaultBranch.ValueString())
+	}
+}
@@ -0,0 +1,244 @@
+package runnertoken
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &runnerTokenResource{}
+	_ resource.ResourceWithConfigure = &runnerTokenResource{}
+)
+
+type Model struct {
+	Id                types.String `tfsdk:"id"` // needed by TF
+	Url               types.String `tfsdk:"url"`
+	Token             types.String `tfsdk:"token"`
+	Organization      types.String `tfsdk:"organization"`
+	Repository        types.String `tfsdk:"repository"`
+	RegistrationToken types.String `tfsdk:"registration_token"`
+}
+
+// NewRunnerTokenResource is a helper function to simplify the provider implementation.
+func NewRunnerTokenResource() resource.Resource {
+	return &runnerTokenResource{}
+}
+
+// runnerTokenResource is the resource implementation.
+type runnerTokenResource struct{}
+
+// Metadata returns the resource type name.
+func (r *runnerTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_runner_token"
+}
+
+// Configure checks that beta resources are enabled.
+func (r *runnerTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_git_runner_token", "resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git runner token configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *runnerTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "Git runner token resource schema. Retrieves the token to register Forgejo Actions runners for an organization or a repository of a STACKIT Git instance, e.g. to pass it to the `forgejo-runner register` command in the user data of a server.",
+		"note": "-> **Note:** Deleting this resource does not unregister runners, which were registered with the token. The token stays valid until it is reset in the runner settings of the organization or repository in the Git instance, since the Forgejo API provides no operation to revoke it.",
+		"id":   "Terraform's internal resource identifier. It is structured as \"`organization`\" or \"`organization`,`repository`\".",
+		"url":  "URL of the Git instance, e.g. from `stackit_git.url`.",
+		"token": "Access token of an instance user, which is used to authenticate against the Forgejo API of the instance. " +
+			"The user must be an owner of the organization or an administrator of the repository.",
+		"organization":       "Name of the organization the runners are registered for.",
+		"repository":         "Name of a repository of the organization. If set, the runners are only registered for this repository.",
+		"registration_token": "Token to register runners.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         descriptions["main"],
+		MarkdownDescription: fmt.Sprintf("%s\n\n%s", features.AddBetaDescription(descriptions["main"], core.Resource), descriptions["note"]),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: descriptions["url"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: descriptions["token"],
+				Required:    true,
+				Sensitive:   true,
+			},
+			"organization": schema.StringAttribute{
+				Description: descriptions["organization"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				Description: descriptions["repository"],
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"registration_token": schema.StringAttribute{
+				Description: descriptions["registration_token"],
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create retrieves the registration token and sets the initial Terraform state.
+func (r *runnerTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	repository := model.Repository.ValueString()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "repository", repository)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	err := readRegistrationToken(ctx, client, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git runner token", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git runner token created")
+}
+
+// Read refreshes the Terraform state with the current registration token.
+func (r *runnerTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	organization := model.Organization.ValueString()
+	repository := model.Repository.ValueString()
+	ctx = tflog.SetField(ctx, "organization", organization)
+	ctx = tflog.SetField(ctx, "repository", repository)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	err := readRegistrationToken(ctx, client, &model)
+	if err != nil {
+		if forgejo.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Git runner token", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git runner token read")
+}
+
+// Update updates the access token, all other changes create a new resource.
+func (r *runnerTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git runner token updated")
+}
+
+// Delete removes the registration token from the Terraform state. The token itself stays valid, since the Forgejo API
+// can't revoke it, so a warning tells to reset it in the Git instance.
+func (r *runnerTokenResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	core.LogAndAddWarning(ctx, &resp.Diagnostics, "Git runner token stays valid",
+		"The Forgejo API provides no operation to revoke runner registration tokens, so the token can still be used to register runners. "+
+			"Reset it in the runner settings of the organization or repository in the Git instance to invalidate it.")
+	tflog.Info(ctx, "Git runner token deleted")
+}
+
+// readRegistrationToken retrieves the registration token of the organization or repository of the model and maps it.
+func readRegistrationToken(ctx context.Context, client *forgejo.Client, model *Model) error {
+	token, err := client.GetRunnerRegistrationToken(ctx, model.Organization.ValueString(), model.Repository.ValueString())
+	if err != nil {
+		return err
+	}
+	mapFields(token, model)
+	return nil
+}
+
+func mapFields(token string, model *Model) {
+	idParts := []string{model.Organization.ValueString()}
+	if !utils.IsUndefined(model.Repository) {
+		idParts = append(idParts, model.Repository.ValueString())
+	}
+	model.Id = utils.BuildInternalTerraformId(idParts...)
+	model.RegistrationToken = types.StringValue(token)
+}
@@ -0,0 +1,172 @@
+package runnertoken
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo/forgejotest"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       *Model
+		input       string
+		expected    *Model
+	}{
+		{
+			"organization",
+			&Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringNull(),
+			},
+			"registration-token",
+			&Model{
+				Id:                types.StringValue("team"),
+				Organization:      types.StringValue("team"),
+				Repository:        types.StringNull(),
+				RegistrationToken: types.StringValue("registration-token"),
+			},
+		},
+		{
+			"repository",
+			&Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("service"),
+			},
+			"registration-token",
+			&Model{
+				Id:                types.StringValue("team,service"),
+				Organization:      types.StringValue("team"),
+				Repository:        types.StringValue("service"),
+				RegistrationToken: types.StringValue("registration-token"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			mapFields(tt.input, tt.state)
+			diff := cmp.Diff(tt.state, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadRegistrationToken(t *testing.T) {
+	ctx := context.Background()
+	server := forgejotest.NewServer("token")
+	defer server.Close()
+
+	client := forgejo.NewClient(server.URL, "token", nil)
+	if _, err := client.CreateOrganization(ctx, &forgejo.CreateOrganizationOptions{Name: "team"}); err != nil {
+		t.Fatalf("Creating organization failed: %v", err)
+	}
+	if _, err := client.CreateOrganizationRepository(ctx, "team", &forgejo.CreateRepositoryOptions{Name: "service"}); err != nil {
+		t.Fatalf("Creating repository failed: %v", err)
+	}
+	orgToken, err := client.GetRunnerRegistrationToken(ctx, "team", "")
+	if err != nil {
+		t.Fatalf("Getting organization token failed: %v", err)
+	}
+	repoToken, err := client.GetRunnerRegistrationToken(ctx, "team", "service")
+	if err != nil {
+		t.Fatalf("Getting repository token failed: %v", err)
+	}
+
+	tests := []struct {
+		description string
+		token       string
+		input       *Model
+		expected    *Model
+		isValid     bool
+		isNotFound  bool
+	}{
+		{
+			description: "organization",
+			token:       "token",
+			input: &Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringNull(),
+			},
+			expected: &Model{
+				Id:                types.StringValue("team"),
+				Organization:      types.StringValue("team"),
+				Repository:        types.StringNull(),
+				RegistrationToken: types.StringValue(orgToken),
+			},
+			isValid: true,
+		},
+		{
+			description: "repository",
+			token:       "token",
+			input: &Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("service"),
+			},
+			expected: &Model{
+				Id:                types.StringValue("team,service"),
+				Organization:      types.StringValue("team"),
+				Repository:        types.StringValue("service"),
+				RegistrationToken: types.StringValue(repoToken),
+			},
+			isValid: true,
+		},
+		{
+			description: "organization_not_found",
+			token:       "token",
+			input: &Model{
+				Organization: types.StringValue("other"),
+				Repository:   types.StringNull(),
+			},
+			isValid:    false,
+			isNotFound: true,
+		},
+		{
+			description: "repository_not_found",
+			token:       "token",
+			input: &Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringValue("other"),
+			},
+			isValid:    false,
+			isNotFound: true,
+		},
+		{
+			description: "invalid_access_token",
+			token:       "invalid",
+			input: &Model{
+				Organization: types.StringValue("team"),
+				Repository:   types.StringNull(),
+			},
+			isValid:    false,
+			isNotFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := readRegistrationToken(ctx, forgejo.NewClient(server.URL, tt.token, nil), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if !tt.isValid {
+				if forgejo.IsNotFound(err) != tt.isNotFound {
+					t.Fatalf("Expected not found %t, got error %v", tt.isNotFound, err)
+				}
+				return
+			}
+			diff := cmp.Diff(tt.input, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &userResource{}
+	_ resource.ResourceWithConfigure = &userResource{}
+)
+
+var usernameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+type Model struct {
+	Id                 types.String `tfsdk:"id"` // needed by TF
+	Url                types.String `tfsdk:"url"`
+	Token              types.String `tfsdk:"token"`
+	Username           types.String `tfsdk:"username"`
+	UserId             types.Int64  `tfsdk:"user_id"`
+	Email              types.String `tfsdk:"email"`
+	FullName           types.String `tfsdk:"full_name"`
+	Password           types.String `tfsdk:"password"`
+	MustChangePassword types.Bool   `tfsdk:"must_change_password"`
+	Admin              types.Bool   `tfsdk:"admin"`
+	Restricted         types.Bool   `tfsdk:"restricted"`
+	ProhibitLogin      types.Bool   `tfsdk:"prohibit_login"`
+}
+
+// NewUserResource is a helper function to simplify the provider implementation.
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+// userResource is the resource implementation.
+type userResource struct{}
+
+// Metadata returns the resource type name.
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_user"
+}
+
+// Configure checks that beta resources are enabled.
+func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_git_user", "resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git user configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":                 "Git user resource schema. Manages a local user account of a STACKIT Git instance via its Forgejo-compatible API. Requires an access token of an instance administrator.",
+		"id":                   "Terraform's internal resource identifier. It is structured as \"`username`\".",
+		"url":                  "URL of the Git instance, e.g. from `stackit_git.url`.",
+		"token":                "Access token of an instance administrator, which is used to authenticate against the Forgejo API of the instance.",
+		"username":             "Username of the user.",
+		"user_id":              "ID of the user within the Git instance.",
+		"email":                "Email address of the user.",
+		"full_name":            "Full name of the user.",
+		"password":             "Initial password of the user. Changing the password sets it again.",
+		"must_change_password": "Whether the user has to change the password on the next login.",
+		"admin":                "Whether the user is an administrator of the Git instance.",
+		"restricted":           "Whether the user is restricted, i.e. only has access to organizations and repositories they are explicitly added to.",
+		"prohibit_login":       "Whether the user is blocked from signing in.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         descriptions["main"],
+		MarkdownDescription: features.AddBetaDescription(descriptions["main"], core.Resource),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: descriptions["url"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: descriptions["token"],
+				Required:    true,
+				Sensitive:   true,
+			},
+			"username": schema.StringAttribute{
+				Description: descriptions["username"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+					stringvalidator.LengthAtMost(40),
+					stringvalidator.RegexMatches(usernameRegex, "must start with a letter or digit and only contain letters, digits, '_', '.' and '-'"),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Description: descriptions["user_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: descriptions["email"],
+				Required:    true,
+			},
+			"full_name": schema.StringAttribute{
+				Description: descriptions["full_name"],
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"password": schema.StringAttribute{
+				Description: descriptions["password"],
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+				},
+			},
+			"must_change_password": schema.BoolAttribute{
+				Description: descriptions["must_change_password"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"admin": schema.BoolAttribute{
+				Description: descriptions["admin"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"restricted": schema.BoolAttribute{
+				Description: descriptions["restricted"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"prohibit_login": schema.BoolAttribute{
+				Description: descriptions["prohibit_login"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	username := model.Username.ValueString()
+	ctx = tflog.SetField(ctx, "username", username)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	user, err := client.CreateUser(ctx, toCreatePayload(&model))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git user", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// administrator permissions and login restrictions are not part of the creation options
+	if model.Admin.ValueBool() || model.ProhibitLogin.ValueBool() {
+		user, err = client.EditUser(ctx, username, toUpdatePayload(&model, false))
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git user", fmt.Sprintf("Updating permissions: %v", err))
+			return
+		}
+	}
+
+	err = mapFields(user, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Git user", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git user created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	username := model.Username.ValueString()
+	ctx = tflog.SetField(ctx, "username", username)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	user, err := client.GetUser(ctx, username)
+	if err != nil {
+		if forgejo.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Git user", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(user, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Git user", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git user read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateModel Model
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	username := model.Username.ValueString()
+	ctx = tflog.SetField(ctx, "username", username)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	// the password is only set again if it was changed, so the user can keep the password chosen on the first login
+	passwordChanged := !model.Password.Equal(stateModel.Password)
+	user, err := client.EditUser(ctx, username, toUpdatePayload(&model, passwordChanged))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Git user", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(user, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Git user", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git user updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	username := model.Username.ValueString()
+	ctx = tflog.SetField(ctx, "username", username)
+
+	client := forgejo.NewClient(model.Url.ValueString(), model.Token.ValueString(), nil)
+
+	err := client.DeleteUser(ctx, username)
+	if err != nil && !forgejo.IsNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Git user", fmt.Sprintf("Calling API: %v. Users can only be deleted once they don't own any repositories or organizations.", err))
+		return
+	}
+	tflog.Info(ctx, "Git user deleted")
+}
+
+// mapFields maps the user to the model. The API doesn't return the password and whether it must be changed, so they are kept.
+func mapFields(user *forgejo.User, model *Model) error {
+	if user == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if user.Login == "" {
+		return fmt.Errorf("username not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(user.Login)
+	model.Username = types.StringValue(user.Login)
+	model.UserId = types.Int64Value(user.ID)
+	model.Email = types.StringValue(user.Email)
+	model.FullName = types.StringValue(user.FullName)
+	model.Admin = types.BoolValue(user.IsAdmin)
+	model.Restricted = types.BoolValue(user.Restricted)
+	model.ProhibitLogin = types.BoolValue(user.ProhibitLogin)
+	return nil
+}
+
+func toCreatePayload(model *Model) *forgejo.CreateUserOptions {
+	return &forgejo.CreateUserOptions{
+		Username:           model.Username.ValueString(),
+		Email:              model.Email.ValueString(),
+		FullName:           model.FullName.ValueString(),
+		Password:           model.Password.ValueString(),
+		MustChangePassword: model.MustChangePassword.ValueBool(),
+		Restricted:         model.Restricted.ValueBool(),
+	}
+}
+
+func toUpdatePayload(model *Model, setPassword bool) *forgejo.EditUserOptions {
+	payload := &forgejo.EditUserOptions{
+		LoginName:     model.Username.ValueString(),
+		Email:         new(model.Email.ValueString()),
+		FullName:      new(model.FullName.ValueString()),
+		Admin:         new(model.Admin.ValueBool()),
+		Restricted:    new(model.Restricted.ValueBool()),
+		ProhibitLogin: new(model.ProhibitLogin.ValueBool()),
+	}
+	if setPassword {
+		payload.Password = new(model.Password.ValueString())
+		payload.MustChangePassword = new(model.MustChangePassword.ValueBool())
+	}
+	return payload
+}
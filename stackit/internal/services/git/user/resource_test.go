@@ -0,0 +1,200 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/forgejo"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       *Model
+		input       *forgejo.User
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"password_is_kept",
+			&Model{
+				Password:           types.StringValue("password"),
+				MustChangePassword: types.BoolValue(true),
+			},
+			&forgejo.User{
+				ID:    1,
+				Login: "jane",
+				Email: "jane@example.com",
+			},
+			&Model{
+				Id:                 types.StringValue("jane"),
+				Username:           types.StringValue("jane"),
+				UserId:             types.Int64Value(1),
+				Email:              types.StringValue("jane@example.com"),
+				FullName:           types.StringValue(""),
+				Password:           types.StringValue("password"),
+				MustChangePassword: types.BoolValue(true),
+				Admin:              types.BoolValue(false),
+				Restricted:         types.BoolValue(false),
+				ProhibitLogin:      types.BoolValue(false),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&Model{},
+			&forgejo.User{
+				ID:            2,
+				Login:         "jane",
+				FullName:      "Jane Doe",
+				Email:         "jane@example.com",
+				IsAdmin:       true,
+				Restricted:    true,
+				ProhibitLogin: true,
+			},
+			&Model{
+				Id:            types.StringValue("jane"),
+				Username:      types.StringValue("jane"),
+				UserId:        types.Int64Value(2),
+				Email:         types.StringValue("jane@example.com"),
+				FullName:      types.StringValue("Jane Doe"),
+				Admin:         types.BoolValue(true),
+				Restricted:    types.BoolValue(true),
+				ProhibitLogin: types.BoolValue(true),
+			},
+			true,
+		},
+		{
+			"no_username",
+			&Model{},
+			&forgejo.User{ID: 1},
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			&forgejo.User{Login: "jane"},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *forgejo.CreateUserOptions
+	}{
+		{
+			"simple_values",
+			&Model{
+				Username:           types.StringValue("jane"),
+				Email:              types.StringValue("jane@example.com"),
+				FullName:           types.StringValue("Jane Doe"),
+				Password:           types.StringValue("password"),
+				MustChangePassword: types.BoolValue(true),
+				Admin:              types.BoolValue(true),
+				Restricted:         types.BoolValue(true),
+			},
+			&forgejo.CreateUserOptions{
+				Username:           "jane",
+				Email:              "jane@example.com",
+				FullName:           "Jane Doe",
+				Password:           "password",
+				MustChangePassword: true,
+				Restricted:         true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toCreatePayload(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	model := &Model{
+		Username:           types.StringValue("jane"),
+		Email:              types.StringValue("jane@example.com"),
+		FullName:           types.StringValue(""),
+		Password:           types.StringValue("password"),
+		MustChangePassword: types.BoolValue(false),
+		Admin:              types.BoolValue(true),
+		Restricted:         types.BoolValue(false),
+		ProhibitLogin:      types.BoolValue(false),
+	}
+	tests := []struct {
+		description string
+		setPassword bool
+		expected    *forgejo.EditUserOptions
+	}{
+		{
+			"password_unchanged",
+			false,
+			&forgejo.EditUserOptions{
+				LoginName:     "jane",
+				Email:         new("jane@example.com"),
+				FullName:      new(""),
+				Admin:         new(true),
+				Restricted:    new(false),
+				ProhibitLogin: new(false),
+			},
+		},
+		{
+			"password_changed",
+			true,
+			&forgejo.EditUserOptions{
+				LoginName:          "jane",
+				Email:              new("jane@example.com"),
+				FullName:           new(""),
+				Password:           new("password"),
+				MustChangePassword: new(false),
+				Admin:              new(true),
+				Restricted:         new(false),
+				ProhibitLogin:      new(false),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toUpdatePayload(model, tt.setPassword)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
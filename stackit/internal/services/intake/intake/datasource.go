@@ -0,0 +1,245 @@
+package intake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	intakeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/intake/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+
+	intake "github.com/stackitcloud/stackit-sdk-go/services/intake/v1betaapi"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource = &intakeDataSource{}
+)
+
+// NewIntakeDataSource is a helper function to simplify the provider implementation
+func NewIntakeDataSource() datasource.DataSource {
+	return &intakeDataSource{}
+}
+
+type intakeDataSource struct {
+	client       *intake.APIClient
+	providerData core.ProviderData
+}
+
+func (r *intakeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_intake"
+}
+
+// Configure adds the provider configured client to the data source
+func (r *intakeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := intakeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "Intake client configured for data source")
+}
+
+// Schema defines the schema for the data source
+func (r *intakeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":                  "Datasource for STACKIT Intake.",
+		"id":                    "Terraform's internal resource identifier. It is structured as \"`project_id`,`region`,`intake_id`\".",
+		"project_id":            "STACKIT Project ID to which the intake is associated.",
+		"region":                "The resource region. If not defined, the provider region is used.",
+		"intake_id":             "The intake ID.",
+		"runner_id":             "The ID of the intake runner which feeds the intake.",
+		"name":                  "The name of the intake.",
+		"description":           "The description of the intake.",
+		"labels":                "User-defined labels.",
+		"catalog":               "The Apache Iceberg catalog the intake writes into.",
+		"catalog.uri":           "The URI of the Iceberg catalog endpoint.",
+		"catalog.warehouse":     "The name of the warehouse within the catalog.",
+		"catalog.namespace":     "The namespace of the target table.",
+		"catalog.table_name":    "The name of the target table.",
+		"catalog.partitioning":  "The partitioning of the target table.",
+		"catalog.partition_by":  "The partition transforms of the target table.",
+		"auth":                  "The authentication against the catalog.",
+		"auth.type":             "The authentication type.",
+		"dremio":                "Dremio authentication settings.",
+		"token_endpoint":        "The Dremio endpoint which exchanges the personal access token.",
+		"personal_access_token": "The Dremio personal access token. It is never returned by the API and therefore always empty.",
+		"topic":                 "The topic producers write their messages to.",
+		"uri":                   "The URI producers connect to.",
+		"create_time":           "The creation time of the intake.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["region"],
+			},
+			"intake_id": schema.StringAttribute{
+				Description: descriptions["intake_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"runner_id": schema.StringAttribute{
+				Description: descriptions["runner_id"],
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: descriptions["name"],
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: descriptions["description"],
+				Computed:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: descriptions["labels"],
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"catalog": schema.SingleNestedAttribute{
+				Description: descriptions["catalog"],
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"uri": schema.StringAttribute{
+						Description: descriptions["catalog.uri"],
+						Computed:    true,
+					},
+					"warehouse": schema.StringAttribute{
+						Description: descriptions["catalog.warehouse"],
+						Computed:    true,
+					},
+					"namespace": schema.StringAttribute{
+						Description: descriptions["catalog.namespace"],
+						Computed:    true,
+					},
+					"table_name": schema.StringAttribute{
+						Description: descriptions["catalog.table_name"],
+						Computed:    true,
+					},
+					"partitioning": schema.StringAttribute{
+						Description: descriptions["catalog.partitioning"],
+						Computed:    true,
+					},
+					"partition_by": schema.ListAttribute{
+						Description: descriptions["catalog.partition_by"],
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"auth": schema.SingleNestedAttribute{
+						Description: descriptions["auth"],
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Description: descriptions["auth.type"],
+								Computed:    true,
+							},
+							"dremio": schema.SingleNestedAttribute{
+								Description: descriptions["dremio"],
+								Computed:    true,
+								Attributes: map[string]schema.Attribute{
+									"token_endpoint": schema.StringAttribute{
+										Description: descriptions["token_endpoint"],
+										Computed:    true,
+									},
+									"personal_access_token": schema.StringAttribute{
+										Description: descriptions["personal_access_token"],
+										Computed:    true,
+										Sensitive:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"topic": schema.StringAttribute{
+				Description: descriptions["topic"],
+				Computed:    true,
+			},
+			"uri": schema.StringAttribute{
+				Description: descriptions["uri"],
+				Computed:    true,
+			},
+			"create_time": schema.StringAttribute{
+				Description: descriptions["create_time"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *intakeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	intakeId := model.IntakeId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "intake_id", intakeId)
+
+	intakeResp, err := r.client.DefaultAPI.GetIntake(ctx, projectId, region, intakeId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading intake", fmt.Sprintf("Intake with ID %s not found in project %s and region %s", intakeId, projectId, region))
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading intake", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(ctx, intakeResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading intake", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Intake read")
+}
@@ -0,0 +1,828 @@
+package intake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	intakeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/intake/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+
+	intake "github.com/stackitcloud/stackit-sdk-go/services/intake/v1betaapi"
+	"github.com/stackitcloud/stackit-sdk-go/services/intake/v1betaapi/wait"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &intakeResource{}
+	_ resource.ResourceWithConfigure      = &intakeResource{}
+	_ resource.ResourceWithImportState    = &intakeResource{}
+	_ resource.ResourceWithModifyPlan     = &intakeResource{}
+	_ resource.ResourceWithValidateConfig = &intakeResource{}
+)
+
+const (
+	partitioningNone       = "none"
+	partitioningIntakeTime = "intake-time"
+	partitioningManual     = "manual"
+
+	authTypeNone   = "none"
+	authTypeDremio = "dremio"
+)
+
+var (
+	partitioningValues = []string{partitioningNone, partitioningIntakeTime, partitioningManual}
+	authTypeValues     = []string{authTypeNone, authTypeDremio}
+)
+
+// Model is the internal model of the terraform resource
+type Model struct {
+	Id          types.String `tfsdk:"id"` // needed by TF
+	ProjectId   types.String `tfsdk:"project_id"`
+	Region      types.String `tfsdk:"region"`
+	IntakeId    types.String `tfsdk:"intake_id"`
+	RunnerId    types.String `tfsdk:"runner_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Labels      types.Map    `tfsdk:"labels"`
+	Catalog     types.Object `tfsdk:"catalog"`
+	Topic       types.String `tfsdk:"topic"`
+	Uri         types.String `tfsdk:"uri"`
+	CreateTime  types.String `tfsdk:"create_time"`
+}
+
+// Struct corresponding to Model.Catalog
+type catalogModel struct {
+	Uri          types.String `tfsdk:"uri"`
+	Warehouse    types.String `tfsdk:"warehouse"`
+	Namespace    types.String `tfsdk:"namespace"`
+	TableName    types.String `tfsdk:"table_name"`
+	Partitioning types.String `tfsdk:"partitioning"`
+	PartitionBy  types.List   `tfsdk:"partition_by"`
+	Auth         types.Object `tfsdk:"auth"`
+}
+
+var catalogTypes = map[string]attr.Type{
+	"uri":          types.StringType,
+	"warehouse":    types.StringType,
+	"namespace":    types.StringType,
+	"table_name":   types.StringType,
+	"partitioning": types.StringType,
+	"partition_by": types.ListType{ElemType: types.StringType},
+	"auth":         types.ObjectType{AttrTypes: authTypes},
+}
+
+// Struct corresponding to catalogModel.Auth
+type authModel struct {
+	Type   types.String `tfsdk:"type"`
+	Dremio types.Object `tfsdk:"dremio"`
+}
+
+var authTypes = map[string]attr.Type{
+	"type":   types.StringType,
+	"dremio": types.ObjectType{AttrTypes: dremioTypes},
+}
+
+// Struct corresponding to authModel.Dremio
+type dremioModel struct {
+	TokenEndpoint       types.String `tfsdk:"token_endpoint"`
+	PersonalAccessToken types.String `tfsdk:"personal_access_token"`
+}
+
+var dremioTypes = map[string]attr.Type{
+	"token_endpoint":        types.StringType,
+	"personal_access_token": types.StringType,
+}
+
+// NewIntakeResource is a helper function to simplify the provider implementation.
+func NewIntakeResource() resource.Resource {
+	return &intakeResource{}
+}
+
+// intakeResource is the resource implementation.
+type intakeResource struct {
+	client       *intake.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *intakeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_intake"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *intakeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := intakeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "Intake client configured")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *intakeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *intakeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":                  "Manages STACKIT Intake. An intake receives messages through the runner it is attached to and writes them into an Apache Iceberg table.",
+		"id":                    "Terraform's internal resource identifier. It is structured as \"`project_id`,`region`,`intake_id`\".",
+		"project_id":            "STACKIT Project ID to which the intake is associated.",
+		"region":                "The resource region. If not defined, the provider region is used.",
+		"intake_id":             "The intake ID.",
+		"runner_id":             "The ID of the intake runner which feeds the intake.",
+		"name":                  "The name of the intake.",
+		"description":           "The description of the intake.",
+		"labels":                "User-defined labels.",
+		"catalog":               "The Apache Iceberg catalog the intake writes into.",
+		"catalog.uri":           "The URI of the Iceberg catalog endpoint.",
+		"catalog.warehouse":     "The name of the warehouse within the catalog.",
+		"catalog.namespace":     "The namespace of the target table. Defaults to `intake`.",
+		"catalog.table_name":    "The name of the target table. Defaults to the intake ID.",
+		"catalog.partitioning":  fmt.Sprintf("The partitioning of the target table. %s", utils.FormatPossibleValues(partitioningValues...)),
+		"catalog.partition_by":  fmt.Sprintf("The partition transforms of the target table, e.g. `day(__intake_ts)`. Only allowed if `partitioning` is `%s`.", partitioningManual),
+		"auth":                  "The authentication against the catalog.",
+		"auth.type":             fmt.Sprintf("The authentication type. %s", utils.FormatPossibleValues(authTypeValues...)),
+		"dremio":                fmt.Sprintf("Dremio authentication settings. Required if `type` is `%s`.", authTypeDremio),
+		"token_endpoint":        "The Dremio endpoint which exchanges the personal access token.",
+		"personal_access_token": "The Dremio personal access token.",
+		"topic":                 "The topic producers write their messages to.",
+		"uri":                   "The URI producers connect to.",
+		"create_time":           "The creation time of the intake.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"intake_id": schema.StringAttribute{
+				Description: descriptions["intake_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"runner_id": schema.StringAttribute{
+				Description: descriptions["runner_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: descriptions["name"],
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: descriptions["description"],
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: descriptions["labels"],
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"catalog": schema.SingleNestedAttribute{
+				Description: descriptions["catalog"],
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"uri": schema.StringAttribute{
+						Description: descriptions["catalog.uri"],
+						Required:    true,
+					},
+					"warehouse": schema.StringAttribute{
+						Description: descriptions["catalog.warehouse"],
+						Required:    true,
+					},
+					"namespace": schema.StringAttribute{
+						Description: descriptions["catalog.namespace"],
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"table_name": schema.StringAttribute{
+						Description: descriptions["catalog.table_name"],
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"partitioning": schema.StringAttribute{
+						Description: descriptions["catalog.partitioning"],
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(partitioningValues...),
+						},
+					},
+					"partition_by": schema.ListAttribute{
+						Description: descriptions["catalog.partition_by"],
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"auth": schema.SingleNestedAttribute{
+						Description: descriptions["auth"],
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Description: descriptions["auth.type"],
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(authTypeValues...),
+								},
+							},
+							"dremio": schema.SingleNestedAttribute{
+								Description: descriptions["dremio"],
+								Optional:    true,
+								Attributes: map[string]schema.Attribute{
+									"token_endpoint": schema.StringAttribute{
+										Description: descriptions["token_endpoint"],
+										Required:    true,
+									},
+									"personal_access_token": schema.StringAttribute{
+										Description: descriptions["personal_access_token"],
+										Required:    true,
+										Sensitive:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"topic": schema.StringAttribute{
+				Description: descriptions["topic"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				Description: descriptions["uri"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_time": schema.StringAttribute{
+				Description: descriptions["create_time"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the combination of partitioning and auth settings.
+func (r *intakeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() || utils.IsUndefined(model.Catalog) {
+		return
+	}
+
+	var catalog catalogModel
+	resp.Diagnostics.Append(model.Catalog.As(ctx, &catalog, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !utils.IsUndefined(catalog.PartitionBy) && !catalog.Partitioning.IsUnknown() && catalog.Partitioning.ValueString() != partitioningManual {
+		resp.Diagnostics.AddAttributeError(path.Root("catalog").AtName("partition_by"), "Invalid configuration",
+			fmt.Sprintf("partition_by can only be set if partitioning is %q", partitioningManual))
+	}
+	if catalog.Partitioning.ValueString() == partitioningManual && catalog.PartitionBy.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("catalog").AtName("partition_by"), "Invalid configuration",
+			fmt.Sprintf("partition_by must be set if partitioning is %q", partitioningManual))
+	}
+
+	if utils.IsUndefined(catalog.Auth) {
+		return
+	}
+	var auth authModel
+	resp.Diagnostics.Append(catalog.Auth.As(ctx, &auth, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || auth.Type.IsUnknown() {
+		return
+	}
+	if auth.Type.ValueString() == authTypeDremio && auth.Dremio.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("catalog").AtName("auth").AtName("dremio"), "Invalid configuration",
+			fmt.Sprintf("dremio must be set if the auth type is %q", authTypeDremio))
+	}
+	if auth.Type.ValueString() != authTypeDremio && !auth.Dremio.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("catalog").AtName("auth").AtName("dremio"), "Invalid configuration",
+			fmt.Sprintf("dremio can only be set if the auth type is %q", authTypeDremio))
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *intakeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	payload, err := toCreatePayload(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating intake", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	intakeResp, err := r.client.DefaultAPI.CreateIntake(ctx, projectId, region).CreateIntakePayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating intake", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": projectId,
+		"region":     region,
+		"intake_id":  intakeResp.Id,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	intakeResp, err = wait.CreateIntakeWaitHandler(ctx, r.client.DefaultAPI, projectId, region, intakeResp.GetId()).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating intake", fmt.Sprintf("Intake creation waiting: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, intakeResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating intake", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Intake created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *intakeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	intakeId := model.IntakeId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "intake_id", intakeId)
+
+	intakeResp, err := r.client.DefaultAPI.GetIntake(ctx, projectId, region, intakeId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading intake", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(ctx, intakeResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading intake", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Intake read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *intakeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	intakeId := model.IntakeId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "intake_id", intakeId)
+
+	payload, err := toUpdatePayload(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating intake", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	_, err = r.client.DefaultAPI.UpdateIntake(ctx, projectId, region, intakeId).UpdateIntakePayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating intake", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	intakeResp, err := wait.UpdateIntakeWaitHandler(ctx, r.client.DefaultAPI, projectId, region, intakeId).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating intake", fmt.Sprintf("Intake update waiting: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, intakeResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating intake", fmt.Sprintf("Processing API response: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Intake updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *intakeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	intakeId := model.IntakeId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "intake_id", intakeId)
+
+	err := r.client.DefaultAPI.DeleteIntake(ctx, projectId, region, intakeId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Intake already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting intake", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	_, err = wait.DeleteIntakeWaitHandler(ctx, r.client.DefaultAPI, projectId, region, intakeId).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting intake", fmt.Sprintf("Intake deletion waiting: %v", err))
+		return
+	}
+
+	tflog.Info(ctx, "Intake deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the Intake resource import identifier is: [project_id],[region],[intake_id]
+func (r *intakeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing intake",
+			fmt.Sprintf("Expected import identifier with format [project_id],[region],[intake_id], got %q", req.ID),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": idParts[0],
+		"region":     idParts[1],
+		"intake_id":  idParts[2],
+	})
+
+	tflog.Info(ctx, "Intake state imported")
+}
+
+// Maps intake fields to the provider internal model
+func mapFields(ctx context.Context, intakeResp *intake.IntakeResponse, model *Model, region string) error {
+	if intakeResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, intakeResp.Id)
+
+	labels, err := utils.MapLabels(ctx, &intakeResp.Labels, model.Labels)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := mapCatalog(ctx, &intakeResp.Catalog, model.Catalog)
+	if err != nil {
+		return fmt.Errorf("mapping catalog: %w", err)
+	}
+
+	model.IntakeId = types.StringValue(intakeResp.Id)
+	model.RunnerId = types.StringValue(intakeResp.IntakeRunnerId)
+	model.Name = types.StringValue(intakeResp.DisplayName)
+	model.Description = types.StringPointerValue(intakeResp.Description)
+	model.Labels = labels
+	model.Catalog = catalog
+	model.Region = types.StringValue(region)
+	model.Topic = types.StringValue(intakeResp.Topic)
+	model.Uri = types.StringValue(intakeResp.Uri)
+	model.CreateTime = types.StringValue(intakeResp.CreateTime.String())
+
+	return nil
+}
+
+// mapCatalog maps the catalog of the API response. The personal access token is never
+// returned by the API, so it is kept from the current model.
+func mapCatalog(ctx context.Context, catalogResp *intake.IntakeCatalog, current types.Object) (types.Object, error) {
+	currentPersonalAccessToken := types.StringNull()
+	if !utils.IsUndefined(current) {
+		var currentCatalog catalogModel
+		if diags := current.As(ctx, &currentCatalog, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return types.ObjectNull(catalogTypes), core.DiagsToError(diags)
+		}
+		if !utils.IsUndefined(currentCatalog.Auth) {
+			var currentAuth authModel
+			if diags := currentCatalog.Auth.As(ctx, &currentAuth, basetypes.ObjectAsOptions{}); diags.HasError() {
+				return types.ObjectNull(catalogTypes), core.DiagsToError(diags)
+			}
+			if !utils.IsUndefined(currentAuth.Dremio) {
+				var currentDremio dremioModel
+				if diags := currentAuth.Dremio.As(ctx, &currentDremio, basetypes.ObjectAsOptions{}); diags.HasError() {
+					return types.ObjectNull(catalogTypes), core.DiagsToError(diags)
+				}
+				currentPersonalAccessToken = currentDremio.PersonalAccessToken
+			}
+		}
+	}
+
+	partitionBy := types.ListNull(types.StringType)
+	if len(catalogResp.PartitionBy) > 0 {
+		var diags diag.Diagnostics
+		partitionBy, diags = types.ListValueFrom(ctx, types.StringType, catalogResp.PartitionBy)
+		if diags.HasError() {
+			return types.ObjectNull(catalogTypes), core.DiagsToError(diags)
+		}
+	}
+
+	auth := types.ObjectNull(authTypes)
+	if catalogResp.Auth != nil {
+		dremio := types.ObjectNull(dremioTypes)
+		if catalogResp.Auth.Dremio != nil {
+			var diags diag.Diagnostics
+			dremio, diags = types.ObjectValue(dremioTypes, map[string]attr.Value{
+				"token_endpoint":        types.StringValue(catalogResp.Auth.Dremio.TokenEndpoint),
+				"personal_access_token": currentPersonalAccessToken,
+			})
+			if diags.HasError() {
+				return types.ObjectNull(catalogTypes), core.DiagsToError(diags)
+			}
+		}
+		var diags diag.Diagnostics
+		auth, diags = types.ObjectValue(authTypes, map[string]attr.Value{
+			"type":   types.StringValue(catalogResp.Auth.Type),
+			"dremio": dremio,
+		})
+		if diags.HasError() {
+			return types.ObjectNull(catalogTypes), core.DiagsToError(diags)
+		}
+	}
+
+	catalog, diags := types.ObjectValue(catalogTypes, map[string]attr.Value{
+		"uri":          types.StringValue(catalogResp.Uri),
+		"warehouse":    types.StringValue(catalogResp.Warehouse),
+		"namespace":    types.StringPointerValue(catalogResp.Namespace),
+		"table_name":   types.StringPointerValue(catalogResp.TableName),
+		"partitioning": types.StringPointerValue(catalogResp.Partitioning),
+		"partition_by": partitionBy,
+		"auth":         auth,
+	})
+	if diags.HasError() {
+		return types.ObjectNull(catalogTypes), core.DiagsToError(diags)
+	}
+	return catalog, nil
+}
+
+// Build CreateIntakePayload from provider's model
+func toCreatePayload(ctx context.Context, model *Model) (*intake.CreateIntakePayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	labels, err := utils.LabelsToPayload(ctx, model.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := toCatalogPayload(ctx, model.Catalog)
+	if err != nil {
+		return nil, fmt.Errorf("converting catalog: %w", err)
+	}
+
+	return &intake.CreateIntakePayload{
+		DisplayName:    model.Name.ValueString(),
+		Description:    conversion.StringValueToPointer(model.Description),
+		Labels:         labels,
+		IntakeRunnerId: model.RunnerId.ValueString(),
+		Catalog:        *catalog,
+	}, nil
+}
+
+// Build UpdateIntakePayload from provider's model
+func toUpdatePayload(ctx context.Context, model *Model) (*intake.UpdateIntakePayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	labels, err := utils.LabelsToPayload(ctx, model.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := toCatalogPayload(ctx, model.Catalog)
+	if err != nil {
+		return nil, fmt.Errorf("converting catalog: %w", err)
+	}
+
+	return &intake.UpdateIntakePayload{
+		DisplayName: conversion.StringValueToPointer(model.Name),
+		Description: conversion.StringValueToPointer(model.Description),
+		Labels:      labels,
+		Catalog:     catalog,
+	}, nil
+}
+
+func toCatalogPayload(ctx context.Context, catalogObject types.Object) (*intake.IntakeCatalog, error) {
+	if utils.IsUndefined(catalogObject) {
+		return nil, fmt.Errorf("catalog is not set")
+	}
+	var catalog catalogModel
+	if diags := catalogObject.As(ctx, &catalog, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	payload := &intake.IntakeCatalog{
+		Uri:          catalog.Uri.ValueString(),
+		Warehouse:    catalog.Warehouse.ValueString(),
+		Namespace:    conversion.StringValueToPointer(catalog.Namespace),
+		TableName:    conversion.StringValueToPointer(catalog.TableName),
+		Partitioning: conversion.StringValueToPointer(catalog.Partitioning),
+	}
+
+	if !utils.IsUndefined(catalog.PartitionBy) {
+		partitionBy, err := utils.ListValueToStringSlice(catalog.PartitionBy)
+		if err != nil {
+			return nil, fmt.Errorf("converting partition_by: %w", err)
+		}
+		payload.PartitionBy = partitionBy
+	}
+
+	if utils.IsUndefined(catalog.Auth) {
+		return payload, nil
+	}
+	var auth authModel
+	if diags := catalog.Auth.As(ctx, &auth, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+	payload.Auth = &intake.CatalogAuth{
+		Type: auth.Type.ValueString(),
+	}
+	if !utils.IsUndefined(auth.Dremio) {
+		var dremio dremioModel
+		if diags := auth.Dremio.As(ctx, &dremio, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return nil, core.DiagsToError(diags)
+		}
+		payload.Auth.Dremio = &intake.DremioAuth{
+			TokenEndpoint:       dremio.TokenEndpoint.ValueString(),
+			PersonalAccessToken: dremio.PersonalAccessToken.ValueString(),
+		}
+	}
+	return payload, nil
+}
@@ -0,0 +1,331 @@
+package intake
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	intake "github.com/stackitcloud/stackit-sdk-go/services/intake/v1betaapi"
+)
+
+func fixtureCatalog(mods ...func(m map[string]attr.Value)) types.Object {
+	values := map[string]attr.Value{
+		"uri":          types.StringValue("https://dremio.example.com/iceberg"),
+		"warehouse":    types.StringValue("catalog"),
+		"namespace":    types.StringValue("intake"),
+		"table_name":   types.StringValue("events"),
+		"partitioning": types.StringValue("none"),
+		"partition_by": types.ListNull(types.StringType),
+		"auth":         types.ObjectNull(authTypes),
+	}
+	for _, mod := range mods {
+		mod(values)
+	}
+	return types.ObjectValueMust(catalogTypes, values)
+}
+
+func fixtureDremioAuth(personalAccessToken types.String) types.Object {
+	return types.ObjectValueMust(authTypes, map[string]attr.Value{
+		"type": types.StringValue("dremio"),
+		"dremio": types.ObjectValueMust(dremioTypes, map[string]attr.Value{
+			"token_endpoint":        types.StringValue("https://dremio.example.com/oauth/token"),
+			"personal_access_token": personalAccessToken,
+		}),
+	})
+}
+
+func TestMapFields(t *testing.T) {
+	intakeId := uuid.New().String()
+	runnerId := uuid.New().String()
+	now := time.Now()
+
+	tests := []struct {
+		description string
+		input       *intake.IntakeResponse
+		model       *Model
+		region      string
+		expected    *Model
+		wantErr     bool
+	}{
+		{
+			"success",
+			&intake.IntakeResponse{
+				Id:             intakeId,
+				DisplayName:    "name",
+				Description:    utils.Ptr("description"),
+				Labels:         map[string]string{"key": "value"},
+				IntakeRunnerId: runnerId,
+				Catalog: intake.IntakeCatalog{
+					Uri:          "https://dremio.example.com/iceberg",
+					Warehouse:    "catalog",
+					Namespace:    utils.Ptr("intake"),
+					TableName:    utils.Ptr("events"),
+					Partitioning: utils.Ptr("manual"),
+					PartitionBy:  []string{"day(__intake_ts)"},
+					Auth: &intake.CatalogAuth{
+						Type: "dremio",
+						Dremio: &intake.DremioAuth{
+							TokenEndpoint: "https://dremio.example.com/oauth/token",
+						},
+					},
+				},
+				Topic:      "intake-topic",
+				Uri:        "intake.eu01.onstackit.cloud:9094",
+				CreateTime: now,
+			},
+			&Model{
+				ProjectId: types.StringValue("pid"),
+				Catalog: fixtureCatalog(func(m map[string]attr.Value) {
+					m["auth"] = fixtureDremioAuth(types.StringValue("token"))
+				}),
+			},
+			"eu01",
+			&Model{
+				Id:          types.StringValue(fmt.Sprintf("pid,eu01,%s", intakeId)),
+				ProjectId:   types.StringValue("pid"),
+				Region:      types.StringValue("eu01"),
+				IntakeId:    types.StringValue(intakeId),
+				RunnerId:    types.StringValue(runnerId),
+				Name:        types.StringValue("name"),
+				Description: types.StringValue("description"),
+				Labels:      types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("value")}),
+				Catalog: fixtureCatalog(func(m map[string]attr.Value) {
+					m["partitioning"] = types.StringValue("manual")
+					m["partition_by"] = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("day(__intake_ts)")})
+					m["auth"] = fixtureDremioAuth(types.StringValue("token"))
+				}),
+				Topic:      types.StringValue("intake-topic"),
+				Uri:        types.StringValue("intake.eu01.onstackit.cloud:9094"),
+				CreateTime: types.StringValue(now.String()),
+			},
+			false,
+		},
+		{
+			"imported",
+			&intake.IntakeResponse{
+				Id:             intakeId,
+				IntakeRunnerId: runnerId,
+				Catalog: intake.IntakeCatalog{
+					Uri:       "https://dremio.example.com/iceberg",
+					Warehouse: "catalog",
+					Auth: &intake.CatalogAuth{
+						Type: "dremio",
+						Dremio: &intake.DremioAuth{
+							TokenEndpoint: "https://dremio.example.com/oauth/token",
+						},
+					},
+				},
+				CreateTime: now,
+			},
+			&Model{
+				ProjectId: types.StringValue("pid"),
+			},
+			"eu01",
+			&Model{
+				Id:          types.StringValue(fmt.Sprintf("pid,eu01,%s", intakeId)),
+				ProjectId:   types.StringValue("pid"),
+				Region:      types.StringValue("eu01"),
+				IntakeId:    types.StringValue(intakeId),
+				RunnerId:    types.StringValue(runnerId),
+				Name:        types.StringValue(""),
+				Description: types.StringNull(),
+				Labels:      types.MapNull(types.StringType),
+				Catalog: fixtureCatalog(func(m map[string]attr.Value) {
+					m["namespace"] = types.StringNull()
+					m["table_name"] = types.StringNull()
+					m["partitioning"] = types.StringNull()
+					m["auth"] = fixtureDremioAuth(types.StringNull())
+				}),
+				Topic:      types.StringValue(""),
+				Uri:        types.StringValue(""),
+				CreateTime: types.StringValue(now.String()),
+			},
+			false,
+		},
+		{
+			"nil input",
+			nil,
+			&Model{},
+			"eu01",
+			nil,
+			true,
+		},
+		{
+			"nil model",
+			&intake.IntakeResponse{},
+			nil,
+			"eu01",
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(context.Background(), tt.input, tt.model, tt.region)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("mapFields error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if diff := cmp.Diff(tt.expected, tt.model); diff != "" {
+					t.Errorf("mapFields mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		model       *Model
+		expected    *intake.CreateIntakePayload
+		wantErr     bool
+	}{
+		{
+			"success",
+			&Model{
+				Name:        types.StringValue("name"),
+				Description: types.StringValue("description"),
+				Labels:      types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("value")}),
+				RunnerId:    types.StringValue("rid"),
+				Catalog: fixtureCatalog(func(m map[string]attr.Value) {
+					m["partitioning"] = types.StringValue("manual")
+					m["partition_by"] = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("day(__intake_ts)")})
+					m["auth"] = fixtureDremioAuth(types.StringValue("token"))
+				}),
+			},
+			&intake.CreateIntakePayload{
+				DisplayName:    "name",
+				Description:    utils.Ptr("description"),
+				Labels:         map[string]string{"key": "value"},
+				IntakeRunnerId: "rid",
+				Catalog: intake.IntakeCatalog{
+					Uri:          "https://dremio.example.com/iceberg",
+					Warehouse:    "catalog",
+					Namespace:    utils.Ptr("intake"),
+					TableName:    utils.Ptr("events"),
+					Partitioning: utils.Ptr("manual"),
+					PartitionBy:  []string{"day(__intake_ts)"},
+					Auth: &intake.CatalogAuth{
+						Type: "dremio",
+						Dremio: &intake.DremioAuth{
+							TokenEndpoint:       "https://dremio.example.com/oauth/token",
+							PersonalAccessToken: "token",
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"computed catalog values",
+			&Model{
+				Name:     types.StringValue("name"),
+				RunnerId: types.StringValue("rid"),
+				Catalog: fixtureCatalog(func(m map[string]attr.Value) {
+					m["namespace"] = types.StringUnknown()
+					m["table_name"] = types.StringUnknown()
+					m["partitioning"] = types.StringUnknown()
+				}),
+			},
+			&intake.CreateIntakePayload{
+				DisplayName:    "name",
+				Labels:         map[string]string{},
+				IntakeRunnerId: "rid",
+				Catalog: intake.IntakeCatalog{
+					Uri:       "https://dremio.example.com/iceberg",
+					Warehouse: "catalog",
+				},
+			},
+			false,
+		},
+		{
+			"nil model",
+			nil,
+			nil,
+			true,
+		},
+		{
+			"no catalog",
+			&Model{
+				Name:    types.StringValue("name"),
+				Catalog: types.ObjectNull(catalogTypes),
+			},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			payload, err := toCreatePayload(context.Background(), tt.model)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toCreatePayload error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if diff := cmp.Diff(tt.expected, payload); diff != "" {
+					t.Errorf("toCreatePayload mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		model       *Model
+		expected    *intake.UpdateIntakePayload
+		wantErr     bool
+	}{
+		{
+			"success",
+			&Model{
+				Name:        types.StringValue("name"),
+				Description: types.StringValue("description"),
+				Labels:      types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("value")}),
+				Catalog:     fixtureCatalog(),
+			},
+			&intake.UpdateIntakePayload{
+				DisplayName: utils.Ptr("name"),
+				Description: utils.Ptr("description"),
+				Labels:      map[string]string{"key": "value"},
+				Catalog: &intake.IntakeCatalog{
+					Uri:          "https://dremio.example.com/iceberg",
+					Warehouse:    "catalog",
+					Namespace:    utils.Ptr("intake"),
+					TableName:    utils.Ptr("events"),
+					Partitioning: utils.Ptr("none"),
+				},
+			},
+			false,
+		},
+		{
+			"nil model",
+			nil,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			payload, err := toUpdatePayload(context.Background(), tt.model)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toUpdatePayload error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if diff := cmp.Diff(tt.expected, payload); diff != "" {
+					t.Errorf("toUpdatePayload mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	intakeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/intake/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+
+	intake "github.com/stackitcloud/stackit-sdk-go/services/intake/v1betaapi"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource = &userDataSource{}
+)
+
+// DataSourceModel is the internal model of the terraform data source
+type DataSourceModel struct {
+	Id          types.String `tfsdk:"id"` // needed by TF
+	ProjectId   types.String `tfsdk:"project_id"`
+	Region      types.String `tfsdk:"region"`
+	IntakeId    types.String `tfsdk:"intake_id"`
+	UserId      types.String `tfsdk:"user_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Labels      types.Map    `tfsdk:"labels"`
+	Type        types.String `tfsdk:"type"`
+	Username    types.String `tfsdk:"username"`
+	CreateTime  types.String `tfsdk:"create_time"`
+}
+
+// NewUserDataSource is a helper function to simplify the provider implementation
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+type userDataSource struct {
+	client       *intake.APIClient
+	providerData core.ProviderData
+}
+
+func (r *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_intake_user"
+}
+
+// Configure adds the provider configured client to the data source
+func (r *userDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := intakeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "Intake user client configured for data source")
+}
+
+// Schema defines the schema for the data source
+func (r *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":        "Datasource for STACKIT Intake users.",
+		"id":          "Terraform's internal resource identifier. It is structured as \"`project_id`,`region`,`intake_id`,`user_id`\".",
+		"project_id":  "STACKIT Project ID to which the intake user is associated.",
+		"region":      "The resource region. If not defined, the provider region is used.",
+		"intake_id":   "The ID of the intake the user belongs to.",
+		"user_id":     "The intake user ID.",
+		"name":        "The name of the intake user.",
+		"description": "The description of the intake user.",
+		"labels":      "User-defined labels.",
+		"type":        "The type of the intake user.",
+		"username":    "The username producers authenticate with.",
+		"create_time": "The creation time of the intake user.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["region"],
+			},
+			"intake_id": schema.StringAttribute{
+				Description: descriptions["intake_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: descriptions["user_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: descriptions["name"],
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: descriptions["description"],
+				Computed:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: descriptions["labels"],
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: descriptions["type"],
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: descriptions["username"],
+				Computed:    true,
+			},
+			"create_time": schema.StringAttribute{
+				Description: descriptions["create_time"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	intakeId := model.IntakeId.ValueString()
+	userId := model.UserId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "intake_id", intakeId)
+	ctx = tflog.SetField(ctx, "user_id", userId)
+
+	userResp, err := r.client.DefaultAPI.GetIntakeUser(ctx, projectId, region, intakeId, userId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading intake user", fmt.Sprintf("Intake user with ID %s not found for intake %s in project %s and region %s", userId, intakeId, projectId, region))
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading intake user", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapDataSourceFields(ctx, userResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading intake user", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Intake user read")
+}
+
+func mapDataSourceFields(ctx context.Context, userResp *intake.IntakeUserResponse, model *DataSourceModel, region string) error {
+	if userResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.IntakeId.ValueString(), userResp.Id)
+
+	labels, err := utils.MapLabels(ctx, &userResp.Labels, model.Labels)
+	if err != nil {
+		return err
+	}
+
+	model.UserId = types.StringValue(userResp.Id)
+	model.Name = types.StringValue(userResp.DisplayName)
+	model.Description = types.StringPointerValue(userResp.Description)
+	model.Labels = labels
+	model.Type = types.StringValue(userResp.Type)
+	model.Username = types.StringValue(userResp.User)
+	model.Region = types.StringValue(region)
+	model.CreateTime = types.StringValue(userResp.CreateTime.String())
+
+	return nil
+}
@@ -0,0 +1,590 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	intakeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/intake/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+
+	intake "github.com/stackitcloud/stackit-sdk-go/services/intake/v1betaapi"
+	"github.com/stackitcloud/stackit-sdk-go/services/intake/v1betaapi/wait"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userResource{}
+	_ resource.ResourceWithConfigure   = &userResource{}
+	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithModifyPlan  = &userResource{}
+)
+
+const (
+	userTypeIntake     = "intake"
+	userTypeDeadLetter = "dead-letter"
+)
+
+var userTypeValues = []string{userTypeIntake, userTypeDeadLetter}
+
+// Model is the internal model of the terraform resource
+type Model struct {
+	Id                types.String `tfsdk:"id"` // needed by TF
+	ProjectId         types.String `tfsdk:"project_id"`
+	Region            types.String `tfsdk:"region"`
+	IntakeId          types.String `tfsdk:"intake_id"`
+	UserId            types.String `tfsdk:"user_id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Labels            types.Map    `tfsdk:"labels"`
+	Type              types.String `tfsdk:"type"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+	Username          types.String `tfsdk:"username"`
+	CreateTime        types.String `tfsdk:"create_time"`
+}
+
+// NewUserResource is a helper function to simplify the provider implementation.
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+// userResource is the resource implementation.
+type userResource struct {
+	client       *intake.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_intake_user"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := intakeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "Intake user client configured")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":                "Manages STACKIT Intake users. Producers authenticate with an intake user against the runner to write messages to an intake.",
+		"id":                  "Terraform's internal resource identifier. It is structured as \"`project_id`,`region`,`intake_id`,`user_id`\".",
+		"project_id":          "STACKIT Project ID to which the intake user is associated.",
+		"region":              "The resource region. If not defined, the provider region is used.",
+		"intake_id":           "The ID of the intake the user belongs to.",
+		"user_id":             "The intake user ID.",
+		"name":                "The name of the intake user.",
+		"description":         "The description of the intake user.",
+		"labels":              "User-defined labels.",
+		"type":                fmt.Sprintf("The type of the intake user. Users of type `%s` write messages, users of type `%s` read undeliverable messages. %s", userTypeIntake, userTypeDeadLetter, utils.FormatPossibleValues(userTypeValues...)),
+		"password":            "The password of the intake user. Write-only argument `password_wo` should be preferred.",
+		"password_wo":         "The password of the intake user. Write-only - never stored in state and never returned by the API. To change the password, update this value AND increment `password_wo_version`. Changing this field alone will NOT trigger an update.",
+		"password_wo_version": "User-managed rotation counter for the password. Must be incremented every time `password_wo` is changed.",
+		"username":            "The username producers authenticate with.",
+		"create_time":         "The creation time of the intake user.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         descriptions["main"],
+		MarkdownDescription: descriptions["main"] + "\n\n~> Write-Only argument `password_wo` is available to use in place of `password`. Write-Only arguments are supported in HashiCorp Terraform 1.11.0 and later. [Learn more](https://developer.hashicorp.com/terraform/language/resources/ephemeral#write-only-arguments).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"intake_id": schema.StringAttribute{
+				Description: descriptions["intake_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: descriptions["user_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: descriptions["name"],
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: descriptions["description"],
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: descriptions["labels"],
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: descriptions["type"],
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(userTypeValues...),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: descriptions["password"],
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo_version")),
+					stringvalidator.PreferWriteOnlyAttribute(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Description: descriptions["password_wo"],
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: descriptions["password_wo_version"],
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+					int64validator.ConflictsWith(path.MatchRoot("password")),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: descriptions["username"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_time": schema.StringAttribute{
+				Description: descriptions["create_time"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model, configModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	intakeId := model.IntakeId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "intake_id", intakeId)
+
+	payload, err := toCreatePayload(ctx, &model, &configModel)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating intake user", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	userResp, err := r.client.DefaultAPI.CreateIntakeUser(ctx, projectId, region, intakeId).CreateIntakeUserPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating intake user", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": projectId,
+		"region":     region,
+		"intake_id":  intakeId,
+		"user_id":    userResp.Id,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userResp, err = wait.CreateIntakeUserWaitHandler(ctx, r.client.DefaultAPI, projectId, region, intakeId, userResp.GetId()).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating intake user", fmt.Sprintf("Intake user creation waiting: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, userResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating intake user", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Intake user created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	intakeId := model.IntakeId.ValueString()
+	userId := model.UserId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "intake_id", intakeId)
+	ctx = tflog.SetField(ctx, "user_id", userId)
+
+	userResp, err := r.client.DefaultAPI.GetIntakeUser(ctx, projectId, region, intakeId, userId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading intake user", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(ctx, userResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading intake user", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Intake user read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model, stateModel, configModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	intakeId := model.IntakeId.ValueString()
+	userId := model.UserId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "intake_id", intakeId)
+	ctx = tflog.SetField(ctx, "user_id", userId)
+
+	payload, err := toUpdatePayload(ctx, &model, &stateModel, &configModel)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating intake user", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	_, err = r.client.DefaultAPI.UpdateIntakeUser(ctx, projectId, region, intakeId, userId).UpdateIntakeUserPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating intake user", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	userResp, err := wait.UpdateIntakeUserWaitHandler(ctx, r.client.DefaultAPI, projectId, region, intakeId, userId).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating intake user", fmt.Sprintf("Intake user update waiting: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, userResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating intake user", fmt.Sprintf("Processing API response: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Intake user updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	intakeId := model.IntakeId.ValueString()
+	userId := model.UserId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "intake_id", intakeId)
+	ctx = tflog.SetField(ctx, "user_id", userId)
+
+	err := r.client.DefaultAPI.DeleteIntakeUser(ctx, projectId, region, intakeId, userId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Intake user already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting intake user", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	_, err = wait.DeleteIntakeUserWaitHandler(ctx, r.client.DefaultAPI, projectId, region, intakeId, userId).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting intake user", fmt.Sprintf("Intake user deletion waiting: %v", err))
+		return
+	}
+
+	tflog.Info(ctx, "Intake user deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the Intake user resource import identifier is: [project_id],[region],[intake_id],[user_id]
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing intake user",
+			fmt.Sprintf("Expected import identifier with format [project_id],[region],[intake_id],[user_id], got %q", req.ID),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": idParts[0],
+		"region":     idParts[1],
+		"intake_id":  idParts[2],
+		"user_id":    idParts[3],
+	})
+
+	tflog.Info(ctx, "Intake user state imported. The password is not imported, set password_wo and password_wo_version to manage it")
+}
+
+// Maps intake user fields to the provider internal model. The password is never returned by the API
+// and is left untouched.
+func mapFields(ctx context.Context, userResp *intake.IntakeUserResponse, model *Model, region string) error {
+	if userResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.IntakeId.ValueString(), userResp.Id)
+
+	labels, err := utils.MapLabels(ctx, &userResp.Labels, model.Labels)
+	if err != nil {
+		return err
+	}
+
+	model.UserId = types.StringValue(userResp.Id)
+	model.Name = types.StringValue(userResp.DisplayName)
+	model.Description = types.StringPointerValue(userResp.Description)
+	model.Labels = labels
+	model.Type = types.StringValue(userResp.Type)
+	model.Username = types.StringValue(userResp.User)
+	model.Region = types.StringValue(region)
+	model.CreateTime = types.StringValue(userResp.CreateTime.String())
+
+	return nil
+}
+
+// Build CreateIntakeUserPayload from provider's model. Write-only values are only present in the config model.
+func toCreatePayload(ctx context.Context, planModel, configModel *Model) (*intake.CreateIntakeUserPayload, error) {
+	if planModel == nil {
+		return nil, fmt.Errorf("nil plan model")
+	}
+	if configModel == nil {
+		return nil, fmt.Errorf("nil config model")
+	}
+
+	labels, err := utils.LabelsToPayload(ctx, planModel.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	var password string
+	if !utils.IsUndefined(planModel.Password) {
+		password = planModel.Password.ValueString()
+	} else if !utils.IsUndefined(configModel.PasswordWo) {
+		password = configModel.PasswordWo.ValueString()
+	} else {
+		return nil, fmt.Errorf("either password or password_wo must be set")
+	}
+
+	return &intake.CreateIntakeUserPayload{
+		DisplayName: planModel.Name.ValueString(),
+		Description: conversion.StringValueToPointer(planModel.Description),
+		Labels:      labels,
+		Type:        conversion.StringValueToPointer(planModel.Type),
+		Password:    password,
+	}, nil
+}
+
+// Build UpdateIntakeUserPayload from provider's model. The write-only password is only sent if
+// password_wo_version changed.
+func toUpdatePayload(ctx context.Context, planModel, stateModel, configModel *Model) (*intake.UpdateIntakeUserPayload, error) {
+	if planModel == nil {
+		return nil, fmt.Errorf("nil plan model")
+	}
+	if stateModel == nil {
+		return nil, fmt.Errorf("nil state model")
+	}
+	if configModel == nil {
+		return nil, fmt.Errorf("nil config model")
+	}
+
+	labels, err := utils.LabelsToPayload(ctx, planModel.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &intake.UpdateIntakeUserPayload{
+		DisplayName: conversion.StringValueToPointer(planModel.Name),
+		Description: conversion.StringValueToPointer(planModel.Description),
+		Labels:      labels,
+		Type:        conversion.StringValueToPointer(planModel.Type),
+	}
+
+	if !planModel.Password.IsNull() {
+		if !planModel.Password.Equal(stateModel.Password) {
+			payload.Password = conversion.StringValueToPointer(planModel.Password)
+		}
+	} else if !configModel.PasswordWo.IsNull() && !planModel.PasswordWoVersion.Equal(stateModel.PasswordWoVersion) {
+		payload.Password = conversion.StringValueToPointer(configModel.PasswordWo)
+	}
+
+	return payload, nil
+}
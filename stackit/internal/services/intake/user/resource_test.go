@@ -0,0 +1,311 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	intake "github.com/stackitcloud/stackit-sdk-go/services/intake/v1betaapi"
+)
+
+func TestMapFields(t *testing.T) {
+	userId := uuid.New().String()
+	now := time.Now()
+
+	tests := []struct {
+		description string
+		input       *intake.IntakeUserResponse
+		model       *Model
+		region      string
+		expected    *Model
+		wantErr     bool
+	}{
+		{
+			"success",
+			&intake.IntakeUserResponse{
+				Id:          userId,
+				DisplayName: "name",
+				Description: utils.Ptr("description"),
+				Labels:      map[string]string{"key": "value"},
+				Type:        "intake",
+				User:        "intake-user-1",
+				CreateTime:  now,
+			},
+			&Model{
+				ProjectId:         types.StringValue("pid"),
+				IntakeId:          types.StringValue("iid"),
+				PasswordWoVersion: types.Int64Value(1),
+			},
+			"eu01",
+			&Model{
+				Id:                types.StringValue(fmt.Sprintf("pid,eu01,iid,%s", userId)),
+				ProjectId:         types.StringValue("pid"),
+				Region:            types.StringValue("eu01"),
+				IntakeId:          types.StringValue("iid"),
+				UserId:            types.StringValue(userId),
+				Name:              types.StringValue("name"),
+				Description:       types.StringValue("description"),
+				Labels:            types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("value")}),
+				Type:              types.StringValue("intake"),
+				PasswordWoVersion: types.Int64Value(1),
+				Username:          types.StringValue("intake-user-1"),
+				CreateTime:        types.StringValue(now.String()),
+			},
+			false,
+		},
+		{
+			"password is kept",
+			&intake.IntakeUserResponse{
+				Id:   userId,
+				Type: "dead-letter",
+			},
+			&Model{
+				ProjectId: types.StringValue("pid"),
+				IntakeId:  types.StringValue("iid"),
+				Password:  types.StringValue("password"),
+			},
+			"eu01",
+			&Model{
+				Id:          types.StringValue(fmt.Sprintf("pid,eu01,iid,%s", userId)),
+				ProjectId:   types.StringValue("pid"),
+				Region:      types.StringValue("eu01"),
+				IntakeId:    types.StringValue("iid"),
+				UserId:      types.StringValue(userId),
+				Name:        types.StringValue(""),
+				Description: types.StringNull(),
+				Labels:      types.MapNull(types.StringType),
+				Type:        types.StringValue("dead-letter"),
+				Password:    types.StringValue("password"),
+				Username:    types.StringValue(""),
+				CreateTime:  types.StringValue(time.Time{}.String()),
+			},
+			false,
+		},
+		{
+			"nil input",
+			nil,
+			&Model{},
+			"eu01",
+			nil,
+			true,
+		},
+		{
+			"nil model",
+			&intake.IntakeUserResponse{},
+			nil,
+			"eu01",
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(context.Background(), tt.input, tt.model, tt.region)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("mapFields error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if diff := cmp.Diff(tt.expected, tt.model); diff != "" {
+					t.Errorf("mapFields mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		plan        *Model
+		config      *Model
+		expected    *intake.CreateIntakeUserPayload
+		wantErr     bool
+	}{
+		{
+			"password",
+			&Model{
+				Name:        types.StringValue("name"),
+				Description: types.StringValue("description"),
+				Labels:      types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("value")}),
+				Type:        types.StringValue("intake"),
+				Password:    types.StringValue("password"),
+			},
+			&Model{},
+			&intake.CreateIntakeUserPayload{
+				DisplayName: "name",
+				Description: utils.Ptr("description"),
+				Labels:      map[string]string{"key": "value"},
+				Type:        utils.Ptr("intake"),
+				Password:    "password",
+			},
+			false,
+		},
+		{
+			"write-only password",
+			&Model{
+				Name:              types.StringValue("name"),
+				Type:              types.StringUnknown(),
+				PasswordWoVersion: types.Int64Value(1),
+			},
+			&Model{
+				PasswordWo: types.StringValue("password-wo"),
+			},
+			&intake.CreateIntakeUserPayload{
+				DisplayName: "name",
+				Labels:      map[string]string{},
+				Password:    "password-wo",
+			},
+			false,
+		},
+		{
+			"no password",
+			&Model{
+				Name: types.StringValue("name"),
+			},
+			&Model{},
+			nil,
+			true,
+		},
+		{
+			"nil plan model",
+			nil,
+			&Model{},
+			nil,
+			true,
+		},
+		{
+			"nil config model",
+			&Model{},
+			nil,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			payload, err := toCreatePayload(context.Background(), tt.plan, tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toCreatePayload error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if diff := cmp.Diff(tt.expected, payload); diff != "" {
+					t.Errorf("toCreatePayload mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		plan        *Model
+		state       *Model
+		config      *Model
+		expected    *intake.UpdateIntakeUserPayload
+		wantErr     bool
+	}{
+		{
+			"password unchanged",
+			&Model{
+				Name:     types.StringValue("name"),
+				Type:     types.StringValue("intake"),
+				Password: types.StringValue("password"),
+			},
+			&Model{
+				Password: types.StringValue("password"),
+			},
+			&Model{},
+			&intake.UpdateIntakeUserPayload{
+				DisplayName: utils.Ptr("name"),
+				Labels:      map[string]string{},
+				Type:        utils.Ptr("intake"),
+			},
+			false,
+		},
+		{
+			"password changed",
+			&Model{
+				Name:     types.StringValue("name"),
+				Password: types.StringValue("new-password"),
+			},
+			&Model{
+				Password: types.StringValue("password"),
+			},
+			&Model{},
+			&intake.UpdateIntakeUserPayload{
+				DisplayName: utils.Ptr("name"),
+				Labels:      map[string]string{},
+				Password:    utils.Ptr("new-password"),
+			},
+			false,
+		},
+		{
+			"write-only version unchanged",
+			&Model{
+				Name:              types.StringValue("name"),
+				PasswordWoVersion: types.Int64Value(1),
+			},
+			&Model{
+				PasswordWoVersion: types.Int64Value(1),
+			},
+			&Model{
+				PasswordWo: types.StringValue("password-wo"),
+			},
+			&intake.UpdateIntakeUserPayload{
+				DisplayName: utils.Ptr("name"),
+				Labels:      map[string]string{},
+			},
+			false,
+		},
+		{
+			"write-only version bumped",
+			&Model{
+				Name:              types.StringValue("name"),
+				PasswordWoVersion: types.Int64Value(2),
+			},
+			&Model{
+				PasswordWoVersion: types.Int64Value(1),
+			},
+			&Model{
+				PasswordWo: types.StringValue("password-wo"),
+			},
+			&intake.UpdateIntakeUserPayload{
+				DisplayName: utils.Ptr("name"),
+				Labels:      map[string]string{},
+				Password:    utils.Ptr("password-wo"),
+			},
+			false,
+		},
+		{
+			"nil state model",
+			&Model{},
+			nil,
+			&Model{},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			payload, err := toUpdatePayload(context.Background(), tt.plan, tt.state, tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toUpdatePayload error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if diff := cmp.Diff(tt.expected, payload); diff != "" {
+					t.Errorf("toUpdatePayload mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	kmsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &decryptEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &decryptEphemeralResource{}
+)
+
+// NewDecryptEphemeralResource is a helper function to simplify the provider implementation.
+func NewDecryptEphemeralResource() ephemeral.EphemeralResource {
+	return &decryptEphemeralResource{}
+}
+
+// decryptEphemeralResource is the ephemeral resource implementation.
+type decryptEphemeralResource struct {
+	client       *kms.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (e *decryptEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_decrypt"
+}
+
+// Configure adds the provider configured client to the resource.
+func (e *decryptEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	ephemeralProviderData, ok := conversion.ParseEphemeralProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	e.providerData = ephemeralProviderData.ProviderData
+
+	e.client = kmsUtils.ConfigureClient(ctx, &e.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "KMS client configured")
+}
+
+// ephemeralModel is the model for the ephemeral resource.
+type ephemeralModel struct {
+	ProjectId       types.String `tfsdk:"project_id"`
+	Region          types.String `tfsdk:"region"`
+	KeyRingId       types.String `tfsdk:"keyring_id"`
+	KeyId           types.String `tfsdk:"key_id"`
+	VersionNumber   types.Int64  `tfsdk:"version_number"`
+	Ciphertext      types.String `tfsdk:"ciphertext"`
+	Plaintext       types.String `tfsdk:"plaintext"`
+	PlaintextBase64 types.String `tfsdk:"plaintext_base64"`
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *decryptEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Ephemeral resource that decrypts data with a KMS key. Works with keys of purpose `%s` and `%s`. The plaintext is only available during the Terraform operation and is never stored in the state. %s", kms.PURPOSE_SYMMETRIC_ENCRYPT_DECRYPT, kms.PURPOSE_ASYMMETRIC_ENCRYPT_DECRYPT, core.ResourceRegionFallbackDocstring),
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the key is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: "The resource region. If not defined, the provider region is used.",
+			},
+			"keyring_id": schema.StringAttribute{
+				Description: "The ID of the associated keyring",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Description: "The ID of the key",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"version_number": schema.Int64Attribute{
+				Description: "The number of the key version the data was encrypted with. The ciphertext can only be decrypted with this version, so keep it together with the ciphertext, as newer versions are created by rotations.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ciphertext": schema.StringAttribute{
+				Description: "The encrypted data as returned by the KMS, encoded in base64.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"plaintext": schema.StringAttribute{
+				Description: "The decrypted data as string. Only set if the decrypted data is valid UTF-8, use `plaintext_base64` for binary data.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"plaintext_base64": schema.StringAttribute{
+				Description: "The decrypted data, encoded in base64.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Open decrypts the ciphertext and sets the result.
+func (e *decryptEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ephemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := e.providerData.GetRegionWithOverride(model.Region)
+	keyRingId := model.KeyRingId.ValueString()
+	keyId := model.KeyId.ValueString()
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "keyring_id", keyRingId)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+
+	versionNumber := model.VersionNumber.ValueInt64()
+	ctx = tflog.SetField(ctx, "version_number", versionNumber)
+
+	decrypted, err := decrypt(ctx, e.client.DefaultAPI, projectId, region, keyRingId, keyId, versionNumber, model.Ciphertext.ValueString())
+
+	ctx = core.LogResponse(ctx)
+
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error decrypting data", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	model.Region = types.StringValue(region)
+	err = mapPlaintext(decrypted, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error decrypting data", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+	tflog.Info(ctx, "KMS data decrypted")
+}
+
+// decrypt initializes the API call to decrypt the ciphertext
+func decrypt(ctx context.Context, client kms.DefaultAPI, projectId, region, keyRingId, keyId string, versionNumber int64, ciphertext string) (*kms.DecryptedData, error) {
+	payload := kms.DecryptPayload{
+		Data: ciphertext,
+	}
+	return client.Decrypt(ctx, projectId, region, keyRingId, keyId, versionNumber).DecryptPayload(payload).Execute()
+}
+
+func mapPlaintext(decrypted *kms.DecryptedData, model *ephemeralModel) error {
+	if decrypted == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(decrypted.Data)
+	if err != nil {
+		return fmt.Errorf("decoding plaintext: %w", err)
+	}
+
+	model.PlaintextBase64 = types.StringValue(decrypted.Data)
+	if utf8.Valid(plaintext) {
+		model.Plaintext = types.StringValue(string(plaintext))
+	} else {
+		model.Plaintext = types.StringNull()
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+)
+
+func TestDecrypt(t *testing.T) {
+	const (
+		projectId  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+		keyRingId  = "keyring"
+		keyId      = "key"
+		region     = "eu01"
+		ciphertext = "Y2lwaGVydGV4dA=="
+	)
+
+	tests := []struct {
+		description  string
+		mockResponse *kms.DecryptedData
+		mockError    error
+		expectError  bool
+	}{
+		{
+			description:  "success",
+			mockResponse: &kms.DecryptedData{Data: "cGxhaW50ZXh0"},
+		},
+		{
+			description: "api error",
+			mockError:   fmt.Errorf("api error"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			decryptFn := func(_ kms.ApiDecryptRequest) (*kms.DecryptedData, error) {
+				return tt.mockResponse, tt.mockError
+			}
+			client := &kms.DefaultAPIServiceMock{
+				DecryptExecuteMock: &decryptFn,
+			}
+
+			resp, err := decrypt(context.Background(), client, projectId, region, keyRingId, keyId, 1, ciphertext)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("decrypt() error = %v, expectError %v", err, tt.expectError)
+			}
+
+			if !tt.expectError {
+				if diff := cmp.Diff(resp, tt.mockResponse); diff != "" {
+					t.Errorf("Response mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMapPlaintext(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *kms.DecryptedData
+		expected    ephemeralModel
+		isValid     bool
+	}{
+		{
+			description: "text",
+			input:       &kms.DecryptedData{Data: "cGxhaW50ZXh0"},
+			expected: ephemeralModel{
+				Plaintext:       types.StringValue("plaintext"),
+				PlaintextBase64: types.StringValue("cGxhaW50ZXh0"),
+			},
+			isValid: true,
+		},
+		{
+			description: "binary",
+			input:       &kms.DecryptedData{Data: "/w=="},
+			expected: ephemeralModel{
+				Plaintext:       types.StringNull(),
+				PlaintextBase64: types.StringValue("/w=="),
+			},
+			isValid: true,
+		},
+		{
+			description: "invalid base64",
+			input:       &kms.DecryptedData{Data: "not base64!"},
+			isValid:     false,
+		},
+		{
+			description: "nil response",
+			input:       nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &ephemeralModel{}
+			err := mapPlaintext(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
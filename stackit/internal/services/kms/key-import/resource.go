@@ -0,0 +1,463 @@
+package kms
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+	"github.com/stackitcloud/stackit-sdk-go/services/kms/v1api/wait"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	kmsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+const (
+	deletionWarning = "Imported key versions will **not** be instantly destroyed by terraform during a `terraform destroy`. They will just be scheduled for destruction via the API and thrown out of the Terraform state afterwards. **This way we can ensure no key material is lost by accident and it gives you the option to restore the version within the grace period.**"
+)
+
+var (
+	_ resource.Resource               = &keyImportResource{}
+	_ resource.ResourceWithConfigure  = &keyImportResource{}
+	_ resource.ResourceWithModifyPlan = &keyImportResource{}
+)
+
+type Model struct {
+	Id                          types.String `tfsdk:"id"` // needed by TF
+	ProjectId                   types.String `tfsdk:"project_id"`
+	Region                      types.String `tfsdk:"region"`
+	KeyRingId                   types.String `tfsdk:"keyring_id"`
+	KeyId                       types.String `tfsdk:"key_id"`
+	WrappingKeyId               types.String `tfsdk:"wrapping_key_id"`
+	KeyMaterialWriteOnly        types.String `tfsdk:"key_material_base64_wo"`
+	KeyMaterialWriteOnlyVersion types.Int64  `tfsdk:"key_material_base64_wo_version"`
+	VersionNumber               types.Int64  `tfsdk:"version_number"`
+	State                       types.String `tfsdk:"state"`
+	CreatedAt                   types.String `tfsdk:"created_at"`
+	PublicKey                   types.String `tfsdk:"public_key"`
+}
+
+func NewKeyImportResource() resource.Resource {
+	return &keyImportResource{}
+}
+
+type keyImportResource struct {
+	client       *kms.APIClient
+	providerData core.ProviderData
+}
+
+func (r *keyImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_key_import"
+}
+
+func (r *keyImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = kmsUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "KMS client configured")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *keyImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *keyImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := fmt.Sprintf("KMS Key import resource schema. Imports own key material as a new version of a key with `import_only` enabled. The key material is wrapped locally with the public key of the given wrapping key, so it never leaves Terraform in plaintext and is never stored in the state. %s", core.ResourceRegionFallbackDocstring)
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: fmt.Sprintf("%s\n\n-> **Note:** `key_material_base64_wo` is a write-only argument, which is supported in HashiCorp Terraform 1.11.0 and later. [Learn more](https://developer.hashicorp.com/terraform/language/resources/ephemeral#write-only-arguments).\n\n ~> %s", description, deletionWarning),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`keyring_id`,`key_id`,`version_number`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the key is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: "The resource region. If not defined, the provider region is used.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keyring_id": schema.StringAttribute{
+				Description: "The ID of the associated keyring",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Description: "The ID of the key the key material is imported into. The key must have `import_only` enabled.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"wrapping_key_id": schema.StringAttribute{
+				Description: "The ID of the wrapping key which is used to wrap the key material. The wrapping key must be in the same keyring as the key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"key_material_base64_wo": schema.StringAttribute{
+				Description: "The plaintext key material, encoded in base64. Symmetric keys are expected as raw bytes, asymmetric keys as DER encoded PKCS #8 private keys. Write-only - never stored in state. To import new key material, update this value AND increment `key_material_base64_wo_version`.",
+				Required:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+			},
+			"key_material_base64_wo_version": schema.Int64Attribute{
+				Description: "Used together with `key_material_base64_wo` to trigger a re-import. Increment this value when an update to `key_material_base64_wo` is required.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"version_number": schema.Int64Attribute{
+				Description: "The number of the key version created by the import.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "The current state of the key version.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The date and time the key version was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The public key of the key version. Only set for asymmetric keys.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *keyImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available in the config, the plan just contains null values for them.
+	var configModel Model
+	diags = req.Config.Get(ctx, &configModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	keyRingId := model.KeyRingId.ValueString()
+	keyId := model.KeyId.ValueString()
+	wrappingKeyId := model.WrappingKeyId.ValueString()
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "keyring_id", keyRingId)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+	ctx = tflog.SetField(ctx, "wrapping_key_id", wrappingKeyId)
+
+	key, err := r.client.DefaultAPI.GetKey(ctx, projectId, region, keyRingId, keyId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing key material", fmt.Sprintf("Calling API to get key: %v", err))
+		return
+	}
+	wrappingKey, err := r.client.DefaultAPI.GetWrappingKey(ctx, projectId, region, keyRingId, wrappingKeyId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing key material", fmt.Sprintf("Calling API to get wrapping key: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	payload, err := toImportPayload(key, wrappingKey, configModel.KeyMaterialWriteOnly.ValueString(), time.Now())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing key material", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	importResponse, err := r.client.DefaultAPI.ImportKey(ctx, projectId, region, keyRingId, keyId).ImportKeyPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing key material", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	if importResponse == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing key material", "API returned empty response")
+		return
+	}
+
+	// Write id attributes to state before polling via the wait handler - just in case anything goes wrong during the wait handler
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id":     projectId,
+		"region":         region,
+		"keyring_id":     keyRingId,
+		"key_id":         keyId,
+		"version_number": importResponse.Number,
+	})
+
+	version, err := wait.EnableKeyVersionWaitHandler(ctx, r.client.DefaultAPI, projectId, region, keyRingId, keyId, importResponse.Number).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error waiting for key material import", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(version, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing key material", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Key material imported")
+}
+
+func (r *keyImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	keyRingId := model.KeyRingId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	keyId := model.KeyId.ValueString()
+	versionNumber := model.VersionNumber.ValueInt64()
+
+	ctx = tflog.SetField(ctx, "keyring_id", keyRingId)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+	ctx = tflog.SetField(ctx, "version_number", versionNumber)
+
+	versionResponse, err := r.client.DefaultAPI.GetVersion(ctx, projectId, region, keyRingId, keyId, versionNumber).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading imported key version", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Versions which are scheduled for destruction are treated as deleted
+	if versionResponse.State == kms.VERSIONSTATE_DESTROYED || versionResponse.DestroyDate != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapFields(versionResponse, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading imported key version", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Imported key version read")
+}
+
+func (r *keyImportResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// imported key versions cannot be updated, so we log an error.
+	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating imported key version", "Imported key versions can't be updated")
+}
+
+func (r *keyImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	keyRingId := model.KeyRingId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	keyId := model.KeyId.ValueString()
+	versionNumber := model.VersionNumber.ValueInt64()
+
+	err := r.client.DefaultAPI.DestroyVersion(ctx, projectId, region, keyRingId, keyId, versionNumber).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting imported key version", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// The key versions can't be destroyed instantly by Terraform, they can only be scheduled for destruction via the API.
+	core.LogAndAddWarning(ctx, &resp.Diagnostics, "Imported key version scheduled for destruction on API side", deletionWarning)
+
+	tflog.Info(ctx, "Imported key version deleted")
+}
+
+func mapFields(version *kms.Version, model *Model, region string) error {
+	if version == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.KeyRingId.ValueString(), model.KeyId.ValueString(), strconv.FormatInt(version.Number, 10))
+	model.Region = types.StringValue(region)
+	model.VersionNumber = types.Int64Value(version.Number)
+	model.State = types.StringValue(string(version.State))
+	model.CreatedAt = types.StringValue(version.CreatedAt.Format(time.RFC3339))
+	model.PublicKey = types.StringPointerValue(version.PublicKey)
+
+	return nil
+}
+
+func toImportPayload(key *kms.Key, wrappingKey *kms.WrappingKey, keyMaterialBase64 string, now time.Time) (*kms.ImportKeyPayload, error) {
+	if key == nil {
+		return nil, fmt.Errorf("nil key")
+	}
+	if wrappingKey == nil {
+		return nil, fmt.Errorf("nil wrapping key")
+	}
+	if !key.ImportOnly {
+		return nil, fmt.Errorf("key %q is not import only", key.Id)
+	}
+	if wrappingKey.State != kms.WRAPPINGKEYSTATE_ACTIVE {
+		return nil, fmt.Errorf("wrapping key %q is in state %q, expected %q", wrappingKey.Id, wrappingKey.State, kms.WRAPPINGKEYSTATE_ACTIVE)
+	}
+	if !now.Before(wrappingKey.ExpiresAt) {
+		return nil, fmt.Errorf("wrapping key %q expired at %s", wrappingKey.Id, wrappingKey.ExpiresAt.Format(time.RFC3339))
+	}
+	if wrappingKey.PublicKey == nil {
+		return nil, fmt.Errorf("wrapping key %q has no public key", wrappingKey.Id)
+	}
+
+	keyMaterial, err := base64.StdEncoding.DecodeString(keyMaterialBase64)
+	if err != nil {
+		return nil, fmt.Errorf("decoding key material: %w", err)
+	}
+	err = validateKeyMaterial(key.Algorithm, keyMaterial)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := wrapKeyMaterial(*wrappingKey.PublicKey, wrappingKey.Algorithm, keyMaterial, rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("wrapping key material: %w", err)
+	}
+
+	return &kms.ImportKeyPayload{
+		WrappedKey:    base64.StdEncoding.EncodeToString(wrappedKey),
+		WrappingKeyId: wrappingKey.Id,
+	}, nil
+}
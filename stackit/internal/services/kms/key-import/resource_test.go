@@ -0,0 +1,214 @@
+package kms
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+)
+
+var (
+	keyId         = uuid.NewString()
+	keyRingId     = uuid.NewString()
+	projectId     = uuid.NewString()
+	wrappingKeyId = uuid.NewString()
+)
+
+func TestMapFields(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		input       *kms.Version
+		expected    Model
+		isValid     bool
+	}{
+		{
+			description: "values_ok",
+			input: &kms.Version{
+				Number:    3,
+				CreatedAt: createdAt,
+				PublicKey: new("public-key"),
+				State:     kms.VERSIONSTATE_ACTIVE,
+			},
+			expected: Model{
+				Id:            types.StringValue(fmt.Sprintf("%s,eu01,%s,%s,3", projectId, keyRingId, keyId)),
+				ProjectId:     types.StringValue(projectId),
+				Region:        types.StringValue("eu01"),
+				KeyRingId:     types.StringValue(keyRingId),
+				KeyId:         types.StringValue(keyId),
+				WrappingKeyId: types.StringValue(wrappingKeyId),
+				VersionNumber: types.Int64Value(3),
+				State:         types.StringValue(string(kms.VERSIONSTATE_ACTIVE)),
+				CreatedAt:     types.StringValue("2025-01-01T12:00:00Z"),
+				PublicKey:     types.StringValue("public-key"),
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				ProjectId:     types.StringValue(projectId),
+				KeyRingId:     types.StringValue(keyRingId),
+				KeyId:         types.StringValue(keyId),
+				WrappingKeyId: types.StringValue(wrappingKeyId),
+			}
+			err := mapFields(tt.input, model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToImportPayload(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Generating RSA key: %v", err)
+	}
+	publicKey := encodePublicKeyPEM(t, &privateKey.PublicKey)
+	keyMaterial := make([]byte, 32)
+	keyMaterialBase64 := base64.StdEncoding.EncodeToString(keyMaterial)
+
+	key := func(mod func(*kms.Key)) *kms.Key {
+		k := &kms.Key{Id: keyId, Algorithm: kms.ALGORITHM_AES_256_GCM, ImportOnly: true}
+		if mod != nil {
+			mod(k)
+		}
+		return k
+	}
+	wrappingKey := func(mod func(*kms.WrappingKey)) *kms.WrappingKey {
+		w := &kms.WrappingKey{
+			Id:        wrappingKeyId,
+			Algorithm: kms.WRAPPINGALGORITHM_RSA_2048_OAEP_SHA256,
+			ExpiresAt: now.Add(time.Hour),
+			PublicKey: new(publicKey),
+			State:     kms.WRAPPINGKEYSTATE_ACTIVE,
+		}
+		if mod != nil {
+			mod(w)
+		}
+		return w
+	}
+
+	tests := []struct {
+		description string
+		key         *kms.Key
+		wrappingKey *kms.WrappingKey
+		keyMaterial string
+		isValid     bool
+	}{
+		{
+			description: "ok",
+			key:         key(nil),
+			wrappingKey: wrappingKey(nil),
+			keyMaterial: keyMaterialBase64,
+			isValid:     true,
+		},
+		{
+			description: "nil key",
+			wrappingKey: wrappingKey(nil),
+			keyMaterial: keyMaterialBase64,
+			isValid:     false,
+		},
+		{
+			description: "nil wrapping key",
+			key:         key(nil),
+			keyMaterial: keyMaterialBase64,
+			isValid:     false,
+		},
+		{
+			description: "key not import only",
+			key:         key(func(k *kms.Key) { k.ImportOnly = false }),
+			wrappingKey: wrappingKey(nil),
+			keyMaterial: keyMaterialBase64,
+			isValid:     false,
+		},
+		{
+			description: "wrapping key not active",
+			key:         key(nil),
+			wrappingKey: wrappingKey(func(w *kms.WrappingKey) { w.State = kms.WRAPPINGKEYSTATE_CREATING }),
+			keyMaterial: keyMaterialBase64,
+			isValid:     false,
+		},
+		{
+			description: "wrapping key expired",
+			key:         key(nil),
+			wrappingKey: wrappingKey(func(w *kms.WrappingKey) { w.ExpiresAt = now }),
+			keyMaterial: keyMaterialBase64,
+			isValid:     false,
+		},
+		{
+			description: "wrapping key without public key",
+			key:         key(nil),
+			wrappingKey: wrappingKey(func(w *kms.WrappingKey) { w.PublicKey = nil }),
+			keyMaterial: keyMaterialBase64,
+			isValid:     false,
+		},
+		{
+			description: "key material not base64",
+			key:         key(nil),
+			wrappingKey: wrappingKey(nil),
+			keyMaterial: "not base64!",
+			isValid:     false,
+		},
+		{
+			description: "key material does not match algorithm",
+			key:         key(nil),
+			wrappingKey: wrappingKey(nil),
+			keyMaterial: base64.StdEncoding.EncodeToString(make([]byte, 16)),
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toImportPayload(tt.key, tt.wrappingKey, tt.keyMaterial, now)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if output.WrappingKeyId != wrappingKeyId {
+					t.Fatalf("Wrapping key ID does not match: %q", output.WrappingKeyId)
+				}
+				wrapped, err := base64.StdEncoding.DecodeString(output.WrappedKey)
+				if err != nil {
+					t.Fatalf("Wrapped key is not base64 encoded: %v", err)
+				}
+				unwrapped, err := rsa.DecryptOAEP(sha256.New(), nil, privateKey, wrapped, nil)
+				if err != nil {
+					t.Fatalf("Decrypting wrapped key: %v", err)
+				}
+				diff := cmp.Diff(unwrapped, keyMaterial)
+				if diff != "" {
+					t.Fatalf("Key material does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
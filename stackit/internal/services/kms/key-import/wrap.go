@@ -0,0 +1,206 @@
+package kms
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // registers crypto.SHA256
+	_ "crypto/sha512" // registers crypto.SHA512
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"strings"
+
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+)
+
+// aes256KeySize is the size of the ephemeral AES key used by the "*_aes_256_key_wrap" wrapping algorithms.
+const aes256KeySize = 32
+
+// wrappingScheme describes how key material is wrapped for a wrapping algorithm.
+type wrappingScheme struct {
+	// rsaKeyBits is the expected size of the RSA wrapping key.
+	rsaKeyBits int
+	// hash is used for RSA-OAEP and its MGF1 function.
+	hash crypto.Hash
+	// aesKeyWrap states whether the key material is wrapped with an ephemeral AES key (RFC 5649),
+	// which in turn is wrapped with RSA-OAEP. Otherwise the key material is wrapped with RSA-OAEP directly.
+	aesKeyWrap bool
+}
+
+var wrappingSchemes = map[kms.WrappingAlgorithm]wrappingScheme{
+	kms.WRAPPINGALGORITHM_RSA_2048_OAEP_SHA256:                  {rsaKeyBits: 2048, hash: crypto.SHA256},
+	kms.WRAPPINGALGORITHM_RSA_3072_OAEP_SHA256:                  {rsaKeyBits: 3072, hash: crypto.SHA256},
+	kms.WRAPPINGALGORITHM_RSA_4096_OAEP_SHA256:                  {rsaKeyBits: 4096, hash: crypto.SHA256},
+	kms.WRAPPINGALGORITHM_RSA_4096_OAEP_SHA512:                  {rsaKeyBits: 4096, hash: crypto.SHA512},
+	kms.WRAPPINGALGORITHM_RSA_2048_OAEP_SHA256_AES_256_KEY_WRAP: {rsaKeyBits: 2048, hash: crypto.SHA256, aesKeyWrap: true},
+	kms.WRAPPINGALGORITHM_RSA_3072_OAEP_SHA256_AES_256_KEY_WRAP: {rsaKeyBits: 3072, hash: crypto.SHA256, aesKeyWrap: true},
+	kms.WRAPPINGALGORITHM_RSA_4096_OAEP_SHA256_AES_256_KEY_WRAP: {rsaKeyBits: 4096, hash: crypto.SHA256, aesKeyWrap: true},
+	kms.WRAPPINGALGORITHM_RSA_4096_OAEP_SHA512_AES_256_KEY_WRAP: {rsaKeyBits: 4096, hash: crypto.SHA512, aesKeyWrap: true},
+}
+
+// validateKeyMaterial checks whether the key material matches the algorithm of the key it is imported into.
+// Symmetric keys are expected as raw bytes, asymmetric keys as DER encoded PKCS #8 private keys.
+func validateKeyMaterial(algorithm kms.Algorithm, keyMaterial []byte) error {
+	switch algorithm {
+	case kms.ALGORITHM_AES_256_GCM:
+		if len(keyMaterial) != aes256KeySize {
+			return fmt.Errorf("algorithm %q requires %d bytes of key material, got %d", algorithm, aes256KeySize, len(keyMaterial))
+		}
+	case kms.ALGORITHM_HMAC_SHA256, kms.ALGORITHM_HMAC_SHA384, kms.ALGORITHM_HMAC_SHA512:
+		minSize := map[kms.Algorithm]int{
+			kms.ALGORITHM_HMAC_SHA256: crypto.SHA256.Size(),
+			kms.ALGORITHM_HMAC_SHA384: crypto.SHA384.Size(),
+			kms.ALGORITHM_HMAC_SHA512: crypto.SHA512.Size(),
+		}[algorithm]
+		if len(keyMaterial) < minSize {
+			return fmt.Errorf("algorithm %q requires at least %d bytes of key material, got %d", algorithm, minSize, len(keyMaterial))
+		}
+	case kms.ALGORITHM_RSA_2048_OAEP_SHA256, kms.ALGORITHM_RSA_3072_OAEP_SHA256, kms.ALGORITHM_RSA_4096_OAEP_SHA256, kms.ALGORITHM_RSA_4096_OAEP_SHA512:
+		bits := map[kms.Algorithm]int{
+			kms.ALGORITHM_RSA_2048_OAEP_SHA256: 2048,
+			kms.ALGORITHM_RSA_3072_OAEP_SHA256: 3072,
+			kms.ALGORITHM_RSA_4096_OAEP_SHA256: 4096,
+			kms.ALGORITHM_RSA_4096_OAEP_SHA512: 4096,
+		}[algorithm]
+		key, err := x509.ParsePKCS8PrivateKey(keyMaterial)
+		if err != nil {
+			return fmt.Errorf("algorithm %q requires a DER encoded PKCS #8 private key: %w", algorithm, err)
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok || rsaKey.N.BitLen() != bits {
+			return fmt.Errorf("algorithm %q requires a %d bit RSA private key", algorithm, bits)
+		}
+	case kms.ALGORITHM_ECDSA_P256_SHA256, kms.ALGORITHM_ECDSA_P384_SHA384, kms.ALGORITHM_ECDSA_P521_SHA512:
+		curve := map[kms.Algorithm]elliptic.Curve{
+			kms.ALGORITHM_ECDSA_P256_SHA256: elliptic.P256(),
+			kms.ALGORITHM_ECDSA_P384_SHA384: elliptic.P384(),
+			kms.ALGORITHM_ECDSA_P521_SHA512: elliptic.P521(),
+		}[algorithm]
+		key, err := x509.ParsePKCS8PrivateKey(keyMaterial)
+		if err != nil {
+			return fmt.Errorf("algorithm %q requires a DER encoded PKCS #8 private key: %w", algorithm, err)
+		}
+		ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+		if !ok || ecdsaKey.Curve != curve {
+			return fmt.Errorf("algorithm %q requires an ECDSA private key on curve %s", algorithm, curve.Params().Name)
+		}
+	default:
+		return fmt.Errorf("unsupported key algorithm %q", algorithm)
+	}
+	return nil
+}
+
+// wrapKeyMaterial wraps the key material with the public key of a wrapping key, so it can be imported into the KMS.
+// For the "*_aes_256_key_wrap" algorithms the result is the RSA-OAEP wrapped ephemeral AES key followed by the
+// AES wrapped key material. The random source is used for the ephemeral AES key and the RSA-OAEP padding.
+func wrapKeyMaterial(publicKey string, algorithm kms.WrappingAlgorithm, keyMaterial []byte, random io.Reader) ([]byte, error) {
+	if len(keyMaterial) == 0 {
+		return nil, fmt.Errorf("key material is empty")
+	}
+	scheme, ok := wrappingSchemes[algorithm]
+	if !ok {
+		return nil, fmt.Errorf("unsupported wrapping algorithm %q", algorithm)
+	}
+
+	rsaKey, err := parseRSAPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("parsing public key: %w", err)
+	}
+	if rsaKey.N.BitLen() != scheme.rsaKeyBits {
+		return nil, fmt.Errorf("wrapping algorithm %q requires a %d bit RSA key, got %d bit", algorithm, scheme.rsaKeyBits, rsaKey.N.BitLen())
+	}
+
+	if !scheme.aesKeyWrap {
+		wrapped, err := rsa.EncryptOAEP(scheme.hash.New(), random, rsaKey, keyMaterial, nil)
+		if err != nil {
+			return nil, fmt.Errorf("encrypting key material: %w", err)
+		}
+		return wrapped, nil
+	}
+
+	aesKey := make([]byte, aes256KeySize)
+	if _, err := io.ReadFull(random, aesKey); err != nil {
+		return nil, fmt.Errorf("generating AES key: %w", err)
+	}
+	wrappedAESKey, err := rsa.EncryptOAEP(scheme.hash.New(), random, rsaKey, aesKey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("encrypting AES key: %w", err)
+	}
+	wrappedKeyMaterial, err := aesKeyWrapWithPadding(aesKey, keyMaterial)
+	if err != nil {
+		return nil, fmt.Errorf("wrapping key material: %w", err)
+	}
+	return append(wrappedAESKey, wrappedKeyMaterial...), nil
+}
+
+// parseRSAPublicKey parses a PEM or base64 encoded DER public key in PKIX or PKCS #1 format.
+func parseRSAPublicKey(publicKey string) (*rsa.PublicKey, error) {
+	var der []byte
+	if block, _ := pem.Decode([]byte(publicKey)); block != nil {
+		der = block.Bytes
+	} else {
+		var err error
+		der, err = base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+		if err != nil {
+			return nil, fmt.Errorf("public key is neither PEM nor base64 encoded")
+		}
+	}
+
+	if key, err := x509.ParsePKIXPublicKey(der); err == nil {
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("public key is of type %T, expected RSA", key)
+		}
+		return rsaKey, nil
+	}
+	rsaKey, err := x509.ParsePKCS1PublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported public key format: %w", err)
+	}
+	return rsaKey, nil
+}
+
+// aesKeyWrapWithPadding implements the AES Key Wrap with Padding Algorithm (RFC 5649).
+func aesKeyWrapWithPadding(kek, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	// alternative initial value: constant 0xA65959A6 followed by the 32-bit message length indicator
+	aiv := make([]byte, 8)
+	copy(aiv, []byte{0xA6, 0x59, 0x59, 0xA6})
+	binary.BigEndian.PutUint32(aiv[4:], uint32(len(plaintext))) //nolint:gosec // key material is much smaller than 4 GiB
+
+	padded := make([]byte, (len(plaintext)+7)/8*8)
+	copy(padded, plaintext)
+
+	if len(padded) == 8 {
+		out := append(aiv, padded...)
+		block.Encrypt(out, out)
+		return out, nil
+	}
+
+	// wrapping process of RFC 3394 with the alternative initial value
+	n := len(padded) / 8
+	a := aiv
+	r := padded
+	buf := make([]byte, 16)
+	for j := range 6 {
+		for i := range n {
+			copy(buf[:8], a)
+			copy(buf[8:], r[i*8:(i+1)*8])
+			block.Encrypt(buf, buf)
+			t := uint64(n*j + i + 1) //nolint:gosec // always positive
+			binary.BigEndian.PutUint64(a, binary.BigEndian.Uint64(buf[:8])^t)
+			copy(r[i*8:(i+1)*8], buf[8:])
+		}
+	}
+	return append(a, r...), nil
+}
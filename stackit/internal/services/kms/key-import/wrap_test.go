@@ -0,0 +1,278 @@
+package kms
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"testing"
+
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+)
+
+func TestAESKeyWrapWithPadding(t *testing.T) {
+	// test vectors from RFC 5649, section 6
+	kek := mustDecodeHex(t, "5840df6e29b02af1ab493b705bf16ea1ae8338f4dcc176a8")
+	tests := []struct {
+		description string
+		plaintext   string
+		expected    string
+	}{
+		{
+			description: "20 octets",
+			plaintext:   "c37b7e6492584340bed12207808941155068f738",
+			expected:    "138bdeaa9b8fa7fc61f97742e72248ee5ae6ae5360d1ae6a5f54f373fa543b6a",
+		},
+		{
+			description: "7 octets",
+			plaintext:   "466f7250617369",
+			expected:    "afbeb0f07dfbf5419200f2ccb50bb24f",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := aesKeyWrapWithPadding(kek, mustDecodeHex(t, tt.plaintext))
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if hex.EncodeToString(output) != tt.expected {
+				t.Fatalf("Wrapped key does not match: got %x, expected %s", output, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWrapKeyMaterial(t *testing.T) {
+	keys := map[int]*rsa.PrivateKey{}
+	for _, bits := range []int{2048, 3072, 4096} {
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			t.Fatalf("Generating RSA key: %v", err)
+		}
+		keys[bits] = key
+	}
+	keyMaterial := bytes.Repeat([]byte{0x42}, 32)
+
+	for algorithm, scheme := range wrappingSchemes {
+		t.Run(string(algorithm), func(t *testing.T) {
+			privateKey := keys[scheme.rsaKeyBits]
+			publicKey := encodePublicKeyPEM(t, &privateKey.PublicKey)
+
+			wrapped, err := wrapKeyMaterial(publicKey, algorithm, keyMaterial, rand.Reader)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+
+			var unwrapped []byte
+			if scheme.aesKeyWrap {
+				rsaSize := privateKey.Size()
+				aesKey, err := rsa.DecryptOAEP(scheme.hash.New(), nil, privateKey, wrapped[:rsaSize], nil)
+				if err != nil {
+					t.Fatalf("Decrypting AES key: %v", err)
+				}
+				unwrapped = aesKeyUnwrapWithPadding(t, aesKey, wrapped[rsaSize:])
+			} else {
+				unwrapped, err = rsa.DecryptOAEP(scheme.hash.New(), nil, privateKey, wrapped, nil)
+				if err != nil {
+					t.Fatalf("Decrypting key material: %v", err)
+				}
+			}
+			if !bytes.Equal(unwrapped, keyMaterial) {
+				t.Fatalf("Unwrapped key material does not match")
+			}
+		})
+	}
+}
+
+func TestWrapKeyMaterialErrors(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Generating RSA key: %v", err)
+	}
+	publicKeyPEM := encodePublicKeyPEM(t, &privateKey.PublicKey)
+	publicKeyBase64 := base64.StdEncoding.EncodeToString(x509.MarshalPKCS1PublicKey(&privateKey.PublicKey))
+
+	tests := []struct {
+		description string
+		publicKey   string
+		algorithm   kms.WrappingAlgorithm
+		keyMaterial []byte
+		isValid     bool
+	}{
+		{
+			description: "base64 encoded PKCS #1 key",
+			publicKey:   publicKeyBase64,
+			algorithm:   kms.WRAPPINGALGORITHM_RSA_2048_OAEP_SHA256,
+			keyMaterial: []byte("key"),
+			isValid:     true,
+		},
+		{
+			description: "empty key material",
+			publicKey:   publicKeyPEM,
+			algorithm:   kms.WRAPPINGALGORITHM_RSA_2048_OAEP_SHA256,
+			keyMaterial: []byte{},
+			isValid:     false,
+		},
+		{
+			description: "unsupported algorithm",
+			publicKey:   publicKeyPEM,
+			algorithm:   kms.WRAPPINGALGORITHM_UNKNOWN_DEFAULT_OPEN_API,
+			keyMaterial: []byte("key"),
+			isValid:     false,
+		},
+		{
+			description: "key size mismatch",
+			publicKey:   publicKeyPEM,
+			algorithm:   kms.WRAPPINGALGORITHM_RSA_3072_OAEP_SHA256,
+			keyMaterial: []byte("key"),
+			isValid:     false,
+		},
+		{
+			description: "invalid public key",
+			publicKey:   "not a key",
+			algorithm:   kms.WRAPPINGALGORITHM_RSA_2048_OAEP_SHA256,
+			keyMaterial: []byte("key"),
+			isValid:     false,
+		},
+		{
+			description: "key material too long for RSA-OAEP",
+			publicKey:   publicKeyPEM,
+			algorithm:   kms.WRAPPINGALGORITHM_RSA_2048_OAEP_SHA256,
+			keyMaterial: make([]byte, 1024),
+			isValid:     false,
+		},
+		{
+			description: "long key material with AES key wrap",
+			publicKey:   publicKeyPEM,
+			algorithm:   kms.WRAPPINGALGORITHM_RSA_2048_OAEP_SHA256_AES_256_KEY_WRAP,
+			keyMaterial: make([]byte, 1024),
+			isValid:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			_, err := wrapKeyMaterial(tt.publicKey, tt.algorithm, tt.keyMaterial, rand.Reader)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateKeyMaterial(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Generating RSA key: %v", err)
+	}
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Generating ECDSA key: %v", err)
+	}
+	rsaDER := mustMarshalPKCS8(t, rsaKey)
+	ecdsaDER := mustMarshalPKCS8(t, ecdsaKey)
+
+	tests := []struct {
+		description string
+		algorithm   kms.Algorithm
+		keyMaterial []byte
+		isValid     bool
+	}{
+		{"aes ok", kms.ALGORITHM_AES_256_GCM, make([]byte, 32), true},
+		{"aes wrong size", kms.ALGORITHM_AES_256_GCM, make([]byte, 16), false},
+		{"hmac ok", kms.ALGORITHM_HMAC_SHA384, make([]byte, 48), true},
+		{"hmac too short", kms.ALGORITHM_HMAC_SHA512, make([]byte, 32), false},
+		{"rsa ok", kms.ALGORITHM_RSA_2048_OAEP_SHA256, rsaDER, true},
+		{"rsa wrong size", kms.ALGORITHM_RSA_3072_OAEP_SHA256, rsaDER, false},
+		{"rsa wrong type", kms.ALGORITHM_RSA_2048_OAEP_SHA256, ecdsaDER, false},
+		{"rsa no pkcs8", kms.ALGORITHM_RSA_2048_OAEP_SHA256, x509.MarshalPKCS1PrivateKey(rsaKey), false},
+		{"ecdsa ok", kms.ALGORITHM_ECDSA_P256_SHA256, ecdsaDER, true},
+		{"ecdsa wrong curve", kms.ALGORITHM_ECDSA_P384_SHA384, ecdsaDER, false},
+		{"ecdsa wrong type", kms.ALGORITHM_ECDSA_P256_SHA256, rsaDER, false},
+		{"unknown algorithm", kms.ALGORITHM_UNKNOWN_DEFAULT_OPEN_API, make([]byte, 32), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := validateKeyMaterial(tt.algorithm, tt.keyMaterial)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
+func mustMarshalPKCS8(t *testing.T, key any) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("Marshalling private key: %v", err)
+	}
+	return der
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("Decoding hex: %v", err)
+	}
+	return b
+}
+
+func encodePublicKeyPEM(t *testing.T, key *rsa.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("Marshalling public key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// aesKeyUnwrapWithPadding is the inverse of aesKeyWrapWithPadding, as done by the KMS on import.
+func aesKeyUnwrapWithPadding(t *testing.T, kek, ciphertext []byte) []byte {
+	t.Helper()
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		t.Fatalf("Creating cipher: %v", err)
+	}
+
+	var a, r []byte
+	if len(ciphertext) == 16 {
+		out := make([]byte, 16)
+		block.Decrypt(out, ciphertext)
+		a, r = out[:8], out[8:]
+	} else {
+		n := len(ciphertext)/8 - 1
+		a = bytes.Clone(ciphertext[:8])
+		r = bytes.Clone(ciphertext[8:])
+		buf := make([]byte, 16)
+		for j := 5; j >= 0; j-- {
+			for i := n - 1; i >= 0; i-- {
+				counter := uint64(n*j + i + 1) //nolint:gosec // always positive
+				binary.BigEndian.PutUint64(buf[:8], binary.BigEndian.Uint64(a)^counter)
+				copy(buf[8:], r[i*8:(i+1)*8])
+				block.Decrypt(buf, buf)
+				copy(a, buf[:8])
+				copy(r[i*8:(i+1)*8], buf[8:])
+			}
+		}
+	}
+
+	if !bytes.Equal(a[:4], []byte{0xA6, 0x59, 0x59, 0xA6}) {
+		t.Fatalf("Integrity check failed: %x", a)
+	}
+	length := binary.BigEndian.Uint32(a[4:])
+	return r[:length]
+}
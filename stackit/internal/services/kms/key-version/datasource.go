@@ -0,0 +1,242 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	kmsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+var (
+	_ datasource.DataSource              = &keyVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &keyVersionsDataSource{}
+)
+
+type versionsModel struct {
+	Id        types.String   `tfsdk:"id"` // needed by TF
+	ProjectId types.String   `tfsdk:"project_id"`
+	Region    types.String   `tfsdk:"region"`
+	KeyRingId types.String   `tfsdk:"keyring_id"`
+	KeyId     types.String   `tfsdk:"key_id"`
+	Versions  []versionModel `tfsdk:"versions"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+type versionModel struct {
+	VersionNumber types.Int64  `tfsdk:"version_number"`
+	Disabled      types.Bool   `tfsdk:"disabled"`
+	State         types.String `tfsdk:"state"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	DestroyDate   types.String `tfsdk:"destroy_date"`
+	PublicKey     types.String `tfsdk:"public_key"`
+}
+
+func NewKeyVersionsDataSource() datasource.DataSource {
+	return &keyVersionsDataSource{}
+}
+
+type keyVersionsDataSource struct {
+	client       *kms.APIClient
+	providerData core.ProviderData
+}
+
+func (d *keyVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_key_versions"
+}
+
+func (d *keyVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = kmsUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "KMS client configured")
+}
+
+func (d *keyVersionsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("KMS Key versions datasource schema. Lists all versions of a key, sorted by their version number. %s", core.DatasourceRegionFallbackDocstring),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It is structured as \"`project_id`,`region`,`keyring_id`,`key_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the key is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The resource region. If not defined, the provider region is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"keyring_id": schema.StringAttribute{
+				Description: "The ID of the associated keyring",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Description: "The ID of the key",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx),
+			"versions": schema.ListNestedAttribute{
+				Description: "List of the key versions.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version_number": schema.Int64Attribute{
+							Description: "The number of the key version.",
+							Computed:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "States whether the key version is disabled.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The current state of the key version.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The date and time the key version was created.",
+							Computed:    true,
+						},
+						"destroy_date": schema.StringAttribute{
+							Description: "The date on which the key version will be destroyed.",
+							Computed:    true,
+						},
+						"public_key": schema.StringAttribute{
+							Description: "The public key of the key version. Only set for asymmetric keys.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *keyVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model versionsModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := model.Timeouts.Read(ctx, core.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	keyRingId := model.KeyRingId.ValueString()
+	keyId := model.KeyId.ValueString()
+	region := d.providerData.GetRegionWithOverride(model.Region)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "keyring_id", keyRingId)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	versionsResponse, err := d.client.DefaultAPI.ListVersions(ctx, projectId, region, keyRingId, keyId).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading key versions",
+			fmt.Sprintf("Key with ID %q does not exist in project %q.", keyId, projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapVersionsFields(versionsResponse, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading key versions", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Key versions read")
+}
+
+func mapVersionsFields(versions *kms.VersionList, model *versionsModel, region string) error {
+	if versions == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.KeyRingId.ValueString(), model.KeyId.ValueString())
+	model.Region = types.StringValue(region)
+
+	sorted := slices.SortedFunc(slices.Values(versions.Versions), func(a, b kms.Version) int {
+		return int(a.Number - b.Number)
+	})
+
+	model.Versions = make([]versionModel, 0, len(sorted))
+	for _, version := range sorted {
+		destroyDate := types.StringNull()
+		if version.DestroyDate != nil {
+			destroyDate = types.StringValue(version.DestroyDate.Format(time.RFC3339))
+		}
+		model.Versions = append(model.Versions, versionModel{
+			VersionNumber: types.Int64Value(version.Number),
+			Disabled:      types.BoolValue(version.Disabled),
+			State:         types.StringValue(string(version.State)),
+			CreatedAt:     types.StringValue(version.CreatedAt.Format(time.RFC3339)),
+			DestroyDate:   destroyDate,
+			PublicKey:     types.StringPointerValue(version.PublicKey),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package kms
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+)
+
+func TestMapVersionsFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *kms.VersionList
+		expected    versionsModel
+		isValid     bool
+	}{
+		{
+			description: "empty",
+			input:       &kms.VersionList{},
+			expected: versionsModel{
+				Id:        types.StringValue(fmt.Sprintf("%s,eu01,%s,%s", projectId, keyRingId, keyId)),
+				ProjectId: types.StringValue(projectId),
+				Region:    types.StringValue("eu01"),
+				KeyRingId: types.StringValue(keyRingId),
+				KeyId:     types.StringValue(keyId),
+				Versions:  []versionModel{},
+			},
+			isValid: true,
+		},
+		{
+			description: "sorted by version number",
+			input: &kms.VersionList{
+				Versions: []kms.Version{
+					{Number: 2, CreatedAt: createdAt.Add(time.Hour), State: kms.VERSIONSTATE_ACTIVE, PublicKey: new("key-2")},
+					{Number: 1, CreatedAt: createdAt, State: kms.VERSIONSTATE_DESTROYED, Disabled: true, DestroyDate: new(createdAt.Add(2 * time.Hour))},
+				},
+			},
+			expected: versionsModel{
+				Id:        types.StringValue(fmt.Sprintf("%s,eu01,%s,%s", projectId, keyRingId, keyId)),
+				ProjectId: types.StringValue(projectId),
+				Region:    types.StringValue("eu01"),
+				KeyRingId: types.StringValue(keyRingId),
+				KeyId:     types.StringValue(keyId),
+				Versions: []versionModel{
+					{
+						VersionNumber: types.Int64Value(1),
+						Disabled:      types.BoolValue(true),
+						State:         types.StringValue(string(kms.VERSIONSTATE_DESTROYED)),
+						CreatedAt:     types.StringValue("2025-01-01T12:00:00Z"),
+						DestroyDate:   types.StringValue("2025-01-01T14:00:00Z"),
+						PublicKey:     types.StringNull(),
+					},
+					{
+						VersionNumber: types.Int64Value(2),
+						Disabled:      types.BoolValue(false),
+						State:         types.StringValue(string(kms.VERSIONSTATE_ACTIVE)),
+						CreatedAt:     types.StringValue("2025-01-01T13:00:00Z"),
+						DestroyDate:   types.StringNull(),
+						PublicKey:     types.StringValue("key-2"),
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "nil response",
+			input:       nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &versionsModel{
+				ProjectId: types.StringValue(projectId),
+				KeyRingId: types.StringValue(keyRingId),
+				KeyId:     types.StringValue(keyId),
+			}
+			err := mapVersionsFields(tt.input, model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,487 @@
+package kms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+	"github.com/stackitcloud/stackit-sdk-go/services/kms/v1api/wait"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	kmsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+const (
+	deletionWarning = "Key versions will **not** be instantly destroyed by terraform during a `terraform destroy`. They will just be scheduled for destruction via the API and thrown out of the Terraform state afterwards. **This way we can ensure no key material is lost by accident and it gives you the option to restore the version within the grace period.**"
+)
+
+var (
+	_ resource.Resource                = &keyVersionResource{}
+	_ resource.ResourceWithConfigure   = &keyVersionResource{}
+	_ resource.ResourceWithImportState = &keyVersionResource{}
+	_ resource.ResourceWithModifyPlan  = &keyVersionResource{}
+)
+
+type Model struct {
+	Id            types.String `tfsdk:"id"` // needed by TF
+	ProjectId     types.String `tfsdk:"project_id"`
+	Region        types.String `tfsdk:"region"`
+	KeyRingId     types.String `tfsdk:"keyring_id"`
+	KeyId         types.String `tfsdk:"key_id"`
+	VersionNumber types.Int64  `tfsdk:"version_number"`
+	Disabled      types.Bool   `tfsdk:"disabled"`
+	State         types.String `tfsdk:"state"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	DestroyDate   types.String `tfsdk:"destroy_date"`
+	PublicKey     types.String `tfsdk:"public_key"`
+}
+
+func NewKeyVersionResource() resource.Resource {
+	return &keyVersionResource{}
+}
+
+type keyVersionResource struct {
+	client       *kms.APIClient
+	providerData core.ProviderData
+}
+
+func (r *keyVersionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_key_version"
+}
+
+func (r *keyVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = kmsUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "KMS client configured")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *keyVersionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *keyVersionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := fmt.Sprintf("KMS Key version resource schema. Creating this resource rotates the key, i.e. a new version of the key is created and used for all further cryptographic operations. The key must not be `import_only`. %s", core.ResourceRegionFallbackDocstring)
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: fmt.Sprintf("%s\n\n ~> %s", description, deletionWarning),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`keyring_id`,`key_id`,`version_number`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the key is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: "The resource region. If not defined, the provider region is used.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keyring_id": schema.StringAttribute{
+				Description: "The ID of the associated keyring",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Description: "The ID of the key",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"version_number": schema.Int64Attribute{
+				Description: "The number of the key version.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "States whether the key version is disabled. Disabled versions can't be used for cryptographic operations. Default is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"state": schema.StringAttribute{
+				Description: "The current state of the key version.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The date and time the key version was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destroy_date": schema.StringAttribute{
+				Description: "The date on which the key version will be destroyed.",
+				Computed:    true,
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The public key of the key version. Only set for asymmetric keys.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *keyVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	keyRingId := model.KeyRingId.ValueString()
+	keyId := model.KeyId.ValueString()
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "keyring_id", keyRingId)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+
+	createResponse, err := r.client.DefaultAPI.RotateKey(ctx, projectId, region, keyRingId, keyId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key version", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	if createResponse == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key version", "API returned empty response")
+		return
+	}
+
+	// Write id attributes to state before polling via the wait handler - just in case anything goes wrong during the wait handler
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id":     projectId,
+		"region":         region,
+		"keyring_id":     keyRingId,
+		"key_id":         keyId,
+		"version_number": createResponse.Number,
+	})
+
+	version, err := wait.EnableKeyVersionWaitHandler(ctx, r.client.DefaultAPI, projectId, region, keyRingId, keyId, createResponse.Number).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error waiting for key version creation", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	if model.Disabled.ValueBool() {
+		version, err = r.setDisabled(ctx, projectId, region, keyRingId, keyId, createResponse.Number, true)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key version", fmt.Sprintf("Disabling key version: %v", err))
+			return
+		}
+	}
+
+	err = mapFields(version, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key version", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Key version created")
+}
+
+func (r *keyVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	keyRingId := model.KeyRingId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	keyId := model.KeyId.ValueString()
+	versionNumber := model.VersionNumber.ValueInt64()
+
+	ctx = tflog.SetField(ctx, "keyring_id", keyRingId)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+	ctx = tflog.SetField(ctx, "version_number", versionNumber)
+
+	versionResponse, err := r.client.DefaultAPI.GetVersion(ctx, projectId, region, keyRingId, keyId, versionNumber).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading key version", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Versions which are scheduled for destruction are treated as deleted
+	if versionResponse.State == kms.VERSIONSTATE_DESTROYED || versionResponse.DestroyDate != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapFields(versionResponse, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading key version", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Key version read")
+}
+
+// Update enables or disables the key version. All other attributes require a replacement.
+func (r *keyVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	keyRingId := model.KeyRingId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	keyId := model.KeyId.ValueString()
+	versionNumber := model.VersionNumber.ValueInt64()
+
+	ctx = tflog.SetField(ctx, "keyring_id", keyRingId)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+	ctx = tflog.SetField(ctx, "version_number", versionNumber)
+
+	version, err := r.setDisabled(ctx, projectId, region, keyRingId, keyId, versionNumber, model.Disabled.ValueBool())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating key version", err.Error())
+		return
+	}
+
+	err = mapFields(version, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating key version", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Key version updated")
+}
+
+func (r *keyVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	keyRingId := model.KeyRingId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	keyId := model.KeyId.ValueString()
+	versionNumber := model.VersionNumber.ValueInt64()
+
+	err := r.client.DefaultAPI.DestroyVersion(ctx, projectId, region, keyRingId, keyId, versionNumber).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting key version", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// The key versions can't be destroyed instantly by Terraform, they can only be scheduled for destruction via the API.
+	core.LogAndAddWarning(ctx, &resp.Diagnostics, "Key version scheduled for destruction on API side", deletionWarning)
+
+	tflog.Info(ctx, "Key version deleted")
+}
+
+func (r *keyVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 5 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" || idParts[4] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing key version",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[keyring_id],[key_id],[version_number], got %q", req.ID),
+		)
+		return
+	}
+
+	versionNumber, err := strconv.ParseInt(idParts[4], 10, 64)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing key version",
+			fmt.Sprintf("Expected version_number to be an integer, got %q", idParts[4]),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id":     idParts[0],
+		"region":         idParts[1],
+		"keyring_id":     idParts[2],
+		"key_id":         idParts[3],
+		"version_number": versionNumber,
+	})
+
+	tflog.Info(ctx, "Key version state imported")
+}
+
+// setDisabled disables or enables the key version and waits until the operation is done.
+func (r *keyVersionResource) setDisabled(ctx context.Context, projectId, region, keyRingId, keyId string, versionNumber int64, disabled bool) (*kms.Version, error) {
+	if disabled {
+		err := r.client.DefaultAPI.DisableVersion(ctx, projectId, region, keyRingId, keyId, versionNumber).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("calling API to disable key version: %w", err)
+		}
+		version, err := wait.DisableKeyVersionWaitHandler(ctx, r.client.DefaultAPI, projectId, region, keyRingId, keyId, versionNumber).WaitWithContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("waiting for key version to be disabled: %w", err)
+		}
+		return version, nil
+	}
+
+	err := r.client.DefaultAPI.EnableVersion(ctx, projectId, region, keyRingId, keyId, versionNumber).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("calling API to enable key version: %w", err)
+	}
+	version, err := wait.EnableKeyVersionWaitHandler(ctx, r.client.DefaultAPI, projectId, region, keyRingId, keyId, versionNumber).WaitWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for key version to be enabled: %w", err)
+	}
+	return version, nil
+}
+
+func mapFields(version *kms.Version, model *Model, region string) error {
+	if version == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.KeyRingId.ValueString(), model.KeyId.ValueString(), strconv.FormatInt(version.Number, 10))
+	model.Region = types.StringValue(region)
+	model.VersionNumber = types.Int64Value(version.Number)
+	model.Disabled = types.BoolValue(version.Disabled)
+	model.State = types.StringValue(string(version.State))
+	model.CreatedAt = types.StringValue(version.CreatedAt.Format(time.RFC3339))
+	model.PublicKey = types.StringPointerValue(version.PublicKey)
+	if version.DestroyDate != nil {
+		model.DestroyDate = types.StringValue(version.DestroyDate.Format(time.RFC3339))
+	} else {
+		model.DestroyDate = types.StringNull()
+	}
+
+	return nil
+}
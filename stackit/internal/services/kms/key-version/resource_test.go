@@ -0,0 +1,123 @@
+package kms
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+)
+
+var (
+	keyId     = uuid.NewString()
+	keyRingId = uuid.NewString()
+	projectId = uuid.NewString()
+	createdAt = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func TestMapFields(t *testing.T) {
+	type args struct {
+		state  Model
+		input  *kms.Version
+		region string
+	}
+	tests := []struct {
+		description string
+		args        args
+		expected    Model
+		isValid     bool
+	}{
+		{
+			description: "default values",
+			args: args{
+				state: Model{
+					KeyId:     types.StringValue(keyId),
+					KeyRingId: types.StringValue(keyRingId),
+					ProjectId: types.StringValue(projectId),
+				},
+				input: &kms.Version{
+					Number:    2,
+					CreatedAt: createdAt,
+					State:     kms.VERSIONSTATE_ACTIVE,
+				},
+				region: "eu01",
+			},
+			expected: Model{
+				Id:            types.StringValue(fmt.Sprintf("%s,eu01,%s,%s,2", projectId, keyRingId, keyId)),
+				ProjectId:     types.StringValue(projectId),
+				Region:        types.StringValue("eu01"),
+				KeyRingId:     types.StringValue(keyRingId),
+				KeyId:         types.StringValue(keyId),
+				VersionNumber: types.Int64Value(2),
+				Disabled:      types.BoolValue(false),
+				State:         types.StringValue(string(kms.VERSIONSTATE_ACTIVE)),
+				CreatedAt:     types.StringValue("2025-01-01T12:00:00Z"),
+				DestroyDate:   types.StringNull(),
+				PublicKey:     types.StringNull(),
+			},
+			isValid: true,
+		},
+		{
+			description: "values_ok",
+			args: args{
+				state: Model{
+					KeyId:     types.StringValue(keyId),
+					KeyRingId: types.StringValue(keyRingId),
+					ProjectId: types.StringValue(projectId),
+				},
+				input: &kms.Version{
+					Number:      1,
+					CreatedAt:   createdAt,
+					DestroyDate: new(createdAt.Add(24 * time.Hour)),
+					Disabled:    true,
+					PublicKey:   new("public-key"),
+					State:       kms.VERSIONSTATE_DISABLED,
+				},
+				region: "eu02",
+			},
+			expected: Model{
+				Id:            types.StringValue(fmt.Sprintf("%s,eu02,%s,%s,1", projectId, keyRingId, keyId)),
+				ProjectId:     types.StringValue(projectId),
+				Region:        types.StringValue("eu02"),
+				KeyRingId:     types.StringValue(keyRingId),
+				KeyId:         types.StringValue(keyId),
+				VersionNumber: types.Int64Value(1),
+				Disabled:      types.BoolValue(true),
+				State:         types.StringValue(string(kms.VERSIONSTATE_DISABLED)),
+				CreatedAt:     types.StringValue("2025-01-01T12:00:00Z"),
+				DestroyDate:   types.StringValue("2025-01-02T12:00:00Z"),
+				PublicKey:     types.StringValue("public-key"),
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response_field",
+			args: args{
+				state: Model{},
+				input: nil,
+			},
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &tt.args.state
+			err := mapFields(tt.args.input, state, tt.args.region)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/stackitcloud/stackit-sdk-go/services/kms/v1api/wait"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -30,7 +33,8 @@ import (
 )
 
 const (
-	deletionWarning = "Keys will **not** be instantly destroyed by terraform during a `terraform destroy`. They will just be scheduled for deletion via the API and thrown out of the Terraform state afterwards. **This way we can ensure no key setups are deleted by accident and it gives you the option to recover your keys within the grace period.**"
+	rotationDescription = "Terraform rotates the key by creating a new key version during the first `terraform apply` after `next_rotation_date` has passed. The rotation is not performed by the KMS service itself, so the key is only rotated when Terraform runs."
+	deletionWarning     = "Keys will **not** be instantly destroyed by terraform during a `terraform destroy`. They will just be scheduled for deletion via the API and thrown out of the Terraform state afterwards. **This way we can ensure no key setups are deleted by accident and it gives you the option to recover your keys within the grace period.**"
 )
 
 var (
@@ -55,6 +59,13 @@ type Model struct {
 	Region      types.String `tfsdk:"region"`
 }
 
+// ResourceModel extends Model with the rotation settings, which are managed by Terraform only.
+type ResourceModel struct {
+	Model
+	RotationPeriod   types.String `tfsdk:"rotation_period"`
+	NextRotationDate types.String `tfsdk:"next_rotation_date"`
+}
+
 func NewKeyResource() resource.Resource {
 	return &keyResource{}
 }
@@ -86,7 +97,7 @@ func (r *keyResource) Configure(ctx context.Context, req resource.ConfigureReque
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *keyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
-	var configModel Model
+	var configModel ResourceModel
 	// skip initial empty configuration to avoid follow-up errors
 	if req.Config.Raw.IsNull() {
 		return
@@ -96,7 +107,7 @@ func (r *keyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReq
 		return
 	}
 
-	var planModel Model
+	var planModel ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -107,6 +118,21 @@ func (r *keyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReq
 		return
 	}
 
+	if !planModel.RotationPeriod.IsNull() && planModel.ImportOnly.ValueBool() {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error planning key", "`rotation_period` can't be set for keys with `import_only` enabled, because new versions of these keys can only be imported")
+		return
+	}
+
+	var stateModel *ResourceModel
+	if !req.State.Raw.IsNull() {
+		stateModel = &ResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	planNextRotationDate(&planModel, stateModel, time.Now())
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -169,11 +195,16 @@ func (r *keyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Description: "States whether versions can be created or only imported.",
 				Computed:    true,
 				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
 			},
 			"key_id": schema.StringAttribute{
 				Description: "The ID of the key",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -232,12 +263,23 @@ func (r *keyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"rotation_period": schema.StringAttribute{
+				Description: fmt.Sprintf("Period after which the key is rotated automatically, e.g. `8760h` for a yearly rotation. %s", rotationDescription),
+				Optional:    true,
+				Validators: []validator.String{
+					validate.ValidDurationString(),
+				},
+			},
+			"next_rotation_date": schema.StringAttribute{
+				Description: "Date after which the key is rotated, in RFC3339 format. Calculated from the creation date of the latest key version and `rotation_period`.",
+				Computed:    true,
+			},
 		},
 	}
 }
 
 func (r *keyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -254,7 +296,7 @@ func (r *keyResource) Create(ctx context.Context, req resource.CreateRequest, re
 	ctx = tflog.SetField(ctx, "region", region)
 	ctx = tflog.SetField(ctx, "keyring_id", keyRingId)
 
-	payload, err := toCreatePayload(&model)
+	payload, err := toCreatePayload(&model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -287,12 +329,18 @@ func (r *keyResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	err = mapFields(waitHandlerResp, &model, region)
+	err = mapFields(waitHandlerResp, &model.Model, region)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
 
+	err = r.loadNextRotationDate(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key", fmt.Sprintf("Calculating next rotation date: %v", err))
+		return
+	}
+
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -302,7 +350,7 @@ func (r *keyResource) Create(ctx context.Context, req resource.CreateRequest, re
 }
 
 func (r *keyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -339,11 +387,17 @@ func (r *keyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	ctx = core.LogResponse(ctx)
 
-	err = mapFields(keyResponse, &model, region)
+	err = mapFields(keyResponse, &model.Model, region)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading key", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+
+	err = r.loadNextRotationDate(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading key", fmt.Sprintf("Calculating next rotation date: %v", err))
+		return
+	}
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -352,13 +406,73 @@ func (r *keyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	tflog.Info(ctx, "Key read")
 }
 
-func (r *keyResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	// keys cannot be updated, so we log an error.
-	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating key", "Keys can't be updated")
+// Update only handles the rotation settings, all other attributes of a key require a replacement.
+// If the rotation is due, a new key version is created.
+func (r *keyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model ResourceModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	keyRingId := model.KeyRingId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	keyId := model.KeyId.ValueString()
+
+	ctx = tflog.SetField(ctx, "keyring_id", keyRingId)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+
+	err := r.loadNextRotationDate(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating key", fmt.Sprintf("Calculating next rotation date: %v", err))
+		return
+	}
+
+	if rotationDue(model.NextRotationDate, time.Now()) {
+		version, err := r.client.DefaultAPI.RotateKey(ctx, projectId, region, keyRingId, keyId).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating key", fmt.Sprintf("Calling API: %v", err))
+			return
+		}
+
+		ctx = core.LogResponse(ctx)
+
+		if version == nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating key", "API returned empty response")
+			return
+		}
+		ctx = tflog.SetField(ctx, "version_number", version.Number)
+
+		_, err = wait.EnableKeyVersionWaitHandler(ctx, r.client.DefaultAPI, projectId, region, keyRingId, keyId, version.Number).WaitWithContext(ctx)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error waiting for key rotation", fmt.Sprintf("Calling API: %v", err))
+			return
+		}
+		tflog.Info(ctx, "Key rotated")
+
+		err = r.loadNextRotationDate(ctx, &model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating key", fmt.Sprintf("Calculating next rotation date: %v", err))
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Key updated")
 }
 
 func (r *keyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -411,6 +525,79 @@ func (r *keyResource) ImportState(ctx context.Context, req resource.ImportStateR
 	tflog.Info(ctx, "key state imported")
 }
 
+// loadNextRotationDate sets the next rotation date based on the creation date of the latest key version.
+func (r *keyResource) loadNextRotationDate(ctx context.Context, model *ResourceModel) error {
+	if model.RotationPeriod.IsNull() || model.RotationPeriod.IsUnknown() {
+		model.NextRotationDate = types.StringNull()
+		return nil
+	}
+
+	versions, err := r.client.DefaultAPI.ListVersions(ctx, model.ProjectId.ValueString(), model.Region.ValueString(), model.KeyRingId.ValueString(), model.KeyId.ValueString()).Execute()
+	if err != nil {
+		return fmt.Errorf("listing key versions: %w", err)
+	}
+	return mapNextRotationDate(versions, model)
+}
+
+func mapNextRotationDate(versions *kms.VersionList, model *ResourceModel) error {
+	if versions == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if model.RotationPeriod.IsNull() || model.RotationPeriod.IsUnknown() {
+		model.NextRotationDate = types.StringNull()
+		return nil
+	}
+
+	period, err := time.ParseDuration(model.RotationPeriod.ValueString())
+	if err != nil {
+		return fmt.Errorf("parsing rotation period: %w", err)
+	}
+
+	activeVersions := slices.DeleteFunc(slices.Clone(versions.Versions), func(v kms.Version) bool {
+		return v.State == kms.VERSIONSTATE_DESTROYED || v.DestroyDate != nil
+	})
+	if len(activeVersions) == 0 {
+		// Without any usable version the key has to be rotated right away.
+		model.NextRotationDate = types.StringValue(time.Unix(0, 0).UTC().Format(time.RFC3339))
+		return nil
+	}
+	latest := slices.MaxFunc(activeVersions, func(a, b kms.Version) int {
+		return int(a.Number - b.Number)
+	})
+	model.NextRotationDate = types.StringValue(latest.CreatedAt.Add(period).UTC().Format(time.RFC3339))
+	return nil
+}
+
+// rotationDue returns whether the given next rotation date has passed.
+func rotationDue(nextRotationDate types.String, now time.Time) bool {
+	if nextRotationDate.IsNull() || nextRotationDate.IsUnknown() {
+		return false
+	}
+	next, err := time.Parse(time.RFC3339, nextRotationDate.ValueString())
+	if err != nil {
+		return false
+	}
+	return !now.Before(next)
+}
+
+// planNextRotationDate marks the next rotation date as unknown if the key has to be rotated during the apply
+// or the rotation period changed. This way Terraform plans an update of the key.
+func planNextRotationDate(plan, state *ResourceModel, now time.Time) {
+	switch {
+	case plan.RotationPeriod.IsNull():
+		plan.NextRotationDate = types.StringNull()
+	case state == nil || !plan.RotationPeriod.Equal(state.RotationPeriod):
+		plan.NextRotationDate = types.StringUnknown()
+	case rotationDue(state.NextRotationDate, now):
+		plan.NextRotationDate = types.StringUnknown()
+	default:
+		plan.NextRotationDate = state.NextRotationDate
+	}
+}
+
 func mapFields(key *kms.Key, model *Model, region string) error {
 	if key == nil {
 		return fmt.Errorf("response input is nil")
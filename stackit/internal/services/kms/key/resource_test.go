@@ -3,6 +3,7 @@ package kms
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -193,3 +194,131 @@ func TestToCreatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestMapNextRotationDate(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description    string
+		rotationPeriod types.String
+		input          *kms.VersionList
+		expected       types.String
+		isValid        bool
+	}{
+		{
+			description:    "no rotation period",
+			rotationPeriod: types.StringNull(),
+			input:          &kms.VersionList{},
+			expected:       types.StringNull(),
+			isValid:        true,
+		},
+		{
+			description:    "latest version",
+			rotationPeriod: types.StringValue("720h"),
+			input: &kms.VersionList{
+				Versions: []kms.Version{
+					{Number: 2, CreatedAt: createdAt, State: kms.VERSIONSTATE_ACTIVE},
+					{Number: 1, CreatedAt: createdAt.Add(-time.Hour), State: kms.VERSIONSTATE_DISABLED},
+				},
+			},
+			expected: types.StringValue("2025-01-31T12:00:00Z"),
+			isValid:  true,
+		},
+		{
+			description:    "destroyed versions are ignored",
+			rotationPeriod: types.StringValue("24h"),
+			input: &kms.VersionList{
+				Versions: []kms.Version{
+					{Number: 1, CreatedAt: createdAt, State: kms.VERSIONSTATE_ACTIVE},
+					{Number: 2, CreatedAt: createdAt.Add(time.Hour), State: kms.VERSIONSTATE_DESTROYED},
+				},
+			},
+			expected: types.StringValue("2025-01-02T12:00:00Z"),
+			isValid:  true,
+		},
+		{
+			description:    "no usable version",
+			rotationPeriod: types.StringValue("24h"),
+			input:          &kms.VersionList{},
+			expected:       types.StringValue("1970-01-01T00:00:00Z"),
+			isValid:        true,
+		},
+		{
+			description:    "invalid rotation period",
+			rotationPeriod: types.StringValue("one year"),
+			input:          &kms.VersionList{},
+			isValid:        false,
+		},
+		{
+			description:    "nil response",
+			rotationPeriod: types.StringValue("24h"),
+			input:          nil,
+			isValid:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &ResourceModel{RotationPeriod: tt.rotationPeriod}
+			err := mapNextRotationDate(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model.NextRotationDate, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanNextRotationDate(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		plan        ResourceModel
+		state       *ResourceModel
+		expected    types.String
+	}{
+		{
+			description: "no rotation period",
+			plan:        ResourceModel{RotationPeriod: types.StringNull(), NextRotationDate: types.StringUnknown()},
+			expected:    types.StringNull(),
+		},
+		{
+			description: "create",
+			plan:        ResourceModel{RotationPeriod: types.StringValue("24h"), NextRotationDate: types.StringUnknown()},
+			expected:    types.StringUnknown(),
+		},
+		{
+			description: "rotation period changed",
+			plan:        ResourceModel{RotationPeriod: types.StringValue("48h"), NextRotationDate: types.StringUnknown()},
+			state:       &ResourceModel{RotationPeriod: types.StringValue("24h"), NextRotationDate: types.StringValue("2025-07-01T00:00:00Z")},
+			expected:    types.StringUnknown(),
+		},
+		{
+			description: "rotation due",
+			plan:        ResourceModel{RotationPeriod: types.StringValue("24h"), NextRotationDate: types.StringUnknown()},
+			state:       &ResourceModel{RotationPeriod: types.StringValue("24h"), NextRotationDate: types.StringValue("2025-05-31T00:00:00Z")},
+			expected:    types.StringUnknown(),
+		},
+		{
+			description: "rotation not due",
+			plan:        ResourceModel{RotationPeriod: types.StringValue("24h"), NextRotationDate: types.StringUnknown()},
+			state:       &ResourceModel{RotationPeriod: types.StringValue("24h"), NextRotationDate: types.StringValue("2025-07-01T00:00:00Z")},
+			expected:    types.StringValue("2025-07-01T00:00:00Z"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			planNextRotationDate(&tt.plan, tt.state, now)
+			diff := cmp.Diff(tt.plan.NextRotationDate, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	kmsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+var (
+	_ datasource.DataSource              = &publicKeyDataSource{}
+	_ datasource.DataSourceWithConfigure = &publicKeyDataSource{}
+)
+
+type Model struct {
+	Id            types.String `tfsdk:"id"` // needed by TF
+	ProjectId     types.String `tfsdk:"project_id"`
+	Region        types.String `tfsdk:"region"`
+	KeyRingId     types.String `tfsdk:"keyring_id"`
+	KeyId         types.String `tfsdk:"key_id"`
+	VersionNumber types.Int64  `tfsdk:"version_number"`
+	PublicKey     types.String `tfsdk:"public_key"`
+}
+
+func NewPublicKeyDataSource() datasource.DataSource {
+	return &publicKeyDataSource{}
+}
+
+type publicKeyDataSource struct {
+	client       *kms.APIClient
+	providerData core.ProviderData
+}
+
+func (d *publicKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_public_key"
+}
+
+func (d *publicKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = kmsUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "KMS client configured")
+}
+
+func (d *publicKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("KMS public key datasource schema. Returns the public key of an asymmetric key, i.e. a key of purpose `%s` or `%s`. %s", kms.PURPOSE_ASYMMETRIC_ENCRYPT_DECRYPT, kms.PURPOSE_ASYMMETRIC_SIGN_VERIFY, core.DatasourceRegionFallbackDocstring),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It is structured as \"`project_id`,`region`,`keyring_id`,`key_id`,`version_number`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the key is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The resource region. If not defined, the provider region is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"keyring_id": schema.StringAttribute{
+				Description: "The ID of the associated keyring",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Description: "The ID of the key",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"version_number": schema.Int64Attribute{
+				Description: "The number of the key version. If not defined, the latest active version of the key is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The public key of the key version.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *publicKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	keyRingId := model.KeyRingId.ValueString()
+	keyId := model.KeyId.ValueString()
+	region := d.providerData.GetRegionWithOverride(model.Region)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "keyring_id", keyRingId)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	versionNumber := model.VersionNumber.ValueInt64()
+	if model.VersionNumber.IsNull() || model.VersionNumber.IsUnknown() {
+		var err error
+		versionNumber, err = kmsUtils.LatestVersionNumber(ctx, d.client.DefaultAPI, projectId, region, keyRingId, keyId)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading public key", fmt.Sprintf("Determining key version: %v", err))
+			return
+		}
+	}
+	ctx = tflog.SetField(ctx, "version_number", versionNumber)
+
+	versionResponse, err := d.client.DefaultAPI.GetVersion(ctx, projectId, region, keyRingId, keyId, versionNumber).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading public key",
+			fmt.Sprintf("Version %d of key with ID %q does not exist in project %q.", versionNumber, keyId, projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(versionResponse, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading public key", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Public key read")
+}
+
+func mapFields(version *kms.Version, model *Model, region string) error {
+	if version == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if version.PublicKey == nil {
+		return fmt.Errorf("version %d of key %q has no public key, only asymmetric keys have a public key", version.Number, version.KeyId)
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.KeyRingId.ValueString(), model.KeyId.ValueString(), strconv.FormatInt(version.Number, 10))
+	model.Region = types.StringValue(region)
+	model.VersionNumber = types.Int64Value(version.Number)
+	model.PublicKey = types.StringPointerValue(version.PublicKey)
+
+	return nil
+}
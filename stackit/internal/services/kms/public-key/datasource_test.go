@@ -0,0 +1,80 @@
+package kms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+)
+
+var (
+	keyId     = uuid.NewString()
+	keyRingId = uuid.NewString()
+	projectId = uuid.NewString()
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *kms.Version
+		expected    Model
+		isValid     bool
+	}{
+		{
+			description: "values_ok",
+			input: &kms.Version{
+				KeyId:     keyId,
+				Number:    2,
+				PublicKey: new("-----BEGIN PUBLIC KEY-----"),
+			},
+			expected: Model{
+				Id:            types.StringValue(fmt.Sprintf("%s,eu01,%s,%s,2", projectId, keyRingId, keyId)),
+				ProjectId:     types.StringValue(projectId),
+				Region:        types.StringValue("eu01"),
+				KeyRingId:     types.StringValue(keyRingId),
+				KeyId:         types.StringValue(keyId),
+				VersionNumber: types.Int64Value(2),
+				PublicKey:     types.StringValue("-----BEGIN PUBLIC KEY-----"),
+			},
+			isValid: true,
+		},
+		{
+			description: "symmetric key",
+			input: &kms.Version{
+				KeyId:  keyId,
+				Number: 1,
+			},
+			isValid: false,
+		},
+		{
+			description: "nil response",
+			input:       nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				ProjectId: types.StringValue(projectId),
+				KeyRingId: types.StringValue(keyRingId),
+				KeyId:     types.StringValue(keyId),
+			}
+			err := mapFields(tt.input, model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	kmsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &signEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &signEphemeralResource{}
+)
+
+// NewSignEphemeralResource is a helper function to simplify the provider implementation.
+func NewSignEphemeralResource() ephemeral.EphemeralResource {
+	return &signEphemeralResource{}
+}
+
+// signEphemeralResource is the ephemeral resource implementation.
+type signEphemeralResource struct {
+	client       *kms.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (e *signEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_sign"
+}
+
+// Configure adds the provider configured client to the resource.
+func (e *signEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	ephemeralProviderData, ok := conversion.ParseEphemeralProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	e.providerData = ephemeralProviderData.ProviderData
+
+	e.client = kmsUtils.ConfigureClient(ctx, &e.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "KMS client configured")
+}
+
+// ephemeralModel is the model for the ephemeral resource.
+type ephemeralModel struct {
+	ProjectId     types.String `tfsdk:"project_id"`
+	Region        types.String `tfsdk:"region"`
+	KeyRingId     types.String `tfsdk:"keyring_id"`
+	KeyId         types.String `tfsdk:"key_id"`
+	VersionNumber types.Int64  `tfsdk:"version_number"`
+	Data          types.String `tfsdk:"data"`
+	DataBase64    types.String `tfsdk:"data_base64"`
+	Signature     types.String `tfsdk:"signature"`
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *signEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Ephemeral resource that signs data with a KMS key. Works with keys of purpose `%s` (digital signature) and `%s` (HMAC). The signed data is never stored in the state. %s", kms.PURPOSE_ASYMMETRIC_SIGN_VERIFY, kms.PURPOSE_MESSAGE_AUTHENTICATION_CODE, core.ResourceRegionFallbackDocstring),
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the key is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: "The resource region. If not defined, the provider region is used.",
+			},
+			"keyring_id": schema.StringAttribute{
+				Description: "The ID of the associated keyring",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Description: "The ID of the key",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"version_number": schema.Int64Attribute{
+				Description: "The number of the key version used for signing. If not defined, the latest active version of the key is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"data": schema.StringAttribute{
+				Description: "The data to sign as string. Either `data` or `data_base64` must be set.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("data"), path.MatchRoot("data_base64")),
+				},
+			},
+			"data_base64": schema.StringAttribute{
+				Description: "The data to sign, encoded in base64. Use it for binary data.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"signature": schema.StringAttribute{
+				Description: "The signature of the data, encoded in base64.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Open signs the data and sets the result.
+func (e *signEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ephemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := e.providerData.GetRegionWithOverride(model.Region)
+	keyRingId := model.KeyRingId.ValueString()
+	keyId := model.KeyId.ValueString()
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "keyring_id", keyRingId)
+	ctx = tflog.SetField(ctx, "key_id", keyId)
+
+	payload, err := toSignPayload(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error signing data", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	versionNumber := model.VersionNumber.ValueInt64()
+	if model.VersionNumber.IsNull() || model.VersionNumber.IsUnknown() {
+		versionNumber, err = kmsUtils.LatestVersionNumber(ctx, e.client.DefaultAPI, projectId, region, keyRingId, keyId)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error signing data", fmt.Sprintf("Determining key version: %v", err))
+			return
+		}
+	}
+	ctx = tflog.SetField(ctx, "version_number", versionNumber)
+
+	signed, err := e.client.DefaultAPI.Sign(ctx, projectId, region, keyRingId, keyId, versionNumber).SignPayload(*payload).Execute()
+
+	ctx = core.LogResponse(ctx)
+
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error signing data", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	if signed == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error signing data", "API returned an empty response")
+		return
+	}
+
+	model.Region = types.StringValue(region)
+	model.VersionNumber = types.Int64Value(versionNumber)
+	model.Signature = types.StringValue(signed.Signature)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+	tflog.Info(ctx, "KMS data signed")
+}
+
+func toSignPayload(model *ephemeralModel) (*kms.SignPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	if !model.DataBase64.IsNull() {
+		_, err := base64.StdEncoding.DecodeString(model.DataBase64.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("data_base64 is not base64 encoded: %w", err)
+		}
+		return &kms.SignPayload{
+			Data: model.DataBase64.ValueString(),
+		}, nil
+	}
+
+	return &kms.SignPayload{
+		Data: base64.StdEncoding.EncodeToString([]byte(model.Data.ValueString())),
+	}, nil
+}
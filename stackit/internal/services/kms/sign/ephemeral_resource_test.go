@@ -0,0 +1,67 @@
+package kms
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+)
+
+func TestToSignPayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ephemeralModel
+		expected    *kms.SignPayload
+		isValid     bool
+	}{
+		{
+			description: "data",
+			input: &ephemeralModel{
+				Data:       types.StringValue("message"),
+				DataBase64: types.StringNull(),
+			},
+			expected: &kms.SignPayload{Data: "bWVzc2FnZQ=="},
+			isValid:  true,
+		},
+		{
+			description: "data_base64",
+			input: &ephemeralModel{
+				Data:       types.StringNull(),
+				DataBase64: types.StringValue("/w=="),
+			},
+			expected: &kms.SignPayload{Data: "/w=="},
+			isValid:  true,
+		},
+		{
+			description: "invalid data_base64",
+			input: &ephemeralModel{
+				Data:       types.StringNull(),
+				DataBase64: types.StringValue("not base64!"),
+			},
+			isValid: false,
+		},
+		{
+			description: "nil model",
+			input:       nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toSignPayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
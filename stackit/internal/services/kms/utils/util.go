@@ -28,3 +28,30 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 
 	return apiClient
 }
+
+// LatestVersionNumber returns the number of the latest active version of a key.
+// It is used when a cryptographic operation doesn't specify a key version.
+func LatestVersionNumber(ctx context.Context, client kms.DefaultAPI, projectId, region, keyRingId, keyId string) (int64, error) {
+	versions, err := client.ListVersions(ctx, projectId, region, keyRingId, keyId).Execute()
+	if err != nil {
+		return 0, fmt.Errorf("listing key versions: %w", err)
+	}
+	if versions == nil {
+		return 0, fmt.Errorf("API returned empty response")
+	}
+
+	var latest *kms.Version
+	for i := range versions.Versions {
+		version := &versions.Versions[i]
+		if version.State != kms.VERSIONSTATE_ACTIVE {
+			continue
+		}
+		if latest == nil || version.Number > latest.Number {
+			latest = version
+		}
+	}
+	if latest == nil {
+		return 0, fmt.Errorf("key %q has no active version", keyId)
+	}
+	return latest.Number, nil
+}
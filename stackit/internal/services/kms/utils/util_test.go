@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	kms "github.com/stackitcloud/stackit-sdk-go/services/kms/v1api"
+)
+
+func TestLatestVersionNumber(t *testing.T) {
+	tests := []struct {
+		description  string
+		mockResponse *kms.VersionList
+		mockError    error
+		expected     int64
+		expectError  bool
+	}{
+		{
+			description: "latest active version",
+			mockResponse: &kms.VersionList{
+				Versions: []kms.Version{
+					{Number: 1, State: kms.VERSIONSTATE_ACTIVE},
+					{Number: 3, State: kms.VERSIONSTATE_DISABLED},
+					{Number: 2, State: kms.VERSIONSTATE_ACTIVE},
+				},
+			},
+			expected: 2,
+		},
+		{
+			description: "no active version",
+			mockResponse: &kms.VersionList{
+				Versions: []kms.Version{
+					{Number: 1, State: kms.VERSIONSTATE_DESTROYED},
+				},
+			},
+			expectError: true,
+		},
+		{
+			description: "empty response",
+			expectError: true,
+		},
+		{
+			description: "api error",
+			mockError:   fmt.Errorf("api error"),
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			listVersionsFn := func(_ kms.ApiListVersionsRequest) (*kms.VersionList, error) {
+				return tt.mockResponse, tt.mockError
+			}
+			client := &kms.DefaultAPIServiceMock{
+				ListVersionsExecuteMock: &listVersionsFn,
+			}
+
+			versionNumber, err := LatestVersionNumber(context.Background(), client, "project", "eu01", "keyring", "key")
+			if (err != nil) != tt.expectError {
+				t.Fatalf("LatestVersionNumber() error = %v, expectError %v", err, tt.expectError)
+			}
+			if !tt.expectError && versionNumber != tt.expected {
+				t.Fatalf("LatestVersionNumber() = %d, expected %d", versionNumber, tt.expected)
+			}
+		})
+	}
+}
@@ -363,7 +363,7 @@ func (r *loadBalancerResource) Schema(_ context.Context, _ resource.SchemaReques
 		"unhealthy_threshold":                   "Unhealthy threshold of the health checking.",
 		"target_pools.name":                     "Target pool name.",
 		"target_port":                           "Identical port number where each target listens for traffic.",
-		"targets":                               "List of all targets which will be used in the pool. Limited to 1000.",
+		"targets":                               "List of all targets which will be used in the pool. Limited to 1000. If not set, the targets of the pool are not managed by this resource, e.g. to manage them with `stackit_loadbalancer_target` resources.",
 		"targets.display_name":                  "Target display name",
 		"ip":                                    "Target IP",
 		"region":                                "The resource region. If not defined, the provider region is used.",
@@ -698,7 +698,7 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 						},
 						"targets": schema.ListNestedAttribute{
 							Description: descriptions["targets"],
-							Required:    true,
+							Optional:    true,
 							Validators: []validator.List{
 								listvalidator.SizeBetween(1, 1000),
 							},
@@ -902,6 +902,20 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	if externalTargetPools := externalTargetPoolNames(payload); len(externalTargetPools) > 0 {
+		// the targets of these pools are managed outside of this resource and must be kept. The update is sent with the
+		// version that was read, so concurrent changes of the targets are rejected. The lock avoids such conflicts with the
+		// target resources of this provider process.
+		unlock := loadbalancerUtils.LockTargetPools(projectId, region, name, externalTargetPools...)
+		defer unlock()
+		currentLoadBalancer, err := r.client.DefaultAPI.GetLoadBalancer(ctx, projectId, region, name).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating load balancer", fmt.Sprintf("Reading current targets: %v", err))
+			return
+		}
+		keepExternalTargets(payload, currentLoadBalancer)
+	}
+
 	loadBalancer, err := r.client.DefaultAPI.UpdateLoadBalancer(ctx, projectId, region, name).UpdateLoadBalancerPayload(*payload).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating load balancer", fmt.Sprintf("Calling API: %v", utils.PrettyApiErr(ctx, &resp.Diagnostics, err)))
@@ -1343,6 +1357,41 @@ func toActiveHealthCheckPayload(ctx context.Context, tp *targetPool) (*loadbalan
 	}, nil
 }
 
+// externalTargetPoolNames returns the names of the target pools of the payload without targets, their targets are
+// managed outside of the resource.
+func externalTargetPoolNames(payload *loadbalancer.UpdateLoadBalancerPayload) []string {
+	names := []string{}
+	if payload == nil {
+		return names
+	}
+	for i := range payload.TargetPools {
+		if payload.TargetPools[i].Targets == nil {
+			names = append(names, payload.TargetPools[i].GetName())
+		}
+	}
+	return names
+}
+
+// keepExternalTargets copies the current targets into all target pools of the payload without targets. The payload
+// gets the version of the current load balancer, so the update is rejected if the targets were changed since they were read.
+func keepExternalTargets(payload *loadbalancer.UpdateLoadBalancerPayload, current *loadbalancer.LoadBalancer) {
+	if payload == nil || current == nil {
+		return
+	}
+	payload.Version = current.Version
+	for i := range payload.TargetPools {
+		if payload.TargetPools[i].Targets != nil {
+			continue
+		}
+		for _, currentTargetPool := range current.TargetPools {
+			if currentTargetPool.GetName() == payload.TargetPools[i].GetName() {
+				payload.TargetPools[i].Targets = currentTargetPool.Targets
+				break
+			}
+		}
+	}
+}
+
 func toTargetsPayload(ctx context.Context, tp *targetPool) ([]loadbalancer.Target, error) {
 	if utils.IsUndefined(tp.Targets) {
 		return nil, nil
@@ -1419,7 +1468,7 @@ func mapFields(ctx context.Context, lb *loadbalancer.LoadBalancer, m *Model, reg
 	if err != nil {
 		return fmt.Errorf("mapping options: %w", err)
 	}
-	err = mapTargetPools(lb, m)
+	err = mapTargetPools(ctx, lb, m)
 	if err != nil {
 		return fmt.Errorf("mapping target pools: %w", err)
 	}
@@ -1676,12 +1725,27 @@ func mapACL(accessControlResp *loadbalancer.LoadbalancerOptionAccessControl, o m
 	return nil
 }
 
-func mapTargetPools(loadBalancerResp *loadbalancer.LoadBalancer, m *Model) error {
+func mapTargetPools(ctx context.Context, loadBalancerResp *loadbalancer.LoadBalancer, m *Model) error {
 	if loadBalancerResp.TargetPools == nil {
 		m.TargetPools = types.ListNull(types.ObjectType{AttrTypes: targetPoolTypes})
 		return nil
 	}
 
+	// targets of pools without targets in the model are managed outside of this resource
+	externalTargetPools := map[string]bool{}
+	if !m.TargetPools.IsNull() && !m.TargetPools.IsUnknown() {
+		targetPoolsModel := []targetPool{}
+		diags := m.TargetPools.ElementsAs(ctx, &targetPoolsModel, false)
+		if diags.HasError() {
+			return fmt.Errorf("unpacking target pools from model: %w", core.DiagsToError(diags))
+		}
+		for i := range targetPoolsModel {
+			if targetPoolsModel[i].Targets.IsNull() {
+				externalTargetPools[targetPoolsModel[i].Name.ValueString()] = true
+			}
+		}
+	}
+
 	targetPoolsList := []attr.Value{}
 	for i, targetPoolResp := range loadBalancerResp.TargetPools {
 		targetPoolMap := map[string]attr.Value{
@@ -1694,9 +1758,13 @@ func mapTargetPools(loadBalancerResp *loadbalancer.LoadBalancer, m *Model) error
 			return fmt.Errorf("mapping index %d, field ActiveHealthCheck: %w", i, err)
 		}
 
-		err = mapTargets(targetPoolResp.Targets, targetPoolMap)
-		if err != nil {
-			return fmt.Errorf("mapping index %d, field Targets: %w", i, err)
+		if externalTargetPools[targetPoolResp.GetName()] {
+			targetPoolMap["targets"] = types.ListNull(types.ObjectType{AttrTypes: targetTypes})
+		} else {
+			err = mapTargets(targetPoolResp.Targets, targetPoolMap)
+			if err != nil {
+				return fmt.Errorf("mapping index %d, field Targets: %w", i, err)
+			}
 		}
 
 		err = mapSessionPersistence(targetPoolResp.SessionPersistence, targetPoolMap)
@@ -1310,3 +1310,132 @@ func Test_mapSessionPersistence(t *testing.T) {
 		})
 	}
 }
+
+func Test_keepExternalTargets(t *testing.T) {
+	current := &loadbalancer.LoadBalancer{
+		Version: new("2"),
+		TargetPools: []loadbalancer.TargetPool{
+			{
+				Name:    new("external"),
+				Targets: []loadbalancer.Target{{DisplayName: new("server-1"), Ip: new("10.0.0.1")}},
+			},
+			{
+				Name:    new("inline"),
+				Targets: []loadbalancer.Target{{DisplayName: new("server-2"), Ip: new("10.0.0.2")}},
+			},
+		},
+	}
+	payload := &loadbalancer.UpdateLoadBalancerPayload{
+		Version: new("1"),
+		TargetPools: []loadbalancer.TargetPool{
+			{
+				Name: new("external"),
+			},
+			{
+				Name:    new("inline"),
+				Targets: []loadbalancer.Target{{DisplayName: new("server-3"), Ip: new("10.0.0.3")}},
+			},
+			{
+				Name: new("new"),
+			},
+		},
+	}
+	expected := &loadbalancer.UpdateLoadBalancerPayload{
+		Version: new("2"),
+		TargetPools: []loadbalancer.TargetPool{
+			{
+				Name:    new("external"),
+				Targets: []loadbalancer.Target{{DisplayName: new("server-1"), Ip: new("10.0.0.1")}},
+			},
+			{
+				Name:    new("inline"),
+				Targets: []loadbalancer.Target{{DisplayName: new("server-3"), Ip: new("10.0.0.3")}},
+			},
+			{
+				Name: new("new"),
+			},
+		},
+	}
+
+	if diff := cmp.Diff([]string{"external", "new"}, externalTargetPoolNames(payload)); diff != "" {
+		t.Fatalf("External target pools do not match: %s", diff)
+	}
+	keepExternalTargets(payload, current)
+	if diff := cmp.Diff(expected, payload); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func Test_mapTargetPools_externalTargets(t *testing.T) {
+	targetPool := func(name string, targets types.List) attr.Value {
+		return types.ObjectValueMust(targetPoolTypes, map[string]attr.Value{
+			"active_health_check": types.ObjectNull(activeHealthCheckTypes),
+			"name":                types.StringValue(name),
+			"target_port":         types.Int32Value(80),
+			"targets":             targets,
+			"session_persistence": types.ObjectValueMust(sessionPersistenceTypes, map[string]attr.Value{
+				"use_source_ip_address": types.BoolValue(false),
+			}),
+		})
+	}
+	targets := types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
+		types.ObjectValueMust(targetTypes, map[string]attr.Value{
+			"display_name": types.StringValue("server-1"),
+			"ip":           types.StringValue("10.0.0.1"),
+		}),
+	})
+	nullTargets := types.ListNull(types.ObjectType{AttrTypes: targetTypes})
+
+	resp := &loadbalancer.LoadBalancer{
+		TargetPools: []loadbalancer.TargetPool{
+			{
+				Name:       new("external"),
+				TargetPort: new(int32(80)),
+				Targets:    []loadbalancer.Target{{DisplayName: new("server-1"), Ip: new("10.0.0.1")}},
+			},
+			{
+				Name:       new("inline"),
+				TargetPort: new(int32(80)),
+				Targets:    []loadbalancer.Target{{DisplayName: new("server-1"), Ip: new("10.0.0.1")}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		targetPools types.List
+		expected    types.List
+	}{
+		{
+			name: "targets of pool without targets in model are not mapped",
+			targetPools: types.ListValueMust(types.ObjectType{AttrTypes: targetPoolTypes}, []attr.Value{
+				targetPool("external", nullTargets),
+				targetPool("inline", targets),
+			}),
+			expected: types.ListValueMust(types.ObjectType{AttrTypes: targetPoolTypes}, []attr.Value{
+				targetPool("external", nullTargets),
+				targetPool("inline", targets),
+			}),
+		},
+		{
+			name:        "all targets are mapped without model, e.g. on import",
+			targetPools: types.ListNull(types.ObjectType{AttrTypes: targetPoolTypes}),
+			expected: types.ListValueMust(types.ObjectType{AttrTypes: targetPoolTypes}, []attr.Value{
+				targetPool("external", targets),
+				targetPool("inline", targets),
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &Model{TargetPools: tt.targetPools}
+			err := mapTargetPools(context.Background(), resp, model)
+			if err != nil {
+				t.Fatalf("mapTargetPools() failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, model.TargetPools); diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
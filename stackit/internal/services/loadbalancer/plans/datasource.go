@@ -0,0 +1,44 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	loadbalancer "github.com/stackitcloud/stackit-sdk-go/services/loadbalancer/v2api"
+
+	loadbalancerUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/loadbalancing"
+)
+
+// NewPlansDataSource is a helper function to simplify the provider implementation.
+func NewPlansDataSource() datasource.DataSource {
+	return &loadbalancing.PlansDataSource[loadbalancer.APIClient]{
+		Product:          loadbalancerUtils.Product,
+		ApiClientFactory: loadbalancerUtils.ConfigureClient,
+		ExecListPlans: func(ctx context.Context, client *loadbalancer.APIClient, region string) ([]loadbalancing.Plan, error) {
+			plansResp, err := client.DefaultAPI.ListPlans(ctx, region).Execute()
+			if err != nil {
+				return nil, err
+			}
+			return toPlans(plansResp)
+		},
+	}
+}
+
+func toPlans(plansResp *loadbalancer.ListPlansResponse) ([]loadbalancing.Plan, error) {
+	if plansResp == nil {
+		return nil, fmt.Errorf("response input is nil")
+	}
+	plans := []loadbalancing.Plan{}
+	for i := range plansResp.ValidPlans {
+		plans = append(plans, loadbalancing.Plan{
+			PlanId:         plansResp.ValidPlans[i].PlanId,
+			Name:           plansResp.ValidPlans[i].Name,
+			Description:    plansResp.ValidPlans[i].Description,
+			FlavorName:     plansResp.ValidPlans[i].FlavorName,
+			MaxConnections: plansResp.ValidPlans[i].MaxConnections,
+		})
+	}
+	return plans, nil
+}
@@ -0,0 +1,79 @@
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	loadbalancer "github.com/stackitcloud/stackit-sdk-go/services/loadbalancer/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/loadbalancing"
+)
+
+func TestToPlans(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *loadbalancer.ListPlansResponse
+		expected    []loadbalancing.Plan
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&loadbalancer.ListPlansResponse{},
+			[]loadbalancing.Plan{},
+			true,
+		},
+		{
+			"simple_values",
+			&loadbalancer.ListPlansResponse{
+				ValidPlans: []loadbalancer.PlanDetails{
+					{
+						PlanId:         new("p10"),
+						Name:           new("Network-Loadbalancer-Starter"),
+						Description:    new("starter"),
+						FlavorName:     new("c1.2"),
+						MaxConnections: new(int32(10000)),
+					},
+					{
+						PlanId: new("p50"),
+					},
+				},
+			},
+			[]loadbalancing.Plan{
+				{
+					PlanId:         new("p10"),
+					Name:           new("Network-Loadbalancer-Starter"),
+					Description:    new("starter"),
+					FlavorName:     new("c1.2"),
+					MaxConnections: new(int32(10000)),
+				},
+				{
+					PlanId: new("p50"),
+				},
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toPlans(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
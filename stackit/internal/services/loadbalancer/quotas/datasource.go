@@ -0,0 +1,39 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	loadbalancer "github.com/stackitcloud/stackit-sdk-go/services/loadbalancer/v2api"
+
+	loadbalancerUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/loadbalancing"
+)
+
+// NewQuotasDataSource is a helper function to simplify the provider implementation.
+func NewQuotasDataSource() datasource.DataSource {
+	return &loadbalancing.QuotasDataSource[loadbalancer.APIClient]{
+		Product:          loadbalancerUtils.Product,
+		ApiClientFactory: loadbalancerUtils.ConfigureClient,
+		ExecGetQuota: func(ctx context.Context, client *loadbalancer.APIClient, projectId, region string) (*loadbalancing.Quota, error) {
+			quotaResp, err := client.DefaultAPI.GetQuota(ctx, projectId, region).Execute()
+			if err != nil {
+				return nil, err
+			}
+			return toQuota(quotaResp)
+		},
+	}
+}
+
+func toQuota(quotaResp *loadbalancer.GetQuotaResponse) (*loadbalancing.Quota, error) {
+	if quotaResp == nil {
+		return nil, fmt.Errorf("response input is nil")
+	}
+	return &loadbalancing.Quota{
+		MaxLoadBalancers:  quotaResp.MaxLoadBalancers,
+		UsedLoadBalancers: quotaResp.UsedLoadBalancers,
+		MaxCredentials:    quotaResp.MaxCredentials,
+		UsedCredentials:   quotaResp.UsedCredentials,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	loadbalancer "github.com/stackitcloud/stackit-sdk-go/services/loadbalancer/v2api"
+
+	loadbalancerUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/targetpool"
+)
+
+// NewTargetResource is a helper function to simplify the provider implementation.
+func NewTargetResource() resource.Resource {
+	return &targetpool.TargetResource[loadbalancer.APIClient]{
+		TypeName:             "loadbalancer_target",
+		ProductName:          "load balancer",
+		LoadBalancerResource: "stackit_loadbalancer",
+		DisplayNameValidator: stringvalidator.RegexMatches(
+			regexp.MustCompile(`^[0-9a-zA-Z](?:(?:[0-9a-zA-Z]|-){0,61}[0-9a-zA-Z])?$`),
+			"1-63 characters [0-9], [a-z] & [A-Z] also [-] but not at the beginning or end",
+		),
+		ApiClientFactory: loadbalancerUtils.ConfigureClient,
+		LockTargetPool:   loadbalancerUtils.LockTargetPools,
+		NewTargetPoolAPI: func(client *loadbalancer.APIClient, projectId, region, loadBalancerName, targetPoolName string) targetpool.TargetPoolAPI {
+			return &targetPoolAPI{
+				client:           client.DefaultAPI,
+				projectId:        projectId,
+				region:           region,
+				loadBalancerName: loadBalancerName,
+				targetPoolName:   targetPoolName,
+			}
+		},
+	}
+}
+
+// targetPoolAPI reads and writes the targets of a target pool with the load balancer API.
+type targetPoolAPI struct {
+	client           loadbalancer.DefaultAPI
+	projectId        string
+	region           string
+	loadBalancerName string
+	targetPoolName   string
+
+	// loadBalancer is the load balancer read last, its other settings and its version are kept when the targets are written
+	loadBalancer *loadbalancer.LoadBalancer
+}
+
+func (a *targetPoolAPI) GetTargets(ctx context.Context) ([]targetpool.Target, error) {
+	lbResp, err := a.client.GetLoadBalancer(ctx, a.projectId, a.region, a.loadBalancerName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("reading load balancer: %w", err)
+	}
+	targetPool := findTargetPool(lbResp, a.targetPoolName)
+	if targetPool == nil {
+		return nil, fmt.Errorf("load balancer %q: %w: %q", a.loadBalancerName, targetpool.ErrTargetPoolNotFound, a.targetPoolName)
+	}
+	a.loadBalancer = lbResp
+	return toTargets(targetPool.Targets), nil
+}
+
+// SetTargets updates the whole load balancer instead of only the target pool, since only the load balancer update
+// is sent with a version, which makes the API reject it if the load balancer was changed concurrently.
+func (a *targetPoolAPI) SetTargets(ctx context.Context, targets []targetpool.Target) error {
+	if a.loadBalancer == nil {
+		return fmt.Errorf("load balancer %q wasn't read before it is updated", a.loadBalancerName)
+	}
+	payload := toUpdateLoadBalancerPayload(a.loadBalancer, a.targetPoolName, toTargetsPayload(targets))
+	_, err := a.client.UpdateLoadBalancer(ctx, a.projectId, a.region, a.loadBalancerName).UpdateLoadBalancerPayload(payload).Execute()
+	return err
+}
+
+func findTargetPool(lb *loadbalancer.LoadBalancer, targetPoolName string) *loadbalancer.TargetPool {
+	if lb == nil {
+		return nil
+	}
+	for i := range lb.TargetPools {
+		if lb.TargetPools[i].GetName() == targetPoolName {
+			return &lb.TargetPools[i]
+		}
+	}
+	return nil
+}
+
+// toUpdateLoadBalancerPayload keeps all settings and the version of the load balancer and only replaces the targets
+// of the given target pool.
+func toUpdateLoadBalancerPayload(lb *loadbalancer.LoadBalancer, targetPoolName string, targets []loadbalancer.Target) loadbalancer.UpdateLoadBalancerPayload {
+	targetPools := slices.Clone(lb.TargetPools)
+	for i := range targetPools {
+		if targetPools[i].GetName() == targetPoolName {
+			targetPools[i].Targets = targets
+		}
+	}
+	return loadbalancer.UpdateLoadBalancerPayload{
+		DisableTargetSecurityGroupAssignment: lb.DisableTargetSecurityGroupAssignment,
+		ExternalAddress:                      lb.ExternalAddress,
+		Labels:                               lb.Labels,
+		Listeners:                            lb.Listeners,
+		Name:                                 lb.Name,
+		Networks:                             lb.Networks,
+		Options:                              lb.Options,
+		PlanId:                               lb.PlanId,
+		TargetPools:                          targetPools,
+		Version:                              lb.Version,
+	}
+}
+
+func toTargets(targets []loadbalancer.Target) []targetpool.Target {
+	result := []targetpool.Target{}
+	for i := range targets {
+		result = append(result, targetpool.Target{
+			Ip:          targets[i].GetIp(),
+			DisplayName: targets[i].DisplayName,
+		})
+	}
+	return result
+}
+
+func toTargetsPayload(targets []targetpool.Target) []loadbalancer.Target {
+	result := []loadbalancer.Target{}
+	for i := range targets {
+		result = append(result, loadbalancer.Target{
+			DisplayName: targets[i].DisplayName,
+			Ip:          new(targets[i].Ip),
+		})
+	}
+	return result
+}
@@ -0,0 +1,201 @@
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	loadbalancer "github.com/stackitcloud/stackit-sdk-go/services/loadbalancer/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/targetpool"
+)
+
+func TestTargetPoolAPI(t *testing.T) {
+	lb := &loadbalancer.LoadBalancer{
+		Version: new("1"),
+		TargetPools: []loadbalancer.TargetPool{
+			{Name: new("other-pool")},
+			{
+				Name:       new("pool"),
+				TargetPort: new(int32(80)),
+				Targets:    []loadbalancer.Target{{DisplayName: new("existing"), Ip: new("10.0.0.1")}},
+			},
+		},
+	}
+
+	tests := []struct {
+		description     string
+		targetPoolName  string
+		getResponse     *loadbalancer.LoadBalancer
+		getError        error
+		updateError     error
+		expectedTargets []targetpool.Target
+		expectedErr     error
+		isValid         bool
+	}{
+		{
+			description:     "ok",
+			targetPoolName:  "pool",
+			getResponse:     lb,
+			expectedTargets: []targetpool.Target{{DisplayName: new("existing"), Ip: "10.0.0.1"}},
+			isValid:         true,
+		},
+		{
+			description:     "version conflict",
+			targetPoolName:  "pool",
+			getResponse:     lb,
+			updateError:     &oapierror.GenericOpenAPIError{StatusCode: http.StatusConflict},
+			expectedTargets: []targetpool.Target{{DisplayName: new("existing"), Ip: "10.0.0.1"}},
+			isValid:         true,
+		},
+		{
+			description:    "target pool missing",
+			targetPoolName: "missing-pool",
+			getResponse:    lb,
+			expectedErr:    targetpool.ErrTargetPoolNotFound,
+			isValid:        false,
+		},
+		{
+			description:    "get error",
+			targetPoolName: "pool",
+			getError:       &oapierror.GenericOpenAPIError{StatusCode: http.StatusNotFound},
+			isValid:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			getFn := func(_ loadbalancer.ApiGetLoadBalancerRequest) (*loadbalancer.LoadBalancer, error) {
+				return tt.getResponse, tt.getError
+			}
+			updates := 0
+			updateFn := func(_ loadbalancer.ApiUpdateLoadBalancerRequest) (*loadbalancer.LoadBalancer, error) {
+				updates++
+				return nil, tt.updateError
+			}
+			client := &loadbalancer.DefaultAPIServiceMock{
+				GetLoadBalancerExecuteMock:    &getFn,
+				UpdateLoadBalancerExecuteMock: &updateFn,
+			}
+			api := &targetPoolAPI{
+				client:           client,
+				projectId:        "pid",
+				region:           "eu01",
+				loadBalancerName: "lb",
+				targetPoolName:   tt.targetPoolName,
+			}
+
+			targets, err := api.GetTargets(context.Background())
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Expected error %v, got %v", tt.expectedErr, err)
+			}
+			if !tt.isValid {
+				return
+			}
+			diff := cmp.Diff(targets, tt.expectedTargets)
+			if diff != "" {
+				t.Fatalf("Targets do not match: %s", diff)
+			}
+
+			err = api.SetTargets(context.Background(), targets)
+			if !errors.Is(err, tt.updateError) {
+				t.Fatalf("Expected error %v, got %v", tt.updateError, err)
+			}
+			if updates != 1 {
+				t.Fatalf("Expected 1 update, got %d", updates)
+			}
+		})
+	}
+}
+
+func TestToUpdateLoadBalancerPayload(t *testing.T) {
+	lb := &loadbalancer.LoadBalancer{
+		DisableTargetSecurityGroupAssignment: new(true),
+		Errors:                               []loadbalancer.LoadBalancerError{{Description: new("error")}},
+		ExternalAddress:                      new("1.2.3.4"),
+		Labels:                               &map[string]string{"key": "value"},
+		Listeners:                            []loadbalancer.Listener{{DisplayName: new("listener"), TargetPool: new("pool")}},
+		Name:                                 new("lb"),
+		Networks:                             []loadbalancer.Network{{NetworkId: new("nid"), Role: new(loadbalancer.NETWORKROLE_ROLE_LISTENERS_AND_TARGETS)}},
+		Options:                              &loadbalancer.LoadBalancerOptions{PrivateNetworkOnly: new(false)},
+		PlanId:                               new("p10"),
+		PrivateAddress:                       new("10.1.0.1"),
+		TargetPools: []loadbalancer.TargetPool{
+			{
+				Name:    new("other-pool"),
+				Targets: []loadbalancer.Target{{Ip: new("10.0.0.1")}},
+			},
+			{
+				ActiveHealthCheck:  &loadbalancer.ActiveHealthCheck{HealthyThreshold: new(int32(3))},
+				Name:               new("pool"),
+				SessionPersistence: &loadbalancer.SessionPersistence{UseSourceIpAddress: new(true)},
+				TargetPort:         new(int32(80)),
+				Targets:            []loadbalancer.Target{{Ip: new("10.0.0.1")}},
+			},
+		},
+		Version: new("2"),
+	}
+	targets := []loadbalancer.Target{{Ip: new("10.0.0.2")}}
+	expected := loadbalancer.UpdateLoadBalancerPayload{
+		DisableTargetSecurityGroupAssignment: new(true),
+		ExternalAddress:                      new("1.2.3.4"),
+		Labels:                               &map[string]string{"key": "value"},
+		Listeners:                            []loadbalancer.Listener{{DisplayName: new("listener"), TargetPool: new("pool")}},
+		Name:                                 new("lb"),
+		Networks:                             []loadbalancer.Network{{NetworkId: new("nid"), Role: new(loadbalancer.NETWORKROLE_ROLE_LISTENERS_AND_TARGETS)}},
+		Options:                              &loadbalancer.LoadBalancerOptions{PrivateNetworkOnly: new(false)},
+		PlanId:                               new("p10"),
+		TargetPools: []loadbalancer.TargetPool{
+			{
+				Name:    new("other-pool"),
+				Targets: []loadbalancer.Target{{Ip: new("10.0.0.1")}},
+			},
+			{
+				ActiveHealthCheck:  &loadbalancer.ActiveHealthCheck{HealthyThreshold: new(int32(3))},
+				Name:               new("pool"),
+				SessionPersistence: &loadbalancer.SessionPersistence{UseSourceIpAddress: new(true)},
+				TargetPort:         new(int32(80)),
+				Targets:            []loadbalancer.Target{{Ip: new("10.0.0.2")}},
+			},
+		},
+		Version: new("2"),
+	}
+
+	output := toUpdateLoadBalancerPayload(lb, "pool", targets)
+	diff := cmp.Diff(output, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+	if lb.TargetPools[1].Targets[0].GetIp() != "10.0.0.1" {
+		t.Fatalf("Load balancer read must not be changed")
+	}
+}
+
+func TestToTargetsPayload(t *testing.T) {
+	targets := []targetpool.Target{
+		{DisplayName: new("a"), Ip: "10.0.0.1"},
+		{Ip: "10.0.0.2"},
+	}
+	expected := []loadbalancer.Target{
+		{DisplayName: new("a"), Ip: new("10.0.0.1")},
+		{Ip: new("10.0.0.2")},
+	}
+
+	output := toTargetsPayload(targets)
+	diff := cmp.Diff(output, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+	diff = cmp.Diff(toTargets(output), targets)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
@@ -11,8 +11,16 @@ import (
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/loadbalancing"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils/targetpool"
 )
 
+// Product describes the load balancer in the data sources shared with the other load balancer products.
+var Product = loadbalancing.Product{
+	Name:     "load balancer",
+	TypeName: "loadbalancer",
+}
+
 func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags *diag.Diagnostics) *loadbalancer.APIClient {
 	apiClientConfigOptions := []config.ConfigurationOption{
 		config.WithCustomAuth(providerData.RoundTripper),
@@ -29,3 +37,13 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 
 	return apiClient
 }
+
+// targetPoolLocks serializes the changes of the targets of target pools within this provider process.
+// The changes of other Terraform runs or API clients aren't covered.
+var targetPoolLocks targetpool.Locks
+
+// LockTargetPools acquires the locks for target pools of a load balancer.
+// It returns an unlock function that must be deferred.
+func LockTargetPools(projectId, region, loadBalancerName string, targetPoolNames ...string) func() {
+	return targetPoolLocks.Lock(projectId, region, loadBalancerName, targetPoolNames...)
+}
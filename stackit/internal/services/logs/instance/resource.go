@@ -47,13 +47,17 @@ var schemaDescriptions = map[string]string{
 	"ingest_url":      "The logs instance's ingest logs URL",
 	"query_range_url": "The Logs instance's query range URL",
 	"query_url":       "The Logs instance's query URL",
-	"retention_days":  "The log retention time in days",
+	"retention_days":  "The log retention time in days. It applies to all log streams of the instance",
 	"status": fmt.Sprintf(
 		"The status of the Logs instance. %s",
 		tfutils.FormatPossibleValues("active", "deleting", "reconciling"),
 	),
 }
 
+// alertRulesDocstring points to the resource for log alerts. There is no resource for alert rules of Logs instances,
+// since the Logs API provides no ruler endpoint.
+const alertRulesDocstring = "Log alerts can be defined with `stackit_observability_logalertgroup`."
+
 type Model struct {
 	ID            types.String `tfsdk:"id"` // Required by Terraform
 	InstanceID    types.String `tfsdk:"instance_id"`
@@ -129,7 +133,7 @@ func (r *logsInstanceResource) Metadata(_ context.Context, req resource.Metadata
 
 func (r *logsInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: fmt.Sprintf("Logs instance resource schema. %s", core.ResourceRegionFallbackDocstring),
+		Description: fmt.Sprintf("Logs instance resource schema. %s %s", core.ResourceRegionFallbackDocstring, alertRulesDocstring),
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: schemaDescriptions["id"],
@@ -206,6 +210,7 @@ func (r *logsInstanceResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: schemaDescriptions["query_url"],
 				Computed:    true,
 			},
+			// the Logs API doesn't support a retention per log stream
 			"retention_days": schema.Int32Attribute{
 				Description: schemaDescriptions["retention_days"],
 				Required:    true,
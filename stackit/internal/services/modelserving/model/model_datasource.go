@@ -0,0 +1,184 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	modelserving "github.com/stackitcloud/stackit-sdk-go/services/modelserving/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	modelservingUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/modelserving/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &modelDataSource{}
+	_ datasource.DataSourceWithConfigure = &modelDataSource{}
+)
+
+type modelDataSourceModel struct {
+	Id            types.String `tfsdk:"id"` // needed by TF
+	Region        types.String `tfsdk:"region"`
+	ModelId       types.String `tfsdk:"model_id"`
+	Name          types.String `tfsdk:"name"`
+	DisplayedName types.String `tfsdk:"displayed_name"`
+	Description   types.String `tfsdk:"description"`
+	Type          types.String `tfsdk:"type"`
+	Category      types.String `tfsdk:"category"`
+	ContextLength types.Int64  `tfsdk:"context_length"`
+	Url           types.String `tfsdk:"url"`
+	Deprecated    types.Bool   `tfsdk:"deprecated"`
+}
+
+// NewModelDataSource is a helper function to simplify the provider implementation.
+func NewModelDataSource() datasource.DataSource {
+	return &modelDataSource{}
+}
+
+// modelDataSource is the data source implementation.
+type modelDataSource struct {
+	client       *modelserving.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (d *modelDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_modelserving_model"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *modelDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := modelservingUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "Model-Serving client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *modelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := modelItemAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "Terraform's internal data source ID. It is structured as \"`region`,`model_id`\".",
+		Computed:    true,
+	}
+	attributes["region"] = schema.StringAttribute{
+		Description: descriptions["region"],
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["model_id"] = schema.StringAttribute{
+		Description: descriptions["model_id"] + " Either `model_id` or `name` must be set.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			validate.NoSeparator(),
+			stringvalidator.ExactlyOneOf(path.MatchRoot("model_id"), path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: descriptions["name"] + " Either `model_id` or `name` must be set.",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "AI model serving model data source schema. Looks up a shared model by its ID or name.",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *modelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model modelDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "model_id", model.ModelId.ValueString())
+	ctx = tflog.SetField(ctx, "name", model.Name.ValueString())
+
+	modelsResp, err := d.client.DefaultAPI.ListModels(ctx, region).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading model", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapModelFields(modelsResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading model", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Model-Serving model read")
+}
+
+// mapModelFields looks up the model matching the configured ID or name and maps it to the data source model.
+func mapModelFields(modelsResp *modelserving.ListModelsResponse, model *modelDataSourceModel, region string) error {
+	if modelsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var found *modelserving.Model
+	for i := range modelsResp.Models {
+		m := &modelsResp.Models[i]
+		if !model.ModelId.IsNull() && m.Id == model.ModelId.ValueString() ||
+			!model.Name.IsNull() && m.Name == model.Name.ValueString() {
+			found = m
+			break
+		}
+	}
+	if found == nil {
+		if !model.ModelId.IsNull() {
+			return fmt.Errorf("model with ID %q not found in region %q", model.ModelId.ValueString(), region)
+		}
+		return fmt.Errorf("model with name %q not found in region %q", model.Name.ValueString(), region)
+	}
+
+	item := toModelItem(found)
+	model.Id = utils.BuildInternalTerraformId(region, found.Id)
+	model.Region = types.StringValue(region)
+	model.ModelId = item.ModelId
+	model.Name = item.Name
+	model.DisplayedName = item.DisplayedName
+	model.Description = item.Description
+	model.Type = item.Type
+	model.Category = item.Category
+	model.ContextLength = item.ContextLength
+	model.Url = item.Url
+	model.Deprecated = item.Deprecated
+	return nil
+}
@@ -0,0 +1,96 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelserving "github.com/stackitcloud/stackit-sdk-go/services/modelserving/v1api"
+)
+
+func TestMapModelFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *modelserving.ListModelsResponse
+		state       modelDataSourceModel
+		expected    modelDataSourceModel
+		isValid     bool
+	}{
+		{
+			description: "by_id",
+			input:       fixtureModels(),
+			state: modelDataSourceModel{
+				ModelId: types.StringValue("mid-1"),
+			},
+			expected: modelDataSourceModel{
+				Id:            types.StringValue(testRegion + ",mid-1"),
+				Region:        types.StringValue(testRegion),
+				ModelId:       types.StringValue("mid-1"),
+				Name:          types.StringValue("cortecs/Llama-3.3-70B-Instruct-FP8-Dynamic"),
+				DisplayedName: types.StringValue("Llama 3.3 70B"),
+				Description:   types.StringValue("chat model"),
+				Type:          types.StringValue("chat"),
+				Category:      types.StringValue("plus"),
+				ContextLength: types.Int64Value(128000),
+				Url:           types.StringValue("https://llama-3-3-70b.example.com/v1"),
+				Deprecated:    types.BoolValue(true),
+			},
+			isValid: true,
+		},
+		{
+			description: "by_name",
+			input:       fixtureModels(),
+			state: modelDataSourceModel{
+				Name: types.StringValue("intfloat/e5-mistral-7b-instruct"),
+			},
+			expected: modelDataSourceModel{
+				Id:            types.StringValue(testRegion + ",mid-3"),
+				Region:        types.StringValue(testRegion),
+				ModelId:       types.StringValue("mid-3"),
+				Name:          types.StringValue("intfloat/e5-mistral-7b-instruct"),
+				DisplayedName: types.StringValue("E5 Mistral 7B"),
+				Description:   types.StringValue("embedding model"),
+				Type:          types.StringValue("embedding"),
+				Category:      types.StringValue("standard"),
+				ContextLength: types.Int64Value(4096),
+				Url:           types.StringValue("https://e5-mistral-7b.example.com/v1"),
+				Deprecated:    types.BoolValue(false),
+			},
+			isValid: true,
+		},
+		{
+			description: "not_found",
+			input:       fixtureModels(),
+			state: modelDataSourceModel{
+				Name: types.StringValue("unknown"),
+			},
+			isValid: false,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			state: modelDataSourceModel{
+				ModelId: types.StringValue("mid-1"),
+			},
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := tt.state
+			err := mapModelFields(tt.input, &state, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
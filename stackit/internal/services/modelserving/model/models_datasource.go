@@ -0,0 +1,270 @@
+package model
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	modelserving "github.com/stackitcloud/stackit-sdk-go/services/modelserving/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	modelservingUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/modelserving/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &modelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &modelsDataSource{}
+)
+
+type modelsDataSourceModel struct {
+	Id        types.String `tfsdk:"id"` // needed by TF
+	Region    types.String `tfsdk:"region"`
+	Type      types.String `tfsdk:"type"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Models    []modelItem  `tfsdk:"models"`
+}
+
+type modelItem struct {
+	ModelId       types.String `tfsdk:"model_id"`
+	Name          types.String `tfsdk:"name"`
+	DisplayedName types.String `tfsdk:"displayed_name"`
+	Description   types.String `tfsdk:"description"`
+	Type          types.String `tfsdk:"type"`
+	Category      types.String `tfsdk:"category"`
+	ContextLength types.Int64  `tfsdk:"context_length"`
+	Region        types.String `tfsdk:"region"`
+	Url           types.String `tfsdk:"url"`
+	Deprecated    types.Bool   `tfsdk:"deprecated"`
+}
+
+// descriptions of the attributes shared by the model and models data sources
+var descriptions = map[string]string{
+	"region":         "Region of the AI model serving models. If not defined, the provider region is used.",
+	"model_id":       "The ID of the model.",
+	"name":           "The name of the model, e.g. used as `model` in OpenAI compatible requests.",
+	"displayed_name": "The human readable name of the model.",
+	"description":    "The description of the model.",
+	"type":           "The type of the model, e.g. `chat` or `embedding`.",
+	"category":       "The category of the model, e.g. `standard` or `plus`.",
+	"context_length": "The maximum context length of the model in tokens.",
+	"url":            "The URL of the OpenAI compatible endpoint serving the model.",
+	"deprecated":     "Whether the model is deprecated and will be removed from the AI model serving in the future.",
+}
+
+// NewModelsDataSource is a helper function to simplify the provider implementation.
+func NewModelsDataSource() datasource.DataSource {
+	return &modelsDataSource{}
+}
+
+// modelsDataSource is the data source implementation.
+type modelsDataSource struct {
+	client       *modelserving.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (d *modelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_modelserving_models"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *modelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := modelservingUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "Model-Serving client configured")
+}
+
+// modelItemAttributes returns the computed attributes of a single model.
+func modelItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"model_id": schema.StringAttribute{
+			Description: descriptions["model_id"],
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: descriptions["name"],
+			Computed:    true,
+		},
+		"displayed_name": schema.StringAttribute{
+			Description: descriptions["displayed_name"],
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: descriptions["description"],
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: descriptions["type"],
+			Computed:    true,
+		},
+		"category": schema.StringAttribute{
+			Description: descriptions["category"],
+			Computed:    true,
+		},
+		"context_length": schema.Int64Attribute{
+			Description: descriptions["context_length"],
+			Computed:    true,
+		},
+		"region": schema.StringAttribute{
+			Description: descriptions["region"],
+			Computed:    true,
+		},
+		"url": schema.StringAttribute{
+			Description: descriptions["url"],
+			Computed:    true,
+		},
+		"deprecated": schema.BoolAttribute{
+			Description: descriptions["deprecated"],
+			Computed:    true,
+		},
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *modelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "AI model serving models data source schema. Lists the shared models, which can be used with a `stackit_modelserving_token`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`region`\".",
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: descriptions["region"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only list models of the given type, e.g. `chat` or `embedding`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only list models whose name matches the given regular expression.",
+				Optional:    true,
+			},
+			"models": schema.ListNestedAttribute{
+				Description: "List of the shared models matching the filters, ordered by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: modelItemAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *modelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model modelsDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	var nameRegex *regexp.Regexp
+	if !model.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(model.NameRegex.ValueString())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading models", fmt.Sprintf("Invalid name_regex: %v", err))
+			return
+		}
+	}
+
+	modelsResp, err := d.client.DefaultAPI.ListModels(ctx, region).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading models", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapModelsFields(modelsResp, &model, region, nameRegex)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading models", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Model-Serving models read")
+}
+
+func mapModelsFields(modelsResp *modelserving.ListModelsResponse, model *modelsDataSourceModel, region string, nameRegex *regexp.Regexp) error {
+	if modelsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(region)
+	model.Region = types.StringValue(region)
+
+	models := slices.Clone(modelsResp.Models)
+	slices.SortStableFunc(models, func(a, b modelserving.Model) int {
+		return cmp.Or(
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Id, b.Id),
+		)
+	})
+
+	model.Models = make([]modelItem, 0, len(models))
+	for i := range models {
+		if !model.Type.IsNull() && string(models[i].Type) != model.Type.ValueString() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(models[i].Name) {
+			continue
+		}
+		model.Models = append(model.Models, toModelItem(&models[i]))
+	}
+	return nil
+}
+
+func toModelItem(m *modelserving.Model) modelItem {
+	return modelItem{
+		ModelId:       types.StringValue(m.Id),
+		Name:          types.StringValue(m.Name),
+		DisplayedName: types.StringValue(m.DisplayedName),
+		Description:   types.StringValue(m.Description),
+		Type:          types.StringValue(string(m.Type)),
+		Category:      types.StringValue(string(m.Category)),
+		ContextLength: types.Int64PointerValue(m.ContextLength),
+		Region:        types.StringValue(m.Region),
+		Url:           types.StringValue(m.Url),
+		Deprecated:    types.BoolValue(m.GetDeprecated()),
+	}
+}
@@ -0,0 +1,171 @@
+package model
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelserving "github.com/stackitcloud/stackit-sdk-go/services/modelserving/v1api"
+)
+
+const testRegion = "eu01"
+
+func fixtureModels() *modelserving.ListModelsResponse {
+	return &modelserving.ListModelsResponse{
+		Models: []modelserving.Model{
+			{
+				Id:            "mid-3",
+				Name:          "intfloat/e5-mistral-7b-instruct",
+				DisplayedName: "E5 Mistral 7B",
+				Description:   "embedding model",
+				Type:          "embedding",
+				Category:      "standard",
+				ContextLength: new(int64(4096)),
+				Region:        testRegion,
+				Url:           "https://e5-mistral-7b.example.com/v1",
+			},
+			{
+				Id:            "mid-1",
+				Name:          "cortecs/Llama-3.3-70B-Instruct-FP8-Dynamic",
+				DisplayedName: "Llama 3.3 70B",
+				Description:   "chat model",
+				Type:          "chat",
+				Category:      "plus",
+				ContextLength: new(int64(128000)),
+				Region:        testRegion,
+				Url:           "https://llama-3-3-70b.example.com/v1",
+				Deprecated:    new(true),
+			},
+			{
+				Id:     "mid-2",
+				Name:   "google/gemma-3-27b-it",
+				Type:   "chat",
+				Region: testRegion,
+			},
+		},
+	}
+}
+
+func TestMapModelsFields(t *testing.T) {
+	llama := modelItem{
+		ModelId:       types.StringValue("mid-1"),
+		Name:          types.StringValue("cortecs/Llama-3.3-70B-Instruct-FP8-Dynamic"),
+		DisplayedName: types.StringValue("Llama 3.3 70B"),
+		Description:   types.StringValue("chat model"),
+		Type:          types.StringValue("chat"),
+		Category:      types.StringValue("plus"),
+		ContextLength: types.Int64Value(128000),
+		Region:        types.StringValue(testRegion),
+		Url:           types.StringValue("https://llama-3-3-70b.example.com/v1"),
+		Deprecated:    types.BoolValue(true),
+	}
+	gemma := modelItem{
+		ModelId:       types.StringValue("mid-2"),
+		Name:          types.StringValue("google/gemma-3-27b-it"),
+		DisplayedName: types.StringValue(""),
+		Description:   types.StringValue(""),
+		Type:          types.StringValue("chat"),
+		Category:      types.StringValue(""),
+		ContextLength: types.Int64Null(),
+		Region:        types.StringValue(testRegion),
+		Url:           types.StringValue(""),
+		Deprecated:    types.BoolValue(false),
+	}
+	e5 := modelItem{
+		ModelId:       types.StringValue("mid-3"),
+		Name:          types.StringValue("intfloat/e5-mistral-7b-instruct"),
+		DisplayedName: types.StringValue("E5 Mistral 7B"),
+		Description:   types.StringValue("embedding model"),
+		Type:          types.StringValue("embedding"),
+		Category:      types.StringValue("standard"),
+		ContextLength: types.Int64Value(4096),
+		Region:        types.StringValue(testRegion),
+		Url:           types.StringValue("https://e5-mistral-7b.example.com/v1"),
+		Deprecated:    types.BoolValue(false),
+	}
+
+	tests := []struct {
+		description string
+		input       *modelserving.ListModelsResponse
+		state       modelsDataSourceModel
+		nameRegex   *regexp.Regexp
+		expected    modelsDataSourceModel
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			input:       &modelserving.ListModelsResponse{},
+			expected: modelsDataSourceModel{
+				Id:     types.StringValue(testRegion),
+				Region: types.StringValue(testRegion),
+				Models: []modelItem{},
+			},
+			isValid: true,
+		},
+		{
+			description: "sorted_by_name",
+			input:       fixtureModels(),
+			expected: modelsDataSourceModel{
+				Id:     types.StringValue(testRegion),
+				Region: types.StringValue(testRegion),
+				Models: []modelItem{llama, gemma, e5},
+			},
+			isValid: true,
+		},
+		{
+			description: "filter_by_type",
+			input:       fixtureModels(),
+			state: modelsDataSourceModel{
+				Type: types.StringValue("embedding"),
+			},
+			expected: modelsDataSourceModel{
+				Id:     types.StringValue(testRegion),
+				Region: types.StringValue(testRegion),
+				Type:   types.StringValue("embedding"),
+				Models: []modelItem{e5},
+			},
+			isValid: true,
+		},
+		{
+			description: "filter_by_type_and_name_regex",
+			input:       fixtureModels(),
+			state: modelsDataSourceModel{
+				Type:      types.StringValue("chat"),
+				NameRegex: types.StringValue("^google/"),
+			},
+			nameRegex: regexp.MustCompile("^google/"),
+			expected: modelsDataSourceModel{
+				Id:        types.StringValue(testRegion),
+				Region:    types.StringValue(testRegion),
+				Type:      types.StringValue("chat"),
+				NameRegex: types.StringValue("^google/"),
+				Models:    []modelItem{gemma},
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := tt.state
+			err := mapModelsFields(tt.input, &state, testRegion, tt.nameRegex)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
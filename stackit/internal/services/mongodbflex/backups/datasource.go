@@ -0,0 +1,222 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	mongodbflexUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+var (
+	_ datasource.DataSource              = new(backups)
+	_ datasource.DataSourceWithConfigure = new(backups)
+)
+
+type model struct {
+	ID         types.String   `tfsdk:"id"`
+	ProjectId  types.String   `tfsdk:"project_id"`
+	InstanceId types.String   `tfsdk:"instance_id"`
+	Region     types.String   `tfsdk:"region"`
+	Backups    []backup       `tfsdk:"backups"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+type backup struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Size      types.Int64  `tfsdk:"size"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+}
+
+type backups struct {
+	client       *mongodbflex.APIClient
+	providerData core.ProviderData
+}
+
+func NewBackupsDataSource() datasource.DataSource {
+	return new(backups)
+}
+
+func (b *backups) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mongodbflex_backups"
+}
+
+func (b *backups) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	b.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := mongodbflexUtils.ConfigureClient(ctx, &b.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	b.client = apiClient
+	tflog.Info(ctx, "MongoDB Flex backups client configured")
+}
+
+func (b *backups) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "MongoDB Flex backups data source schema. Lists the backups of a MongoDB Flex instance, sorted by their start time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID, structured as \"`project_id`,`region`,`instance_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the instance is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "ID of the MongoDB Flex instance.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "MongoDB Flex backups data source region. If undefined, the provider region is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"timeouts": timeouts.Attributes(ctx),
+			"backups": schema.ListNestedAttribute{
+				Description: "List of backups of the instance.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Backup ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Backup name.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "Backup size in bytes.",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "Time when the backup was started.",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "Time when the backup was completed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (b *backups) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := model.Timeouts.Read(ctx, core.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	region := b.providerData.GetRegionWithOverride(model.Region)
+	model.Region = types.StringValue(region)
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id":  projectId,
+		"instance_id": instanceId,
+		"region":      region,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	backupsResp, err := b.client.DefaultAPI.ListBackups(ctx, projectId, instanceId, region).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading backups",
+			fmt.Sprintf("Calling ListBackups: %v", err),
+			map[int]string{
+				http.StatusNotFound: fmt.Sprintf("Instance with ID %q not found or forbidden access", instanceId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	if err := mapFields(backupsResp, &model); err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Reading backups", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "MongoDB Flex backups read")
+}
+
+func mapFields(resp *mongodbflex.ListBackupsResponse, m *model) error {
+	if resp == nil {
+		return fmt.Errorf("nil response")
+	}
+	if m == nil {
+		return fmt.Errorf("nil model")
+	}
+
+	m.ID = utils.BuildInternalTerraformId(m.ProjectId.ValueString(), m.Region.ValueString(), m.InstanceId.ValueString())
+	m.Backups = make([]backup, 0, len(resp.Items))
+
+	slices.SortFunc(resp.Items, func(a, b mongodbflex.Backup) int {
+		return strings.Compare(a.GetStartTime(), b.GetStartTime())
+	})
+
+	for _, respBackup := range resp.Items {
+		m.Backups = append(m.Backups, backup{
+			Id:        types.StringPointerValue(respBackup.Id),
+			Name:      types.StringPointerValue(respBackup.Name),
+			Size:      types.Int64PointerValue(respBackup.Size),
+			StartTime: types.StringPointerValue(respBackup.StartTime),
+			EndTime:   types.StringPointerValue(respBackup.EndTime),
+		})
+	}
+	return nil
+}
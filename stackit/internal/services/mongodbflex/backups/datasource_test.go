@@ -0,0 +1,123 @@
+package backups
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		response *mongodbflex.ListBackupsResponse
+		model    *model
+		expected *model
+		valid    bool
+	}{
+		{
+			name:     "nil response",
+			response: nil,
+			model:    &model{},
+			expected: &model{},
+			valid:    false,
+		},
+		{
+			name:     "nil model",
+			response: &mongodbflex.ListBackupsResponse{},
+			model:    nil,
+			expected: nil,
+			valid:    false,
+		},
+		{
+			name:     "empty response",
+			response: &mongodbflex.ListBackupsResponse{},
+			model: &model{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Region:     types.StringValue("eu01"),
+			},
+			expected: &model{
+				ID:         types.StringValue("pid,eu01,iid"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Region:     types.StringValue("eu01"),
+				Backups:    []backup{},
+			},
+			valid: true,
+		},
+		{
+			name: "sorted by start time",
+			response: &mongodbflex.ListBackupsResponse{
+				Items: []mongodbflex.Backup{
+					{
+						Id:        new("b2"),
+						Name:      new("backup-2"),
+						Size:      new(int64(2048)),
+						StartTime: new("2026-01-02T00:00:00Z"),
+						EndTime:   new("2026-01-02T00:05:00Z"),
+					},
+					{
+						Id:        new("b1"),
+						Name:      new("backup-1"),
+						Size:      new(int64(1024)),
+						StartTime: new("2026-01-01T00:00:00Z"),
+						EndTime:   new("2026-01-01T00:05:00Z"),
+					},
+					{
+						Id: new("b0"),
+					},
+				},
+			},
+			model: &model{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Region:     types.StringValue("eu01"),
+			},
+			expected: &model{
+				ID:         types.StringValue("pid,eu01,iid"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Region:     types.StringValue("eu01"),
+				Backups: []backup{
+					{
+						Id:        types.StringValue("b0"),
+						Name:      types.StringNull(),
+						Size:      types.Int64Null(),
+						StartTime: types.StringNull(),
+						EndTime:   types.StringNull(),
+					},
+					{
+						Id:        types.StringValue("b1"),
+						Name:      types.StringValue("backup-1"),
+						Size:      types.Int64Value(1024),
+						StartTime: types.StringValue("2026-01-01T00:00:00Z"),
+						EndTime:   types.StringValue("2026-01-01T00:05:00Z"),
+					},
+					{
+						Id:        types.StringValue("b2"),
+						Name:      types.StringValue("backup-2"),
+						Size:      types.Int64Value(2048),
+						StartTime: types.StringValue("2026-01-02T00:00:00Z"),
+						EndTime:   types.StringValue("2026-01-02T00:05:00Z"),
+					},
+				},
+			},
+			valid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mapFields(tt.response, tt.model)
+			if (err == nil) != tt.valid {
+				t.Fatalf("mapFields() error = %v, valid %v", err, tt.valid)
+			}
+			if tt.valid {
+				if diff := cmp.Diff(tt.expected, tt.model); diff != "" {
+					t.Fatalf("mapFields() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package flavors
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	mongodbflexUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+var (
+	_ datasource.DataSource              = new(flavors)
+	_ datasource.DataSourceWithConfigure = new(flavors)
+)
+
+type model struct {
+	ID        types.String   `tfsdk:"id"`
+	ProjectId types.String   `tfsdk:"project_id"`
+	Region    types.String   `tfsdk:"region"`
+	Flavors   []flavor       `tfsdk:"flavors"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+type flavor struct {
+	Id          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	CPU         types.Int64  `tfsdk:"cpu"`
+	Memory      types.Int64  `tfsdk:"memory"`
+}
+
+type flavors struct {
+	client       *mongodbflex.APIClient
+	providerData core.ProviderData
+}
+
+func NewFlavorsDataSource() datasource.DataSource {
+	return new(flavors)
+}
+
+func (f *flavors) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mongodbflex_flavors"
+}
+
+func (f *flavors) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	f.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := mongodbflexUtils.ConfigureClient(ctx, &f.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	f.client = apiClient
+	tflog.Info(ctx, "MongoDB Flex flavors client configured")
+}
+
+func (f *flavors) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "MongoDB Flex flavors data source schema.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID, structured as \"`project_id`,`region`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "MongoDB Flex flavors data source region. If undefined, the provider region is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"timeouts": timeouts.Attributes(ctx),
+			"flavors": schema.ListNestedAttribute{
+				Description: "List of flavors available for the project.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Flavor ID.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Flavor description.",
+							Computed:    true,
+						},
+						"cpu": schema.Int64Attribute{
+							Description: "CPU count of the instance.",
+							Computed:    true,
+						},
+						"memory": schema.Int64Attribute{
+							Description: "Memory of the instance in GB.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (f *flavors) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := model.Timeouts.Read(ctx, core.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	projectId := model.ProjectId.ValueString()
+	region := f.providerData.GetRegionWithOverride(model.Region)
+	model.Region = types.StringValue(region)
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": projectId,
+		"region":     region,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	flavorsResp, err := f.client.DefaultAPI.ListFlavors(ctx, projectId, region).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Reading flavors", fmt.Sprintf("Calling ListFlavors: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	if err := mapFields(flavorsResp, &model); err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Reading flavors", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "MongoDB Flex flavors read")
+}
+
+func mapFields(resp *mongodbflex.ListFlavorsResponse, m *model) error {
+	if resp == nil {
+		return fmt.Errorf("nil response")
+	}
+	if m == nil {
+		return fmt.Errorf("nil model")
+	}
+
+	m.ID = utils.BuildInternalTerraformId(m.ProjectId.ValueString(), m.Region.ValueString())
+	m.Flavors = make([]flavor, 0, len(resp.Flavors))
+
+	slices.SortFunc(resp.Flavors, func(a, b mongodbflex.InstanceFlavor) int {
+		return strings.Compare(a.GetId(), b.GetId())
+	})
+
+	for _, respFlavor := range resp.Flavors {
+		m.Flavors = append(m.Flavors, flavor{
+			Id:          types.StringPointerValue(respFlavor.Id),
+			Description: types.StringPointerValue(respFlavor.Description),
+			CPU:         types.Int64PointerValue(int32ToInt64Pointer(respFlavor.Cpu)),
+			Memory:      types.Int64PointerValue(int32ToInt64Pointer(respFlavor.Memory)),
+		})
+	}
+	return nil
+}
+
+func int32ToInt64Pointer(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+	return new(int64(*v))
+}
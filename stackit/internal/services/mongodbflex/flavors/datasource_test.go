@@ -0,0 +1,123 @@
+package flavors
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *mongodbflex.ListFlavorsResponse
+		state       *model
+		expected    *model
+		isValid     bool
+	}{
+		{
+			description: "default_values_and_sorting",
+			input: &mongodbflex.ListFlavorsResponse{
+				Flavors: []mongodbflex.InstanceFlavor{
+					{
+						Id:          new("fid-2"),
+						Description: new("desc2"),
+						Cpu:         new(int32(4)),
+						Memory:      new(int32(16)),
+					},
+					{
+						Id:          new("fid-1"),
+						Description: new("desc1"),
+						Cpu:         new(int32(2)),
+						Memory:      new(int32(8)),
+					},
+				},
+			},
+			state: &model{
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+			},
+			expected: &model{
+				ID:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				Flavors: []flavor{
+					{
+						Id:          types.StringValue("fid-1"),
+						Description: types.StringValue("desc1"),
+						CPU:         types.Int64Value(2),
+						Memory:      types.Int64Value(8),
+					},
+					{
+						Id:          types.StringValue("fid-2"),
+						Description: types.StringValue("desc2"),
+						CPU:         types.Int64Value(4),
+						Memory:      types.Int64Value(16),
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "null_fields",
+			input: &mongodbflex.ListFlavorsResponse{
+				Flavors: []mongodbflex.InstanceFlavor{
+					{
+						Id: new("fid-1"),
+					},
+				},
+			},
+			state: &model{
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+			},
+			expected: &model{
+				ID:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				Flavors: []flavor{
+					{
+						Id:          types.StringValue("fid-1"),
+						Description: types.StringNull(),
+						CPU:         types.Int64Null(),
+						Memory:      types.Int64Null(),
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			state:       &model{},
+			expected:    &model{},
+			isValid:     false,
+		},
+		{
+			description: "nil_model",
+			input:       &mongodbflex.ListFlavorsResponse{},
+			state:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.expected, tt.state)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,382 @@
+package restore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+	"github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api/wait"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	mongodbflexUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &restoreResource{}
+	_ resource.ResourceWithConfigure  = &restoreResource{}
+	_ resource.ResourceWithModifyPlan = &restoreResource{}
+)
+
+type Model struct {
+	Id               types.String `tfsdk:"id"` // needed by TF
+	ProjectId        types.String `tfsdk:"project_id"`
+	InstanceId       types.String `tfsdk:"instance_id"`
+	SourceInstanceId types.String `tfsdk:"source_instance_id"`
+	BackupId         types.String `tfsdk:"backup_id"`
+	Timestamp        types.String `tfsdk:"timestamp"`
+	Triggers         types.Map    `tfsdk:"triggers"`
+	Region           types.String `tfsdk:"region"`
+}
+
+// NewRestoreResource is a helper function to simplify the provider implementation.
+func NewRestoreResource() resource.Resource {
+	return &restoreResource{}
+}
+
+// restoreResource is the resource implementation.
+type restoreResource struct {
+	client       *mongodbflex.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *restoreResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mongodbflex_instance_restore"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *restoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := mongodbflexUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "MongoDB Flex instance restore client configured")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *restoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *restoreResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": strings.Join([]string{
+			"MongoDB Flex instance restore resource schema. Restores a backup or a point in time of a source instance into a target instance.",
+			"To restore in place, leave `source_instance_id` unset. To clone into a new instance, create a `stackit_mongodbflex_instance` and use it as target.",
+			"The restore is executed on creation and again whenever one of the arguments or `triggers` changes. Destroying the resource only removes it from the Terraform state, the data of the target instance is left unchanged.",
+		}, " "),
+		"id":                 "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`instance_id`\".",
+		"project_id":         "STACKIT project ID to which the instances are associated.",
+		"instance_id":        "ID of the target instance, into which the data is restored. All existing data of this instance is overwritten.",
+		"source_instance_id": "ID of the instance the backup or point in time belongs to. Defaults to `instance_id`.",
+		"backup_id":          "ID of the backup to restore. Exactly one of `backup_id` and `timestamp` must be set. See the `stackit_mongodbflex_backups` data source.",
+		"timestamp":          "Point in time to clone the source instance at, in RFC3339 format. Must lie within the `point_in_time_window_hours` of the source instance. Exactly one of `backup_id` and `timestamp` must be set.",
+		"triggers":           "Arbitrary map of values that, when changed, will run the restore again.",
+		"region":             "The resource region. If not defined, the provider region is used.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: descriptions["instance_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"source_instance_id": schema.StringAttribute{
+				Description: descriptions["source_instance_id"],
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				Description: descriptions["backup_id"],
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("backup_id"), path.MatchRoot("timestamp")),
+				},
+			},
+			"timestamp": schema.StringAttribute{
+				Description: descriptions["timestamp"],
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.RFC3339SecondsOnly(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: descriptions["triggers"],
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create runs the restore and sets the initial Terraform state on success.
+func (r *restoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	instanceId := model.InstanceId.ValueString()
+	if model.SourceInstanceId.IsUnknown() || model.SourceInstanceId.IsNull() {
+		model.SourceInstanceId = model.InstanceId
+	}
+	sourceInstanceId := model.SourceInstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "source_instance_id", sourceInstanceId)
+
+	if !model.BackupId.IsNull() {
+		backupId := model.BackupId.ValueString()
+		ctx = tflog.SetField(ctx, "backup_id", backupId)
+
+		payload, err := toRestorePayload(&model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error restoring instance", fmt.Sprintf("Creating API payload: %v", err))
+			return
+		}
+		_, err = r.client.DefaultAPI.RestoreInstance(ctx, projectId, instanceId, region).RestoreInstancePayload(*payload).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error restoring instance", fmt.Sprintf("Calling API: %v", err))
+			return
+		}
+
+		ctx = core.LogResponse(ctx)
+
+		_, err = wait.RestoreInstanceWaitHandler(ctx, r.client.DefaultAPI, projectId, instanceId, backupId, region).WaitWithContext(ctx)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error restoring instance", fmt.Sprintf("Instance restore waiting: %v", err))
+			return
+		}
+	} else {
+		ctx = tflog.SetField(ctx, "timestamp", model.Timestamp.ValueString())
+
+		payload, err := toClonePayload(&model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error cloning instance", fmt.Sprintf("Creating API payload: %v", err))
+			return
+		}
+
+		// the restore jobs which already exist are needed to identify the job of the clone
+		previousJobIds, err := restoreJobIds(ctx, r.client.DefaultAPI, projectId, instanceId, region)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error cloning instance", fmt.Sprintf("Listing restore jobs: %v", err))
+			return
+		}
+
+		_, err = r.client.DefaultAPI.CloneInstance(ctx, projectId, sourceInstanceId, region).CloneInstancePayload(*payload).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error cloning instance", fmt.Sprintf("Calling API: %v", err))
+			return
+		}
+
+		ctx = core.LogResponse(ctx)
+
+		_, err = cloneInstanceWaitHandler(ctx, r.client.DefaultAPI, projectId, instanceId, region, previousJobIds).WaitWithContext(ctx)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error cloning instance", fmt.Sprintf("Instance clone waiting: %v", err))
+			return
+		}
+	}
+
+	model.Id = utils.BuildInternalTerraformId(projectId, region, instanceId)
+	model.Region = types.StringValue(region)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "MongoDB Flex instance restored")
+}
+
+// Read refreshes the Terraform state with the latest data.
+// A restore has no remote representation, so only the existence of the target instance is checked.
+func (r *restoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	_, err := r.client.DefaultAPI.GetInstance(ctx, projectId, instanceId, region).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance restore", err.Error())
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	tflog.Info(ctx, "MongoDB Flex instance restore read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *restoreResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Update shouldn't be called
+	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance restore", "Instance restore can't be updated")
+}
+
+// Delete removes the resource from the Terraform state. The data of the target instance is left unchanged.
+func (r *restoreResource) Delete(ctx context.Context, req resource.DeleteRequest, _ *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	if diags := req.State.Get(ctx, &model); !diags.HasError() {
+		ctx = tflog.SetField(ctx, "instance_id", model.InstanceId.ValueString())
+	}
+	tflog.Info(ctx, "MongoDB Flex instance restore removed from state")
+}
+
+func toRestorePayload(model *Model) (*mongodbflex.RestoreInstancePayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	if model.BackupId.ValueString() == "" {
+		return nil, fmt.Errorf("backup_id is not set")
+	}
+	sourceInstanceId := model.SourceInstanceId.ValueString()
+	if sourceInstanceId == "" {
+		sourceInstanceId = model.InstanceId.ValueString()
+	}
+	return &mongodbflex.RestoreInstancePayload{
+		BackupId:   model.BackupId.ValueString(),
+		InstanceId: sourceInstanceId,
+	}, nil
+}
+
+func toClonePayload(model *Model) (*mongodbflex.CloneInstancePayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	if model.Timestamp.ValueString() == "" {
+		return nil, fmt.Errorf("timestamp is not set")
+	}
+	return &mongodbflex.CloneInstancePayload{
+		InstanceId: model.InstanceId.ValueString(),
+		Timestamp:  conversion.StringValueToPointer(model.Timestamp),
+	}, nil
+}
@@ -0,0 +1,168 @@
+package restore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+)
+
+func TestToRestorePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *mongodbflex.RestoreInstancePayload
+		isValid     bool
+	}{
+		{
+			description: "in_place",
+			input: &Model{
+				InstanceId: types.StringValue("iid"),
+				BackupId:   types.StringValue("bid"),
+			},
+			expected: &mongodbflex.RestoreInstancePayload{
+				BackupId:   "bid",
+				InstanceId: "iid",
+			},
+			isValid: true,
+		},
+		{
+			description: "from_source_instance",
+			input: &Model{
+				InstanceId:       types.StringValue("iid"),
+				SourceInstanceId: types.StringValue("sid"),
+				BackupId:         types.StringValue("bid"),
+			},
+			expected: &mongodbflex.RestoreInstancePayload{
+				BackupId:   "bid",
+				InstanceId: "sid",
+			},
+			isValid: true,
+		},
+		{
+			description: "no_backup_id",
+			input: &Model{
+				InstanceId: types.StringValue("iid"),
+				BackupId:   types.StringNull(),
+			},
+			expected: nil,
+			isValid:  false,
+		},
+		{
+			description: "nil_model",
+			input:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toRestorePayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToClonePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *mongodbflex.CloneInstancePayload
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			input: &Model{
+				InstanceId:       types.StringValue("iid"),
+				SourceInstanceId: types.StringValue("sid"),
+				Timestamp:        types.StringValue("2026-01-02T03:04:05Z"),
+			},
+			expected: &mongodbflex.CloneInstancePayload{
+				InstanceId: "iid",
+				Timestamp:  new("2026-01-02T03:04:05Z"),
+			},
+			isValid: true,
+		},
+		{
+			description: "no_timestamp",
+			input: &Model{
+				InstanceId: types.StringValue("iid"),
+				Timestamp:  types.StringNull(),
+			},
+			expected: nil,
+			isValid:  false,
+		},
+		{
+			description: "nil_model",
+			input:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toClonePayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFindNewRestoreJob(t *testing.T) {
+	jobs := []mongodbflex.RestoreInstanceStatus{
+		{Id: new("job-1"), Status: new(restoreJobStatusFinished)},
+		{Id: new("job-2"), Status: new("IN_PROGRESS")},
+	}
+	tests := []struct {
+		description    string
+		previousJobIds []string
+		expected       *mongodbflex.RestoreInstanceStatus
+	}{
+		{
+			description:    "new_job",
+			previousJobIds: []string{"job-1"},
+			expected:       &jobs[1],
+		},
+		{
+			description:    "no_previous_jobs",
+			previousJobIds: []string{},
+			expected:       &jobs[0],
+		},
+		{
+			description:    "job_not_started_yet",
+			previousJobIds: []string{"job-1", "job-2"},
+			expected:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := findNewRestoreJob(jobs, tt.previousJobIds)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+)
+
+const (
+	restoreJobStatusFinished = "FINISHED"
+	restoreJobStatusBroken   = "BROKEN"
+	restoreJobStatusKilled   = "KILLED"
+
+	cloneInstanceTimeout = 2 * time.Hour
+)
+
+// restoreJobIds returns the IDs of the restore jobs of the instance.
+func restoreJobIds(ctx context.Context, client mongodbflex.DefaultAPI, projectId, instanceId, region string) ([]string, error) {
+	jobs, err := client.ListRestoreJobs(ctx, projectId, instanceId, region).Execute()
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, job := range jobs.Items {
+		ids = append(ids, job.GetId())
+	}
+	return ids, nil
+}
+
+// cloneInstanceWaitHandler waits until the restore job started by cloning into the instance is finished.
+// The instance is READY before the clone starts, so the restore jobs are checked instead of the instance state.
+// The job of the clone is the one which is not part of the restore jobs listed before the clone was triggered.
+func cloneInstanceWaitHandler(ctx context.Context, client mongodbflex.DefaultAPI, projectId, instanceId, region string, previousJobIds []string) *wait.AsyncActionHandler[mongodbflex.RestoreInstanceStatus] {
+	handler := wait.New(func() (waitFinished bool, response *mongodbflex.RestoreInstanceStatus, err error) {
+		jobs, err := client.ListRestoreJobs(ctx, projectId, instanceId, region).Execute()
+		if err != nil {
+			return false, nil, err
+		}
+		job := findNewRestoreJob(jobs.Items, previousJobIds)
+		if job == nil {
+			return false, nil, nil
+		}
+		switch job.GetStatus() {
+		case restoreJobStatusFinished:
+			return true, job, nil
+		case restoreJobStatusBroken, restoreJobStatusKilled:
+			return true, job, fmt.Errorf("restore job %q of the clone has status %q", job.GetId(), job.GetStatus())
+		}
+		return false, job, nil
+	})
+	handler.SetTimeout(cloneInstanceTimeout)
+	return handler
+}
+
+// findNewRestoreJob returns the restore job whose ID is not one of the previous job IDs, or nil if there is none.
+func findNewRestoreJob(jobs []mongodbflex.RestoreInstanceStatus, previousJobIds []string) *mongodbflex.RestoreInstanceStatus {
+	for i := range jobs {
+		if !slices.Contains(previousJobIds, jobs[i].GetId()) {
+			return &jobs[i]
+		}
+	}
+	return nil
+}
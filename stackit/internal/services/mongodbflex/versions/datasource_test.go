@@ -0,0 +1,84 @@
+package versions
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *mongodbflex.ListVersionsResponse
+		state       *model
+		expected    *model
+		isValid     bool
+	}{
+		{
+			description: "default_values_and_sorting",
+			input: &mongodbflex.ListVersionsResponse{
+				Versions: []string{"8.0", "6.0", "7.0"},
+			},
+			state: &model{
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+			},
+			expected: &model{
+				ID:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				Versions:  []string{"6.0", "7.0", "8.0"},
+			},
+			isValid: true,
+		},
+		{
+			description: "empty_response",
+			input:       &mongodbflex.ListVersionsResponse{},
+			state: &model{
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+			},
+			expected: &model{
+				ID:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				Versions:  []string{},
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			state:       &model{},
+			expected:    &model{},
+			isValid:     false,
+		},
+		{
+			description: "nil_model",
+			input:       &mongodbflex.ListVersionsResponse{},
+			state:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.expected, tt.state)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+)
+
+func TestMapFields(t *testing.T) {
+	const testRegion = "region"
+	tests := []struct {
+		description string
+		state       Model
+		input       *postgresflex.GetExtensionResponse
+		region      string
+		expected    Model
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			state: Model{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Name:       types.StringValue("pg_trgm"),
+			},
+			input:  &postgresflex.GetExtensionResponse{},
+			region: testRegion,
+			expected: Model{
+				Id:         types.StringValue("pid,region,iid,pg_trgm"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Name:       types.StringValue("pg_trgm"),
+				Version:    types.StringValue(""),
+				Region:     types.StringValue(testRegion),
+			},
+			isValid: true,
+		},
+		{
+			description: "simple_values",
+			state: Model{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+			},
+			input: &postgresflex.GetExtensionResponse{
+				Name:    "postgis",
+				Version: "3.4.2",
+			},
+			region: testRegion,
+			expected: Model{
+				Id:         types.StringValue("pid,region,iid,postgis"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Name:       types.StringValue("postgis"),
+				Version:    types.StringValue("3.4.2"),
+				Region:     types.StringValue(testRegion),
+			},
+			isValid: true,
+		},
+		{
+			description: "no_name",
+			state: Model{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+			},
+			input:    &postgresflex.GetExtensionResponse{},
+			region:   testRegion,
+			expected: Model{},
+			isValid:  false,
+		},
+		{
+			description: "nil_response",
+			state:       Model{},
+			input:       nil,
+			region:      testRegion,
+			expected:    Model{},
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, &tt.state, tt.region)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *postgresflex.CreateExtensionPayload
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			input: &Model{
+				Name: types.StringValue("pgcrypto"),
+			},
+			expected: &postgresflex.CreateExtensionPayload{
+				Name: "pgcrypto",
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_model",
+			input:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toCreatePayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,653 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api/wait"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	postgresflexUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/postgresflex/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &settingsResource{}
+	_ resource.ResourceWithConfigure   = &settingsResource{}
+	_ resource.ResourceWithImportState = &settingsResource{}
+	_ resource.ResourceWithModifyPlan  = &settingsResource{}
+)
+
+// AllowedParameters contains the PostgreSQL configuration parameters which can be changed on a Postgres Flex instance.
+var AllowedParameters = []string{
+	"autovacuum_analyze_scale_factor",
+	"autovacuum_max_workers",
+	"autovacuum_vacuum_cost_limit",
+	"autovacuum_vacuum_scale_factor",
+	"checkpoint_completion_target",
+	"default_statistics_target",
+	"effective_cache_size",
+	"idle_in_transaction_session_timeout",
+	"lock_timeout",
+	"log_min_duration_statement",
+	"log_statement",
+	"maintenance_work_mem",
+	"max_connections",
+	"max_parallel_workers",
+	"max_parallel_workers_per_gather",
+	"max_wal_size",
+	"min_wal_size",
+	"random_page_cost",
+	"shared_buffers",
+	"statement_timeout",
+	"temp_buffers",
+	"wal_buffers",
+	"work_mem",
+}
+
+// originalParametersKey is the key of the private state holding the values the managed parameters had before they were
+// managed by the resource. They are restored when a parameter is removed from the configuration or the resource is deleted.
+const originalParametersKey = "original_parameters"
+
+type Model struct {
+	Id         types.String `tfsdk:"id"` // needed by TF
+	InstanceId types.String `tfsdk:"instance_id"`
+	ProjectId  types.String `tfsdk:"project_id"`
+	Parameters types.Map    `tfsdk:"parameters"`
+	Region     types.String `tfsdk:"region"`
+}
+
+// NewSettingsResource is a helper function to simplify the provider implementation.
+func NewSettingsResource() resource.Resource {
+	return &settingsResource{}
+}
+
+// settingsResource is the resource implementation.
+type settingsResource struct {
+	client       *postgresflex.APIClient
+	providerData core.ProviderData
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *settingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *settingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresflex_instance_settings"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *settingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := postgresflexUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "Postgres Flex instance settings client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *settingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":        "Postgres Flex instance settings resource schema. Manages the PostgreSQL configuration parameters of a Postgres Flex instance. Must have a `region` specified in the provider configuration.",
+		"id":          "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`instance_id`\".",
+		"instance_id": "ID of the Postgres Flex instance.",
+		"project_id":  "STACKIT project ID to which the instance is associated.",
+		"parameters":  "PostgreSQL configuration parameters, e.g. `max_connections` or `work_mem`. Parameters which are not set are not changed. When a parameter is removed or the resource is deleted, the parameter is set back to the value it had before it was managed by Terraform. Changing a parameter that requires a restart will restart the instance. " + utils.FormatPossibleValues(AllowedParameters...),
+		"region":      "The resource region. If not defined, the provider region is used.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: descriptions["instance_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				Description: descriptions["parameters"],
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.OneOf(AllowedParameters...)),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *settingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	current, err := r.client.DefaultAPI.GetInstanceParameters(ctx, projectId, region, instanceId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance settings", fmt.Sprintf("Getting current instance settings: %v", err))
+		return
+	}
+
+	originals := map[string]string{}
+	recordOriginalParameters(originals, current.Parameters, parameterKeys(model.Parameters))
+
+	payload, err := toUpdatePayload(ctx, &model, originals, nil)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance settings", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	settings, err := r.updateParameters(ctx, &model, payload)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance settings", err.Error())
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Map response body to schema
+	err = mapFields(ctx, settings, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance settings", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setOriginalParameters(ctx, resp.Private, originals)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Postgres Flex instance settings created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *settingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	settings, err := r.client.DefaultAPI.GetInstanceParameters(ctx, projectId, region, instanceId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance settings", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	imported := model.Parameters.IsNull()
+
+	// Map response body to schema
+	err = mapFields(ctx, settings, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance settings", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// After an import, the current values are the ones the instance had before it was managed by Terraform
+	if imported {
+		originals := map[string]string{}
+		recordOriginalParameters(originals, settings.Parameters, parameterKeys(model.Parameters))
+		resp.Diagnostics.Append(setOriginalParameters(ctx, resp.Private, originals)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	tflog.Info(ctx, "Postgres Flex instance settings read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *settingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	var stateModel Model
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	originals, diags := getOriginalParameters(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.DefaultAPI.GetInstanceParameters(ctx, projectId, region, instanceId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance settings", fmt.Sprintf("Getting current instance settings: %v", err))
+		return
+	}
+
+	stateKeys := parameterKeys(stateModel.Parameters)
+	var added []string
+	for _, key := range parameterKeys(model.Parameters) {
+		if !slices.Contains(stateKeys, key) {
+			added = append(added, key)
+		}
+	}
+	recordOriginalParameters(originals, current.Parameters, added)
+
+	removed := removedParameters(&stateModel, &model)
+	payload, err := toUpdatePayload(ctx, &model, originals, removed)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance settings", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	warnUnknownOriginals(&resp.Diagnostics, originals, removed)
+
+	settings, err := r.updateParameters(ctx, &model, payload)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance settings", err.Error())
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Map response body to schema
+	err = mapFields(ctx, settings, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance settings", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, key := range removed {
+		delete(originals, key)
+	}
+	resp.Diagnostics.Append(setOriginalParameters(ctx, resp.Private, originals)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Postgres Flex instance settings updated")
+}
+
+// Delete sets the managed parameters back to the values they had before they were managed and removes the Terraform state on success.
+func (r *settingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	originals, diags := getOriginalParameters(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := parameterKeys(model.Parameters)
+	payload := toResetPayload(originals, managed)
+	warnUnknownOriginals(&resp.Diagnostics, originals, managed)
+	if len(payload.Parameters) == 0 {
+		tflog.Info(ctx, "Postgres Flex instance settings deleted")
+		return
+	}
+
+	err := r.client.DefaultAPI.UpdateInstanceParameters(ctx, projectId, region, instanceId).UpdateInstanceParametersPayload(*payload).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance settings", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	_, err = wait.PartialUpdateInstanceWaitHandler(ctx, r.client.DefaultAPI, projectId, region, instanceId).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance settings", fmt.Sprintf("Instance update waiting: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Postgres Flex instance settings deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,region,instance_id
+func (r *settingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing instance settings",
+			fmt.Sprintf("Expected import identifier with format [project_id],[region],[instance_id], got %q", req.ID),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id":  idParts[0],
+		"region":      idParts[1],
+		"instance_id": idParts[2],
+	})
+	tflog.Info(ctx, "Postgres Flex instance settings state imported")
+}
+
+// updateParameters sends the payload and waits until the instance is ready again,
+// which includes a restart of the instance if one of the parameters requires it.
+func (r *settingsResource) updateParameters(ctx context.Context, model *Model, payload *postgresflex.UpdateInstanceParametersPayload) (*postgresflex.GetInstanceParametersResponse, error) {
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	instanceId := model.InstanceId.ValueString()
+
+	err := r.client.DefaultAPI.UpdateInstanceParameters(ctx, projectId, region, instanceId).UpdateInstanceParametersPayload(*payload).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("calling API: %w", err)
+	}
+
+	_, err = wait.PartialUpdateInstanceWaitHandler(ctx, r.client.DefaultAPI, projectId, region, instanceId).WaitWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("instance update waiting: %w", err)
+	}
+
+	settings, err := r.client.DefaultAPI.GetInstanceParameters(ctx, projectId, region, instanceId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("getting instance settings after update: %w", err)
+	}
+	return settings, nil
+}
+
+func mapFields(ctx context.Context, settingsResp *postgresflex.GetInstanceParametersResponse, model *Model, region string) error {
+	if settingsResp == nil {
+		return fmt.Errorf("response is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	// The API returns every parameter of the instance. Only the ones managed by this resource are kept.
+	// If nothing is managed yet (e.g. after an import), all parameters which can be managed by the resource are kept.
+	managed := AllowedParameters
+	if !(model.Parameters.IsNull() || model.Parameters.IsUnknown()) {
+		managed = parameterKeys(model.Parameters)
+	}
+	parameters := map[string]string{}
+	for _, key := range managed {
+		if value, ok := settingsResp.Parameters[key]; ok {
+			parameters[key] = value
+		}
+	}
+
+	parametersTF, err := conversion.ToTerraformStringMap(ctx, parameters)
+	if err != nil {
+		return fmt.Errorf("mapping parameters: %w", err)
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.InstanceId.ValueString())
+	model.Parameters = parametersTF
+	model.Region = types.StringValue(region)
+	return nil
+}
+
+// toUpdatePayload returns the configured parameters. The removed parameters are set back to their original value, if it is known.
+func toUpdatePayload(ctx context.Context, model *Model, originals map[string]string, removed []string) (*postgresflex.UpdateInstanceParametersPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	parameters := map[string]string{}
+	diags := model.Parameters.ElementsAs(ctx, &parameters, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("converting parameters: %w", core.DiagsToError(diags))
+	}
+	for key, value := range toResetPayload(originals, removed).Parameters {
+		if parameters == nil {
+			parameters = map[string]string{}
+		}
+		parameters[key] = value
+	}
+
+	return &postgresflex.UpdateInstanceParametersPayload{
+		Parameters: parameters,
+	}, nil
+}
+
+// toResetPayload returns the original values of the given parameters. Parameters without a known original value are skipped.
+func toResetPayload(originals map[string]string, keys []string) *postgresflex.UpdateInstanceParametersPayload {
+	parameters := map[string]string{}
+	for _, key := range keys {
+		if value, ok := originals[key]; ok {
+			parameters[key] = value
+		}
+	}
+	return &postgresflex.UpdateInstanceParametersPayload{
+		Parameters: parameters,
+	}
+}
+
+// removedParameters returns the parameters managed in the state which are no longer configured in the plan.
+func removedParameters(state, plan *Model) []string {
+	planKeys := parameterKeys(plan.Parameters)
+	var removed []string
+	for _, key := range parameterKeys(state.Parameters) {
+		if !slices.Contains(planKeys, key) {
+			removed = append(removed, key)
+		}
+	}
+	return removed
+}
+
+// parameterKeys returns the sorted keys of the parameters map.
+func parameterKeys(parameters types.Map) []string {
+	keys := []string{}
+	if parameters.IsNull() || parameters.IsUnknown() {
+		return keys
+	}
+	for key := range parameters.Elements() {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// recordOriginalParameters stores the current value of the given parameters, unless an original value is already known.
+func recordOriginalParameters(originals, current map[string]string, keys []string) {
+	for _, key := range keys {
+		if _, ok := originals[key]; ok {
+			continue
+		}
+		if value, ok := current[key]; ok {
+			originals[key] = value
+		}
+	}
+}
+
+// warnUnknownOriginals adds a warning for the parameters which can't be set back, because their original value is not known.
+func warnUnknownOriginals(diags *diag.Diagnostics, originals map[string]string, keys []string) {
+	var unknown []string
+	for _, key := range keys {
+		if _, ok := originals[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) == 0 {
+		return
+	}
+	diags.AddWarning("Postgres Flex instance parameters not reset",
+		fmt.Sprintf("The value of the parameters %s before they were managed by Terraform is not known. They keep their current value on the instance.", strings.Join(unknown, ", ")))
+}
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func getOriginalParameters(ctx context.Context, private privateStateGetter) (map[string]string, diag.Diagnostics) {
+	originals := map[string]string{}
+	value, diags := private.GetKey(ctx, originalParametersKey)
+	if diags.HasError() || len(value) == 0 {
+		return originals, diags
+	}
+	if err := json.Unmarshal(value, &originals); err != nil {
+		diags.AddError("Error reading original instance parameters", fmt.Sprintf("Decoding private state: %v", err))
+	}
+	return originals, diags
+}
+
+func setOriginalParameters(ctx context.Context, private privateStateSetter, originals map[string]string) diag.Diagnostics {
+	value, err := json.Marshal(originals)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error storing original instance parameters", fmt.Sprintf("Encoding private state: %v", err))
+		return diags
+	}
+	return private.SetKey(ctx, originalParametersKey, value)
+}
@@ -0,0 +1,321 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+)
+
+func TestMapFields(t *testing.T) {
+	const testRegion = "region"
+	tests := []struct {
+		description string
+		state       Model
+		input       *postgresflex.GetInstanceParametersResponse
+		region      string
+		expected    Model
+		isValid     bool
+	}{
+		{
+			description: "import_keeps_allowed_parameters",
+			state: Model{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Parameters: types.MapNull(types.StringType),
+			},
+			input: &postgresflex.GetInstanceParametersResponse{
+				Parameters: map[string]string{
+					"max_connections":          "100",
+					"work_mem":                 "4MB",
+					"ssl":                      "on",
+					"shared_preload_libraries": "pg_stat_statements",
+				},
+			},
+			region: testRegion,
+			expected: Model{
+				Id:         types.StringValue("pid,region,iid"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"max_connections": types.StringValue("100"),
+					"work_mem":        types.StringValue("4MB"),
+				}),
+				Region: types.StringValue(testRegion),
+			},
+			isValid: true,
+		},
+		{
+			description: "only_managed_parameters",
+			state: Model{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"max_connections": types.StringValue("200"),
+				}),
+			},
+			input: &postgresflex.GetInstanceParametersResponse{
+				Parameters: map[string]string{
+					"max_connections": "150",
+					"work_mem":        "4MB",
+				},
+			},
+			region: testRegion,
+			expected: Model{
+				Id:         types.StringValue("pid,region,iid"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"max_connections": types.StringValue("150"),
+				}),
+				Region: types.StringValue(testRegion),
+			},
+			isValid: true,
+		},
+		{
+			description: "managed_parameter_missing_in_response",
+			state: Model{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"work_mem": types.StringValue("8MB"),
+				}),
+			},
+			input: &postgresflex.GetInstanceParametersResponse{
+				Parameters: map[string]string{
+					"max_connections": "150",
+				},
+			},
+			region: testRegion,
+			expected: Model{
+				Id:         types.StringValue("pid,region,iid"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+				Region:     types.StringValue(testRegion),
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response",
+			state:       Model{},
+			input:       nil,
+			region:      testRegion,
+			expected:    Model{},
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(context.Background(), tt.input, &tt.state, tt.region)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		originals   map[string]string
+		removed     []string
+		expected    *postgresflex.UpdateInstanceParametersPayload
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			input: &Model{
+				Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"max_connections":            types.StringValue("200"),
+					"log_min_duration_statement": types.StringValue("500"),
+				}),
+			},
+			expected: &postgresflex.UpdateInstanceParametersPayload{
+				Parameters: map[string]string{
+					"max_connections":            "200",
+					"log_min_duration_statement": "500",
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "removed_parameter_is_reset",
+			input: &Model{
+				Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"max_connections": types.StringValue("200"),
+				}),
+			},
+			originals: map[string]string{
+				"max_connections": "100",
+				"work_mem":        "4MB",
+			},
+			removed: []string{"work_mem"},
+			expected: &postgresflex.UpdateInstanceParametersPayload{
+				Parameters: map[string]string{
+					"max_connections": "200",
+					"work_mem":        "4MB",
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "removed_parameter_without_original",
+			input: &Model{
+				Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"max_connections": types.StringValue("200"),
+				}),
+			},
+			originals: map[string]string{},
+			removed:   []string{"work_mem"},
+			expected: &postgresflex.UpdateInstanceParametersPayload{
+				Parameters: map[string]string{
+					"max_connections": "200",
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "null_parameters",
+			input: &Model{
+				Parameters: types.MapNull(types.StringType),
+			},
+			expected: &postgresflex.UpdateInstanceParametersPayload{},
+			isValid:  true,
+		},
+		{
+			description: "nil_model",
+			input:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toUpdatePayload(context.Background(), tt.input, tt.originals, tt.removed)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToResetPayload(t *testing.T) {
+	originals := map[string]string{
+		"max_connections": "100",
+		"work_mem":        "4MB",
+	}
+	output := toResetPayload(originals, []string{"max_connections", "statement_timeout"})
+	expected := &postgresflex.UpdateInstanceParametersPayload{
+		Parameters: map[string]string{
+			"max_connections": "100",
+		},
+	}
+	if diff := cmp.Diff(output, expected); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestRemovedParameters(t *testing.T) {
+	state := &Model{
+		Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"max_connections": types.StringValue("200"),
+			"work_mem":        types.StringValue("8MB"),
+			"lock_timeout":    types.StringValue("1000"),
+		}),
+	}
+	plan := &Model{
+		Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"max_connections":   types.StringValue("300"),
+			"statement_timeout": types.StringValue("5000"),
+		}),
+	}
+	if diff := cmp.Diff(removedParameters(state, plan), []string{"lock_timeout", "work_mem"}); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+	if removed := removedParameters(&Model{Parameters: types.MapNull(types.StringType)}, plan); len(removed) != 0 {
+		t.Fatalf("Expected no removed parameters after import, got %v", removed)
+	}
+}
+
+func TestRecordOriginalParameters(t *testing.T) {
+	originals := map[string]string{
+		"max_connections": "100",
+	}
+	current := map[string]string{
+		"max_connections": "200",
+		"work_mem":        "4MB",
+	}
+	recordOriginalParameters(originals, current, []string{"max_connections", "work_mem", "unknown"})
+	expected := map[string]string{
+		"max_connections": "100",
+		"work_mem":        "4MB",
+	}
+	if diff := cmp.Diff(originals, expected); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+type privateState map[string][]byte
+
+func (p privateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p privateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestOriginalParametersPrivateState(t *testing.T) {
+	ctx := context.Background()
+	private := privateState{}
+
+	originals, diags := getOriginalParameters(ctx, private)
+	if diags.HasError() {
+		t.Fatalf("Should not have failed: %v", diags)
+	}
+	if len(originals) != 0 {
+		t.Fatalf("Expected no original parameters, got %v", originals)
+	}
+
+	expected := map[string]string{"work_mem": "4MB"}
+	if diags := setOriginalParameters(ctx, private, expected); diags.HasError() {
+		t.Fatalf("Should not have failed: %v", diags)
+	}
+	originals, diags = getOriginalParameters(ctx, private)
+	if diags.HasError() {
+		t.Fatalf("Should not have failed: %v", diags)
+	}
+	if diff := cmp.Diff(originals, expected); diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	private[originalParametersKey] = []byte("[]")
+	if _, diags := getOriginalParameters(ctx, private); !diags.HasError() {
+		t.Fatalf("Should have failed for invalid private state")
+	}
+}
@@ -0,0 +1,182 @@
+package storages
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	postgresflexUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/postgresflex/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+var (
+	_ datasource.DataSource              = new(storages)
+	_ datasource.DataSourceWithConfigure = new(storages)
+)
+
+type model struct {
+	ID             types.String   `tfsdk:"id"`
+	ProjectId      types.String   `tfsdk:"project_id"`
+	Region         types.String   `tfsdk:"region"`
+	FlavorId       types.String   `tfsdk:"flavor_id"`
+	StorageClasses []string       `tfsdk:"storage_classes"`
+	MinSize        types.Int64    `tfsdk:"min_size"`
+	MaxSize        types.Int64    `tfsdk:"max_size"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+type storages struct {
+	client       *postgresflex.APIClient
+	providerData core.ProviderData
+}
+
+func NewStoragesDataSource() datasource.DataSource {
+	return new(storages)
+}
+
+func (s *storages) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresflex_storages"
+}
+
+func (s *storages) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	s.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := postgresflexUtils.ConfigureClient(ctx, &s.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	s.client = apiClient
+	tflog.Info(ctx, "Postgres Flex storages client configured")
+}
+
+func (s *storages) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Postgres Flex storages data source schema. Lists the storage classes and the storage size range that can be used for the `storage` of an instance with the given flavor.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID, structured as \"`project_id`,`region`,`flavor_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Postgres Flex storages data source region. If undefined, the provider region is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"flavor_id": schema.StringAttribute{
+				Description: "ID of the flavor to list the storage options for.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx),
+			"storage_classes": schema.ListAttribute{
+				Description: "Storage classes available for the flavor, as used in `storage.class` of an instance.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"min_size": schema.Int64Attribute{
+				Description: "Minimum storage size in GB.",
+				Computed:    true,
+			},
+			"max_size": schema.Int64Attribute{
+				Description: "Maximum storage size in GB.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (s *storages) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := model.Timeouts.Read(ctx, core.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	projectId := model.ProjectId.ValueString()
+	flavorId := model.FlavorId.ValueString()
+	region := s.providerData.GetRegionWithOverride(model.Region)
+	model.Region = types.StringValue(region)
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": projectId,
+		"region":     region,
+		"flavor_id":  flavorId,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	storagesResp, err := s.client.DefaultAPI.ListStorages(ctx, projectId, region, flavorId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Reading storages", fmt.Sprintf("Calling ListStorages: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	if err := mapFields(storagesResp, &model); err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Reading storages", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Postgres Flex storages read")
+}
+
+func mapFields(resp *postgresflex.ListStoragesResponse, m *model) error {
+	if resp == nil {
+		return fmt.Errorf("nil response")
+	}
+	if m == nil {
+		return fmt.Errorf("nil model")
+	}
+
+	m.ID = utils.BuildInternalTerraformId(m.ProjectId.ValueString(), m.Region.ValueString(), m.FlavorId.ValueString())
+
+	m.StorageClasses = slices.Clone(resp.StorageClasses)
+	if m.StorageClasses == nil {
+		m.StorageClasses = []string{}
+	}
+	slices.Sort(m.StorageClasses)
+
+	m.MinSize = types.Int64Value(resp.StorageRange.Min)
+	m.MaxSize = types.Int64Value(resp.StorageRange.Max)
+	return nil
+}
@@ -0,0 +1,193 @@
+package versions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	postgresflexUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/postgresflex/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+var (
+	_ datasource.DataSource              = new(versions)
+	_ datasource.DataSourceWithConfigure = new(versions)
+)
+
+type model struct {
+	ID        types.String   `tfsdk:"id"`
+	ProjectId types.String   `tfsdk:"project_id"`
+	Region    types.String   `tfsdk:"region"`
+	Versions  []version      `tfsdk:"versions"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+type version struct {
+	Version     types.String `tfsdk:"version"`
+	Beta        types.Bool   `tfsdk:"beta"`
+	Deprecated  types.String `tfsdk:"deprecated"`
+	Recommended types.Bool   `tfsdk:"recommended"`
+}
+
+type versions struct {
+	client       *postgresflex.APIClient
+	providerData core.ProviderData
+}
+
+func NewVersionsDataSource() datasource.DataSource {
+	return new(versions)
+}
+
+func (v *versions) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresflex_versions"
+}
+
+func (v *versions) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	v.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := postgresflexUtils.ConfigureClient(ctx, &v.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	v.client = apiClient
+	tflog.Info(ctx, "Postgres Flex versions client configured")
+}
+
+func (v *versions) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Postgres Flex versions data source schema. Lists the versions that can be used for the `version` of an instance, sorted in ascending order.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID, structured as \"`project_id`,`region`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Postgres Flex versions data source region. If undefined, the provider region is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"timeouts": timeouts.Attributes(ctx),
+			"versions": schema.ListNestedAttribute{
+				Description: "List of versions available for the project.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Description: "Version identifier, as used in the `version` attribute of an instance.",
+							Computed:    true,
+						},
+						"beta": schema.BoolAttribute{
+							Description: "Whether the version is in beta.",
+							Computed:    true,
+						},
+						"deprecated": schema.StringAttribute{
+							Description: "Date from which on the version is deprecated. Empty if the version is not deprecated.",
+							Computed:    true,
+						},
+						"recommended": schema.BoolAttribute{
+							Description: "Whether the version is recommended for new instances.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (v *versions) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := model.Timeouts.Read(ctx, core.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	projectId := model.ProjectId.ValueString()
+	region := v.providerData.GetRegionWithOverride(model.Region)
+	model.Region = types.StringValue(region)
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": projectId,
+		"region":     region,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	versionsResp, err := v.client.DefaultAPI.ListVersions(ctx, projectId, region).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Reading versions", fmt.Sprintf("Calling ListVersions: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	if err := mapFields(versionsResp, &model); err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Reading versions", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Postgres Flex versions read")
+}
+
+func mapFields(resp *postgresflex.ListVersionResponse, m *model) error {
+	if resp == nil {
+		return fmt.Errorf("nil response")
+	}
+	if m == nil {
+		return fmt.Errorf("nil model")
+	}
+
+	m.ID = utils.BuildInternalTerraformId(m.ProjectId.ValueString(), m.Region.ValueString())
+	m.Versions = make([]version, 0, len(resp.Versions))
+
+	slices.SortFunc(resp.Versions, func(a, b postgresflex.Version) int {
+		return utils.CompareVersions(a.Id, b.Id)
+	})
+
+	for _, respVersion := range resp.Versions {
+		m.Versions = append(m.Versions, version{
+			Version:     types.StringValue(respVersion.Id),
+			Beta:        types.BoolValue(respVersion.Beta),
+			Deprecated:  types.StringValue(respVersion.Deprecated),
+			Recommended: types.BoolValue(respVersion.Recommend),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package versions
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *postgresflex.ListVersionResponse
+		state       *model
+		expected    *model
+		isValid     bool
+	}{
+		{
+			description: "default_values_and_sorting",
+			input: &postgresflex.ListVersionResponse{
+				Versions: []postgresflex.Version{
+					{Id: "17", Recommend: true},
+					{Id: "15", Deprecated: "2026-11-13"},
+					{Id: "16.4", Beta: true},
+				},
+			},
+			state: &model{
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+			},
+			expected: &model{
+				ID:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				Versions: []version{
+					{
+						Version:     types.StringValue("15"),
+						Beta:        types.BoolValue(false),
+						Deprecated:  types.StringValue("2026-11-13"),
+						Recommended: types.BoolValue(false),
+					},
+					{
+						Version:     types.StringValue("16.4"),
+						Beta:        types.BoolValue(true),
+						Deprecated:  types.StringValue(""),
+						Recommended: types.BoolValue(false),
+					},
+					{
+						Version:     types.StringValue("17"),
+						Beta:        types.BoolValue(false),
+						Deprecated:  types.StringValue(""),
+						Recommended: types.BoolValue(true),
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "empty_response",
+			input:       &postgresflex.ListVersionResponse{},
+			state: &model{
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+			},
+			expected: &model{
+				ID:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				Versions:  []version{},
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			state:       &model{},
+			expected:    &model{},
+			isValid:     false,
+		},
+		{
+			description: "nil_model",
+			input:       &postgresflex.ListVersionResponse{},
+			state:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.expected, tt.state)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
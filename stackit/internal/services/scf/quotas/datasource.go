@@ -0,0 +1,253 @@
+package quotas
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	scf "github.com/stackitcloud/stackit-sdk-go/services/scf/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	scfUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/scf/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &scfQuotasDataSource{}
+	_ datasource.DataSourceWithConfigure = &scfQuotasDataSource{}
+)
+
+// NewScfQuotasDataSource creates a new instance of the scfQuotasDataSource.
+func NewScfQuotasDataSource() datasource.DataSource {
+	return &scfQuotasDataSource{}
+}
+
+// scfQuotasDataSource is the datasource implementation.
+type scfQuotasDataSource struct {
+	client       *scf.APIClient
+	providerData core.ProviderData
+}
+
+type Model struct {
+	Id        types.String `tfsdk:"id"` // Required by Terraform
+	ProjectId types.String `tfsdk:"project_id"`
+	Region    types.String `tfsdk:"region"`
+	Quotas    []quota      `tfsdk:"quotas"`
+}
+
+type quota struct {
+	QuotaId               types.String `tfsdk:"quota_id"`
+	Name                  types.String `tfsdk:"name"`
+	Type                  types.String `tfsdk:"type"`
+	OrgId                 types.String `tfsdk:"org_id"`
+	TotalMemoryInMb       types.Int64  `tfsdk:"total_memory_in_mb"`
+	InstanceMemoryInMb    types.Int64  `tfsdk:"instance_memory_in_mb"`
+	TotalAppInstances     types.Int64  `tfsdk:"total_app_instances"`
+	TotalServiceInstances types.Int64  `tfsdk:"total_service_instances"`
+	TotalRoutes           types.Int64  `tfsdk:"total_routes"`
+	PaidServicesAllowed   types.Bool   `tfsdk:"paid_services_allowed"`
+}
+
+// descriptions for the attributes in the Schema
+var descriptions = map[string]string{
+	"id":                      "Terraform's internal data source ID, structured as \"`project_id`,`region`\".",
+	"project_id":              "The ID of the project associated with the quotas",
+	"region":                  "The region where the quotas are located. If not defined, the provider region is used",
+	"quotas":                  "List of the available quotas, ordered by type and name. Quotas can be used as `quota_id` of a `stackit_scf_organization` or `stackit_scf_space`.",
+	"quota_id":                "The ID of the quota",
+	"name":                    "The name of the quota",
+	"type":                    "The type of the quota, e.g. `organization` or `space`",
+	"org_id":                  "The ID of the Cloud Foundry Organization a space quota belongs to. Empty for organization quotas",
+	"total_memory_in_mb":      "The total memory available to all applications in MB. Unlimited if not set",
+	"instance_memory_in_mb":   "The maximum memory of a single application instance in MB. Unlimited if not set",
+	"total_app_instances":     "The maximum number of application instances. Unlimited if not set",
+	"total_service_instances": "The maximum number of service instances. Unlimited if not set",
+	"total_routes":            "The maximum number of routes. Unlimited if not set",
+	"paid_services_allowed":   "Whether paid service plans may be used",
+}
+
+func (s *scfQuotasDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	var ok bool
+	s.providerData, ok = conversion.ParseProviderData(ctx, request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := scfUtils.ConfigureClient(ctx, &s.providerData, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	s.client = apiClient
+	tflog.Info(ctx, "scf client configured for quotas")
+}
+
+func (s *scfQuotasDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nolint:gocritic // function signature required by Terraform
+	response.TypeName = request.ProviderTypeName + "_scf_quotas"
+}
+
+func (s *scfQuotasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) { // nolint:gocritic // function signature required by Terraform
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: descriptions["region"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"quotas": schema.ListNestedAttribute{
+				Description: descriptions["quotas"],
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"quota_id": schema.StringAttribute{
+							Description: descriptions["quota_id"],
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: descriptions["name"],
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: descriptions["type"],
+							Computed:    true,
+						},
+						"org_id": schema.StringAttribute{
+							Description: descriptions["org_id"],
+							Computed:    true,
+						},
+						"total_memory_in_mb": schema.Int64Attribute{
+							Description: descriptions["total_memory_in_mb"],
+							Computed:    true,
+						},
+						"instance_memory_in_mb": schema.Int64Attribute{
+							Description: descriptions["instance_memory_in_mb"],
+							Computed:    true,
+						},
+						"total_app_instances": schema.Int64Attribute{
+							Description: descriptions["total_app_instances"],
+							Computed:    true,
+						},
+						"total_service_instances": schema.Int64Attribute{
+							Description: descriptions["total_service_instances"],
+							Computed:    true,
+						},
+						"total_routes": schema.Int64Attribute{
+							Description: descriptions["total_routes"],
+							Computed:    true,
+						},
+						"paid_services_allowed": schema.BoolAttribute{
+							Description: descriptions["paid_services_allowed"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Description: "STACKIT Cloud Foundry quotas datasource schema. Lists the organization and space quotas of a project.",
+	}
+}
+
+func (s *scfQuotasDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := request.Config.Get(ctx, &model)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := s.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	scfQuotasResponse, err := s.client.DefaultAPI.ListQuotas(ctx, projectId, region).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&response.Diagnostics,
+			err,
+			"Reading scf quotas",
+			fmt.Sprintf("Quotas for project %q could not be listed.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(scfQuotasResponse, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error reading scf quotas", fmt.Sprintf("Processing API response: %v", err))
+		return
+	}
+
+	// Set the updated state.
+	diags = response.State.Set(ctx, &model)
+	response.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "read scf quotas")
+}
+
+// mapFields maps a SCF quota list response to the model.
+func mapFields(response *scf.QuotaList, model *Model, region string) error {
+	if response == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region)
+	model.Region = types.StringValue(region)
+
+	quotas := slices.Clone(response.Resources)
+	slices.SortStableFunc(quotas, func(a, b scf.Quota) int {
+		return cmp.Or(
+			cmp.Compare(a.Type, b.Type),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Guid, b.Guid),
+		)
+	})
+
+	model.Quotas = make([]quota, 0, len(quotas))
+	for i := range quotas {
+		model.Quotas = append(model.Quotas, quota{
+			QuotaId:               types.StringValue(quotas[i].Guid),
+			Name:                  types.StringValue(quotas[i].Name),
+			Type:                  types.StringValue(quotas[i].Type),
+			OrgId:                 types.StringPointerValue(quotas[i].OrgId),
+			TotalMemoryInMb:       types.Int64PointerValue(quotas[i].TotalMemoryInMb),
+			InstanceMemoryInMb:    types.Int64PointerValue(quotas[i].InstanceMemoryInMb),
+			TotalAppInstances:     types.Int64PointerValue(quotas[i].TotalAppInstances),
+			TotalServiceInstances: types.Int64PointerValue(quotas[i].TotalServiceInstances),
+			TotalRoutes:           types.Int64PointerValue(quotas[i].TotalRoutes),
+			PaidServicesAllowed:   types.BoolValue(quotas[i].PaidServicesAllowed),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package quotas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	scf "github.com/stackitcloud/stackit-sdk-go/services/scf/v1api"
+)
+
+var (
+	testProjectId  = uuid.New().String()
+	testOrgId      = uuid.New().String()
+	testOrgQuotaId = uuid.New().String()
+	testQuotaId    = uuid.New().String()
+	testRegion     = "eu01"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *scf.QuotaList
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			input:       &scf.QuotaList{},
+			expected: &Model{
+				Id:        types.StringValue(fmt.Sprintf("%s,%s", testProjectId, testRegion)),
+				ProjectId: types.StringValue(testProjectId),
+				Region:    types.StringValue(testRegion),
+				Quotas:    []quota{},
+			},
+			isValid: true,
+		},
+		{
+			description: "sorted_by_type_and_name",
+			input: &scf.QuotaList{
+				Resources: []scf.Quota{
+					{
+						Guid:                  testQuotaId,
+						Name:                  "space-small",
+						Type:                  "space",
+						OrgId:                 new(testOrgId),
+						TotalMemoryInMb:       new(int64(2048)),
+						InstanceMemoryInMb:    new(int64(1024)),
+						TotalAppInstances:     new(int64(10)),
+						TotalServiceInstances: new(int64(5)),
+						TotalRoutes:           new(int64(20)),
+						PaidServicesAllowed:   true,
+					},
+					{
+						Guid: testOrgQuotaId,
+						Name: "default",
+						Type: "organization",
+					},
+				},
+			},
+			expected: &Model{
+				Id:        types.StringValue(fmt.Sprintf("%s,%s", testProjectId, testRegion)),
+				ProjectId: types.StringValue(testProjectId),
+				Region:    types.StringValue(testRegion),
+				Quotas: []quota{
+					{
+						QuotaId:               types.StringValue(testOrgQuotaId),
+						Name:                  types.StringValue("default"),
+						Type:                  types.StringValue("organization"),
+						OrgId:                 types.StringNull(),
+						TotalMemoryInMb:       types.Int64Null(),
+						InstanceMemoryInMb:    types.Int64Null(),
+						TotalAppInstances:     types.Int64Null(),
+						TotalServiceInstances: types.Int64Null(),
+						TotalRoutes:           types.Int64Null(),
+						PaidServicesAllowed:   types.BoolValue(false),
+					},
+					{
+						QuotaId:               types.StringValue(testQuotaId),
+						Name:                  types.StringValue("space-small"),
+						Type:                  types.StringValue("space"),
+						OrgId:                 types.StringValue(testOrgId),
+						TotalMemoryInMb:       types.Int64Value(2048),
+						InstanceMemoryInMb:    types.Int64Value(1024),
+						TotalAppInstances:     types.Int64Value(10),
+						TotalServiceInstances: types.Int64Value(5),
+						TotalRoutes:           types.Int64Value(20),
+						PaidServicesAllowed:   types.BoolValue(true),
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId: types.StringValue(testProjectId),
+			}
+			err := mapFields(tt.input, state, testRegion)
+
+			if tt.isValid && err != nil {
+				t.Fatalf("expected success, got error: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if tt.isValid {
+				if diff := cmp.Diff(tt.expected, state); diff != "" {
+					t.Errorf("unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
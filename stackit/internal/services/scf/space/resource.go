@@ -0,0 +1,497 @@
+package space
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	scf "github.com/stackitcloud/stackit-sdk-go/services/scf/v1api"
+	"github.com/stackitcloud/stackit-sdk-go/services/scf/v1api/wait"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	scfUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/scf/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &scfSpaceResource{}
+	_ resource.ResourceWithConfigure   = &scfSpaceResource{}
+	_ resource.ResourceWithImportState = &scfSpaceResource{}
+	_ resource.ResourceWithModifyPlan  = &scfSpaceResource{}
+)
+
+type Model struct {
+	Id         types.String `tfsdk:"id"` // Required by Terraform
+	CreateAt   types.String `tfsdk:"created_at"`
+	Name       types.String `tfsdk:"name"`
+	OrgId      types.String `tfsdk:"org_id"`
+	PlatformId types.String `tfsdk:"platform_id"`
+	ProjectId  types.String `tfsdk:"project_id"`
+	QuotaId    types.String `tfsdk:"quota_id"`
+	Region     types.String `tfsdk:"region"`
+	SpaceId    types.String `tfsdk:"space_id"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+// NewScfSpaceResource is a helper function to create a new scf space resource.
+func NewScfSpaceResource() resource.Resource {
+	return &scfSpaceResource{}
+}
+
+// scfSpaceResource implements the resource interface for scf space.
+type scfSpaceResource struct {
+	client       *scf.APIClient
+	providerData core.ProviderData
+}
+
+// descriptions for the attributes in the Schema
+var descriptions = map[string]string{
+	"id":          "Terraform's internal resource ID, structured as \"`project_id`,`region`,`space_id`\".",
+	"created_at":  "The time when the space was created",
+	"name":        "The name of the space",
+	"org_id":      "The ID of the Cloud Foundry Organization the space belongs to",
+	"platform_id": "The ID of the platform associated with the space",
+	"project_id":  "The ID of the project associated with the space",
+	"quota_id":    "The ID of the space quota associated with the space. The quota must be defined in the organization of the space, see the `stackit_scf_quotas` data source",
+	"region":      "The resource region. If not defined, the provider region is used",
+	"space_id":    "The ID of the Cloud Foundry Space",
+	"updated_at":  "The time when the space was last updated",
+}
+
+func (s *scfSpaceResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	var ok bool
+	s.providerData, ok = conversion.ParseProviderData(ctx, request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := scfUtils.ConfigureClient(ctx, &s.providerData, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	s.client = apiClient
+	tflog.Info(ctx, "scf client configured")
+}
+
+func (s *scfSpaceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_scf_space"
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (s *scfSpaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, s.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *scfSpaceResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "STACKIT Cloud Foundry space resource schema. Must have a `region` specified in the provider configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: descriptions["created_at"],
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: descriptions["name"],
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: descriptions["org_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"platform_id": schema.StringAttribute{
+				Description: descriptions["platform_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"quota_id": schema.StringAttribute{
+				Description: descriptions["quota_id"],
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: descriptions["region"],
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"space_id": schema.StringAttribute{
+				Description: descriptions["space_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: descriptions["updated_at"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (s *scfSpaceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve the planned values for the resource.
+	var model Model
+	diags := request.Plan.Get(ctx, &model)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	region := model.Region.ValueString()
+	projectId := model.ProjectId.ValueString()
+	orgId := model.OrgId.ValueString()
+	quotaId := model.QuotaId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "org_id", orgId)
+	ctx = tflog.SetField(ctx, "space_name", model.Name.ValueString())
+	ctx = tflog.SetField(ctx, "region", region)
+
+	payload, err := toCreatePayload(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error creating scf space", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	// Create the new scf space via the API client.
+	scfSpaceCreateResponse, err := s.client.DefaultAPI.CreateSpace(ctx, projectId, region).
+		CreateSpacePayload(payload).
+		Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error creating scf space", fmt.Sprintf("Calling API to create space: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	spaceId := scfSpaceCreateResponse.Guid
+
+	ctx = utils.SetAndLogStateFields(ctx, &response.Diagnostics, &response.State, map[string]any{
+		"project_id": projectId,
+		"region":     region,
+		"space_id":   spaceId,
+	})
+
+	// Apply the space quota if provided
+	if quotaId != "" {
+		_, err := s.client.DefaultAPI.ApplySpaceQuota(ctx, projectId, region, spaceId).ApplySpaceQuotaPayload(
+			scf.ApplySpaceQuotaPayload{
+				QuotaId: quotaId,
+			}).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &response.Diagnostics, "Error creating scf space", fmt.Sprintf("Calling API to apply quota: %v", err))
+			return
+		}
+	}
+
+	// Load the newly created scf space
+	scfSpaceResponse, err := s.client.DefaultAPI.GetSpace(ctx, projectId, region, spaceId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error creating scf space", fmt.Sprintf("Calling API to load created space: %v", err))
+		return
+	}
+
+	err = mapFields(scfSpaceResponse, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error creating scf space", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set the state with fully populated data.
+	diags = response.State.Set(ctx, model)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Scf space created")
+}
+
+// Read refreshes the Terraform state with the latest scf space data.
+func (s *scfSpaceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve the current state of the resource.
+	var model Model
+	diags := request.State.Get(ctx, &model)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	spaceId := model.SpaceId.ValueString()
+	region := s.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "space_id", spaceId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	scfSpaceResponse, err := s.client.DefaultAPI.GetSpace(ctx, projectId, region, spaceId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error reading scf space", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(scfSpaceResponse, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error reading scf space", fmt.Sprintf("Processing API response: %v", err))
+		return
+	}
+
+	// Set the updated state.
+	diags = response.State.Set(ctx, &model)
+	response.Diagnostics.Append(diags...)
+	tflog.Info(ctx, fmt.Sprintf("read scf space %s", spaceId))
+}
+
+// Update renames the space and applies a changed space quota.
+func (s *scfSpaceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := request.Plan.Get(ctx, &model)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	region := model.Region.ValueString()
+	projectId := model.ProjectId.ValueString()
+	spaceId := model.SpaceId.ValueString()
+	name := model.Name.ValueString()
+	quotaId := model.QuotaId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "space_id", spaceId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	space, err := s.client.DefaultAPI.GetSpace(ctx, projectId, region, spaceId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error retrieving space state", fmt.Sprintf("Getting space state: %v", err))
+		return
+	}
+
+	// handle a change of the space name
+	if name != space.GetName() {
+		updatedSpace, err := s.client.DefaultAPI.UpdateSpace(ctx, projectId, region, spaceId).UpdateSpacePayload(
+			scf.UpdateSpacePayload{
+				Name: &name,
+			}).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &response.Diagnostics, "Error updating space", fmt.Sprintf("Processing API payload: %v", err))
+			return
+		}
+		space = updatedSpace
+
+		ctx = core.LogResponse(ctx)
+	}
+
+	// handle a quota change of the space
+	if quotaId != space.GetQuotaId() {
+		applySpaceQuota, err := s.client.DefaultAPI.ApplySpaceQuota(ctx, projectId, region, spaceId).ApplySpaceQuotaPayload(
+			scf.ApplySpaceQuotaPayload{
+				QuotaId: quotaId,
+			}).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &response.Diagnostics, "Error applying space quota", fmt.Sprintf("Processing API payload: %v", err))
+			return
+		}
+		space.QuotaId = applySpaceQuota.QuotaId
+	}
+
+	err = mapFields(space, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error updating space", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = response.State.Set(ctx, model)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "space updated")
+}
+
+// Delete deletes the scf space and removes it from the Terraform state on success.
+func (s *scfSpaceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve current state of the resource.
+	var model Model
+	diags := request.State.Get(ctx, &model)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	spaceId := model.SpaceId.ValueString()
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "space_id", spaceId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	// Call API to delete the existing scf space.
+	_, err := s.client.DefaultAPI.DeleteSpace(ctx, projectId, region, spaceId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error deleting scf space", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	_, err = wait.DeleteSpaceWaitHandler(ctx, s.client.DefaultAPI, projectId, region, spaceId).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error waiting for scf space deletion", fmt.Sprintf("SCFSpace deleting waiting: %v", err))
+		return
+	}
+
+	tflog.Info(ctx, "Scf space deleted")
+}
+
+func (s *scfSpaceResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	// Split the import identifier to extract project ID, region and space ID.
+	idParts := strings.Split(request.ID, core.Separator)
+
+	// Ensure the import identifier format is correct.
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		core.LogAndAddError(ctx, &response.Diagnostics,
+			"Error importing scf space",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[space_id]  Got: %q", request.ID),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &response.Diagnostics, &response.State, map[string]any{
+		"project_id": idParts[0],
+		"region":     idParts[1],
+		"space_id":   idParts[2],
+	})
+	tflog.Info(ctx, "Scf space state imported")
+}
+
+// mapFields maps a SCF Space response to the model.
+func mapFields(response *scf.Space, model *Model) error {
+	if response == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(response.ProjectId, response.Region, response.Guid)
+	model.ProjectId = types.StringValue(response.ProjectId)
+	model.Region = types.StringValue(response.Region)
+	model.PlatformId = types.StringValue(response.PlatformId)
+	model.OrgId = types.StringValue(response.OrgId)
+	model.SpaceId = types.StringValue(response.Guid)
+	model.Name = types.StringValue(response.Name)
+	model.QuotaId = types.StringValue(response.QuotaId)
+	model.CreateAt = types.StringValue(response.CreatedAt.String())
+	model.UpdatedAt = types.StringValue(response.UpdatedAt.String())
+	return nil
+}
+
+// toCreatePayload creates the payload to create a scf space
+func toCreatePayload(model *Model) (scf.CreateSpacePayload, error) {
+	if model == nil {
+		return scf.CreateSpacePayload{}, fmt.Errorf("nil model")
+	}
+
+	return scf.CreateSpacePayload{
+		Name:  model.Name.ValueString(),
+		OrgId: model.OrgId.ValueString(),
+	}, nil
+}
@@ -0,0 +1,158 @@
+package space
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	scf "github.com/stackitcloud/stackit-sdk-go/services/scf/v1api"
+)
+
+var (
+	testSpaceId    = uuid.New().String()
+	testOrgId      = uuid.New().String()
+	testProjectId  = uuid.New().String()
+	testPlatformId = uuid.New().String()
+	testQuotaId    = uuid.New().String()
+	testRegion     = "eu01"
+)
+
+func TestMapFields(t *testing.T) {
+	createdTime, err := time.Parse("2006-01-02 15:04:05 -0700 MST", "2025-01-01 00:00:00 +0000 UTC")
+	if err != nil {
+		t.Fatalf("failed to parse test time: %v", err)
+	}
+
+	tests := []struct {
+		description string
+		input       *scf.Space
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			description: "minimal_input",
+			input: &scf.Space{
+				Guid:      testSpaceId,
+				Name:      "scf-space-min",
+				OrgId:     testOrgId,
+				Region:    testRegion,
+				ProjectId: testProjectId,
+				CreatedAt: createdTime,
+				UpdatedAt: createdTime,
+			},
+			expected: &Model{
+				Id:         types.StringValue(fmt.Sprintf("%s,%s,%s", testProjectId, testRegion, testSpaceId)),
+				ProjectId:  types.StringValue(testProjectId),
+				Region:     types.StringValue(testRegion),
+				Name:       types.StringValue("scf-space-min"),
+				OrgId:      types.StringValue(testOrgId),
+				PlatformId: types.StringValue(""),
+				SpaceId:    types.StringValue(testSpaceId),
+				QuotaId:    types.StringValue(""),
+				CreateAt:   types.StringValue("2025-01-01 00:00:00 +0000 UTC"),
+				UpdatedAt:  types.StringValue("2025-01-01 00:00:00 +0000 UTC"),
+			},
+			isValid: true,
+		},
+		{
+			description: "max_input",
+			input: &scf.Space{
+				Guid:       testSpaceId,
+				Name:       "scf-space-max",
+				OrgId:      testOrgId,
+				PlatformId: testPlatformId,
+				ProjectId:  testProjectId,
+				QuotaId:    testQuotaId,
+				Region:     testRegion,
+				CreatedAt:  createdTime,
+				UpdatedAt:  createdTime,
+			},
+			expected: &Model{
+				Id:         types.StringValue(fmt.Sprintf("%s,%s,%s", testProjectId, testRegion, testSpaceId)),
+				ProjectId:  types.StringValue(testProjectId),
+				Region:     types.StringValue(testRegion),
+				Name:       types.StringValue("scf-space-max"),
+				OrgId:      types.StringValue(testOrgId),
+				PlatformId: types.StringValue(testPlatformId),
+				SpaceId:    types.StringValue(testSpaceId),
+				QuotaId:    types.StringValue(testQuotaId),
+				CreateAt:   types.StringValue("2025-01-01 00:00:00 +0000 UTC"),
+				UpdatedAt:  types.StringValue("2025-01-01 00:00:00 +0000 UTC"),
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_space",
+			input:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{}
+			err := mapFields(tt.input, state)
+
+			if tt.isValid && err != nil {
+				t.Fatalf("expected success, got error: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if tt.isValid {
+				if diff := cmp.Diff(tt.expected, state); diff != "" {
+					t.Errorf("unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    scf.CreateSpacePayload
+		expectError bool
+	}{
+		{
+			description: "default values",
+			input: &Model{
+				Name:  types.StringValue("example-space"),
+				OrgId: types.StringValue(testOrgId),
+			},
+			expected: scf.CreateSpacePayload{
+				Name:  "example-space",
+				OrgId: testOrgId,
+			},
+			expectError: false,
+		},
+		{
+			description: "nil input model",
+			input:       nil,
+			expected:    scf.CreateSpacePayload{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toCreatePayload(tt.input)
+
+			if tt.expectError && err == nil {
+				t.Fatalf("expected diagnostics error but got none")
+			}
+
+			if !tt.expectError && err != nil {
+				t.Fatalf("unexpected diagnostics error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.expected, output); diff != "" {
+				t.Fatalf("unexpected payload (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
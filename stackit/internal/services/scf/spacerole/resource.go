@@ -0,0 +1,408 @@
+package spacerole
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	scf "github.com/stackitcloud/stackit-sdk-go/services/scf/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	scfUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/scf/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &scfSpaceRoleResource{}
+	_ resource.ResourceWithConfigure   = &scfSpaceRoleResource{}
+	_ resource.ResourceWithImportState = &scfSpaceRoleResource{}
+	_ resource.ResourceWithModifyPlan  = &scfSpaceRoleResource{}
+)
+
+var spaceRoleTypes = []string{"space_developer", "space_manager", "space_auditor", "space_supporter"}
+
+type Model struct {
+	Id        types.String `tfsdk:"id"` // Required by Terraform
+	Region    types.String `tfsdk:"region"`
+	ProjectId types.String `tfsdk:"project_id"`
+	OrgId     types.String `tfsdk:"org_id"`
+	SpaceId   types.String `tfsdk:"space_id"`
+	RoleId    types.String `tfsdk:"role_id"`
+	UserId    types.String `tfsdk:"user_id"`
+	Type      types.String `tfsdk:"type"`
+	CreateAt  types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// NewScfSpaceRoleResource is a helper function to create a new scf space role resource.
+func NewScfSpaceRoleResource() resource.Resource {
+	return &scfSpaceRoleResource{}
+}
+
+// scfSpaceRoleResource implements the resource interface for scf space roles.
+type scfSpaceRoleResource struct {
+	client       *scf.APIClient
+	providerData core.ProviderData
+}
+
+// descriptions for the attributes in the Schema
+var descriptions = map[string]string{
+	"id":         "Terraform's internal resource ID, structured as \"`project_id`,`region`,`space_id`,`role_id`\".",
+	"region":     "The region where the space of the role is located. If not defined, the provider region is used",
+	"project_id": "The ID of the project associated with the space of the role",
+	"org_id":     "The ID of the Cloud Foundry Organization of the space",
+	"space_id":   "The ID of the Cloud Foundry Space",
+	"role_id":    "The ID of the role assignment",
+	"user_id":    "The ID of the Cloud Foundry user the role is assigned to, e.g. the `user_id` of a `stackit_scf_organization_manager`",
+	"type":       fmt.Sprintf("The type of the role. %s", utils.FormatPossibleValues(spaceRoleTypes...)),
+	"created_at": "The time when the role was assigned",
+	"updated_at": "The time when the role was last updated",
+}
+
+func (s *scfSpaceRoleResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) { // nolint:gocritic // function signature required by Terraform
+	var ok bool
+	s.providerData, ok = conversion.ParseProviderData(ctx, request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := scfUtils.ConfigureClient(ctx, &s.providerData, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	s.client = apiClient
+	tflog.Info(ctx, "scf client configured")
+}
+
+func (s *scfSpaceRoleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nolint:gocritic // function signature required by Terraform
+	response.TypeName = request.ProviderTypeName + "_scf_space_role"
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (s *scfSpaceRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, s.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *scfSpaceRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) { // nolint:gocritic // function signature required by Terraform
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: descriptions["region"],
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: descriptions["org_id"],
+				Computed:    true,
+			},
+			"space_id": schema.StringAttribute{
+				Description: descriptions["space_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: descriptions["role_id"],
+				Computed:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: descriptions["user_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: descriptions["type"],
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(spaceRoleTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: descriptions["created_at"],
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: descriptions["updated_at"],
+				Computed:    true,
+			},
+		},
+		Description: "STACKIT Cloud Foundry space role resource schema. Assigns a space role to a Cloud Foundry user.",
+	}
+}
+
+func (s *scfSpaceRoleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve the planned values for the resource.
+	var model Model
+	diags := request.Plan.Get(ctx, &model)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	spaceId := model.SpaceId.ValueString()
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "space_id", spaceId)
+	ctx = tflog.SetField(ctx, "user_id", model.UserId.ValueString())
+	ctx = tflog.SetField(ctx, "region", region)
+
+	payload, err := toCreatePayload(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error creating scf space role", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	// Assign the space role via the API client.
+	scfSpaceRoleResponse, err := s.client.DefaultAPI.CreateSpaceRole(ctx, projectId, region, spaceId).CreateSpaceRolePayload(payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error creating scf space role", fmt.Sprintf("Calling API to create space role: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	ctx = utils.SetAndLogStateFields(ctx, &response.Diagnostics, &response.State, map[string]any{
+		"project_id": projectId,
+		"region":     region,
+		"space_id":   spaceId,
+		"role_id":    scfSpaceRoleResponse.Guid,
+	})
+
+	err = mapFields(scfSpaceRoleResponse, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error creating scf space role", fmt.Sprintf("Mapping fields: %v", err))
+		return
+	}
+
+	// Set the state with fully populated data.
+	diags = response.State.Set(ctx, model)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Scf space role created")
+}
+
+func (s *scfSpaceRoleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve the current state of the resource.
+	var model Model
+	diags := request.State.Get(ctx, &model)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	spaceId := model.SpaceId.ValueString()
+	roleId := model.RoleId.ValueString()
+	region := s.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "space_id", spaceId)
+	ctx = tflog.SetField(ctx, "role_id", roleId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	scfSpaceRoleResponse, err := s.client.DefaultAPI.GetSpaceRole(ctx, projectId, region, spaceId, roleId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			core.LogAndAddWarning(ctx, &response.Diagnostics, "SCF space role not found", "SCF space role not found, remove from state")
+			response.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error reading scf space role", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(scfSpaceRoleResponse, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error reading scf space role", fmt.Sprintf("Processing API response: %v", err))
+		return
+	}
+
+	// Set the updated state.
+	diags = response.State.Set(ctx, &model)
+	response.Diagnostics.Append(diags...)
+	tflog.Info(ctx, fmt.Sprintf("read scf space role %s", roleId))
+}
+
+func (s *scfSpaceRoleResource) Update(ctx context.Context, _ resource.UpdateRequest, response *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// space roles cannot be updated, all configurable attributes require a replacement.
+	core.LogAndAddError(ctx, &response.Diagnostics, "Error updating space role", "Space role can't be updated")
+}
+
+func (s *scfSpaceRoleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve current state of the resource.
+	var model Model
+	diags := request.State.Get(ctx, &model)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	spaceId := model.SpaceId.ValueString()
+	roleId := model.RoleId.ValueString()
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "space_id", spaceId)
+	ctx = tflog.SetField(ctx, "role_id", roleId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	// Call API to remove the space role.
+	_, err := s.client.DefaultAPI.DeleteSpaceRole(ctx, projectId, region, spaceId, roleId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && (oapiErr.StatusCode == http.StatusGone || oapiErr.StatusCode == http.StatusNotFound) {
+			tflog.Info(ctx, "Scf space role was already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &response.Diagnostics, "Error deleting scf space role", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	tflog.Info(ctx, "Scf space role deleted")
+}
+
+func (s *scfSpaceRoleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Split the import identifier to extract project ID, region, space ID and role ID.
+	idParts := strings.Split(request.ID, core.Separator)
+
+	// Ensure the import identifier format is correct.
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		core.LogAndAddError(ctx, &response.Diagnostics,
+			"Error importing scf space role",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[space_id],[role_id]  Got: %q", request.ID),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &response.Diagnostics, &response.State, map[string]any{
+		"project_id": idParts[0],
+		"region":     idParts[1],
+		"space_id":   idParts[2],
+		"role_id":    idParts[3],
+	})
+	tflog.Info(ctx, "Scf space role state imported")
+}
+
+// mapFields maps a SCF space role response to the model.
+func mapFields(response *scf.SpaceRole, model *Model) error {
+	if response == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if roleId := model.RoleId.ValueString(); roleId != "" && roleId != response.Guid {
+		return fmt.Errorf("role id mismatch in response and model")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(response.ProjectId, response.Region, response.SpaceId, response.Guid)
+	model.Region = types.StringValue(response.Region)
+	model.ProjectId = types.StringValue(response.ProjectId)
+	model.OrgId = types.StringValue(response.OrgId)
+	model.SpaceId = types.StringValue(response.SpaceId)
+	model.RoleId = types.StringValue(response.Guid)
+	model.UserId = types.StringValue(response.UserId)
+	model.Type = types.StringValue(response.Type)
+	model.CreateAt = types.StringValue(response.CreatedAt.String())
+	model.UpdatedAt = types.StringValue(response.UpdatedAt.String())
+	return nil
+}
+
+// toCreatePayload creates the payload to assign a space role
+func toCreatePayload(model *Model) (scf.CreateSpaceRolePayload, error) {
+	if model == nil {
+		return scf.CreateSpaceRolePayload{}, fmt.Errorf("nil model")
+	}
+
+	return scf.CreateSpaceRolePayload{
+		Type:   model.Type.ValueString(),
+		UserId: model.UserId.ValueString(),
+	}, nil
+}
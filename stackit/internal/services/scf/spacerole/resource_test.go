@@ -0,0 +1,150 @@
+package spacerole
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	scf "github.com/stackitcloud/stackit-sdk-go/services/scf/v1api"
+)
+
+var (
+	testRoleId    = uuid.New().String()
+	testSpaceId   = uuid.New().String()
+	testOrgId     = uuid.New().String()
+	testUserId    = uuid.New().String()
+	testProjectId = uuid.New().String()
+	testRegion    = "eu01"
+)
+
+func TestMapFields(t *testing.T) {
+	createdTime, err := time.Parse("2006-01-02 15:04:05 -0700 MST", "2025-01-01 00:00:00 +0000 UTC")
+	if err != nil {
+		t.Fatalf("failed to parse test time: %v", err)
+	}
+
+	tests := []struct {
+		description string
+		input       *scf.SpaceRole
+		state       *Model
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			description: "valid_input",
+			input: &scf.SpaceRole{
+				Guid:      testRoleId,
+				Type:      "space_developer",
+				UserId:    testUserId,
+				SpaceId:   testSpaceId,
+				OrgId:     testOrgId,
+				ProjectId: testProjectId,
+				Region:    testRegion,
+				CreatedAt: createdTime,
+				UpdatedAt: createdTime,
+			},
+			state: &Model{},
+			expected: &Model{
+				Id:        types.StringValue(fmt.Sprintf("%s,%s,%s,%s", testProjectId, testRegion, testSpaceId, testRoleId)),
+				Region:    types.StringValue(testRegion),
+				ProjectId: types.StringValue(testProjectId),
+				OrgId:     types.StringValue(testOrgId),
+				SpaceId:   types.StringValue(testSpaceId),
+				RoleId:    types.StringValue(testRoleId),
+				UserId:    types.StringValue(testUserId),
+				Type:      types.StringValue("space_developer"),
+				CreateAt:  types.StringValue("2025-01-01 00:00:00 +0000 UTC"),
+				UpdatedAt: types.StringValue("2025-01-01 00:00:00 +0000 UTC"),
+			},
+			isValid: true,
+		},
+		{
+			description: "role_id_mismatch",
+			input: &scf.SpaceRole{
+				Guid: testRoleId,
+			},
+			state: &Model{
+				RoleId: types.StringValue(uuid.New().String()),
+			},
+			isValid: false,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			state:       &Model{},
+			isValid:     false,
+		},
+		{
+			description: "nil_model",
+			input:       &scf.SpaceRole{},
+			state:       nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+
+			if tt.isValid && err != nil {
+				t.Fatalf("expected success, got error: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if tt.isValid {
+				if diff := cmp.Diff(tt.expected, tt.state); diff != "" {
+					t.Errorf("unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    scf.CreateSpaceRolePayload
+		expectError bool
+	}{
+		{
+			description: "default values",
+			input: &Model{
+				Type:   types.StringValue("space_manager"),
+				UserId: types.StringValue(testUserId),
+			},
+			expected: scf.CreateSpaceRolePayload{
+				Type:   "space_manager",
+				UserId: testUserId,
+			},
+			expectError: false,
+		},
+		{
+			description: "nil input model",
+			input:       nil,
+			expected:    scf.CreateSpaceRolePayload{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toCreatePayload(tt.input)
+
+			if tt.expectError && err == nil {
+				t.Fatalf("expected diagnostics error but got none")
+			}
+
+			if !tt.expectError && err != nil {
+				t.Fatalf("unexpected diagnostics error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.expected, output); diff != "" {
+				t.Fatalf("unexpected payload (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
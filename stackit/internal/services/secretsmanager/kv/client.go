@@ -0,0 +1,256 @@
+// Package kv implements a minimal client for the Vault-compatible KV v2 endpoint of STACKIT Secrets Manager instances.
+// Each instance is mounted as a KV v2 secrets engine at the path of its instance ID.
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultEndpointFormat is the endpoint of the KV API for a region.
+const DefaultEndpointFormat = "https://prod.sm.%s.stackit.cloud"
+
+const defaultTimeout = 30 * time.Second
+
+// Error is returned for failed requests to the KV API.
+type Error struct {
+	StatusCode int
+	Errors     []string
+}
+
+func (e *Error) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("KV API returned status code %d", e.StatusCode)
+	}
+	return fmt.Sprintf("KV API returned status code %d: %s", e.StatusCode, strings.Join(e.Errors, "; "))
+}
+
+// IsNotFound returns whether the error is a 404 response of the KV API.
+func IsNotFound(err error) bool {
+	var kvErr *Error
+	return errors.As(err, &kvErr) && kvErr.StatusCode == http.StatusNotFound
+}
+
+// Secret is a version of a secret.
+type Secret struct {
+	// Data holds the values of the secret. Values which aren't strings, e.g. numbers or objects written by other
+	// clients, are returned as compact JSON.
+	Data    map[string]string
+	Version int64
+}
+
+// Metadata describes all versions of a secret.
+type Metadata struct {
+	CurrentVersion int64
+	// Destroyed states whether the current version was deleted or destroyed.
+	Destroyed bool
+}
+
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+	token      string
+}
+
+// NewClient creates a client for the given endpoint. If httpClient is nil, a client with a default timeout is used.
+func NewClient(endpoint string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Client{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// Login authenticates with the credentials of a Secrets Manager user via the userpass auth method.
+func (c *Client) Login(ctx context.Context, username, password string) error {
+	var resp struct {
+		Auth *struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	err := c.do(ctx, http.MethodPost, "/v1/auth/userpass/login/"+url.PathEscape(username), map[string]string{"password": password}, &resp)
+	if err != nil {
+		return err
+	}
+	if resp.Auth == nil || resp.Auth.ClientToken == "" {
+		return fmt.Errorf("login response contains no client token")
+	}
+	c.token = resp.Auth.ClientToken
+	return nil
+}
+
+// WriteSecret creates a new version of the secret and returns its version number.
+// If cas is not nil, the write only succeeds if the current version of the secret matches it (0 for new secrets).
+func (c *Client) WriteSecret(ctx context.Context, mount, path string, data map[string]string, cas *int64) (int64, error) {
+	body := map[string]any{"data": data}
+	if cas != nil {
+		body["options"] = map[string]any{"cas": *cas}
+	}
+	var resp struct {
+		Data *struct {
+			Version int64 `json:"version"`
+		} `json:"data"`
+	}
+	err := c.do(ctx, http.MethodPost, secretPath(mount, "data", path), body, &resp)
+	if err != nil {
+		return 0, err
+	}
+	if resp.Data == nil {
+		return 0, fmt.Errorf("write response contains no data")
+	}
+	return resp.Data.Version, nil
+}
+
+// ReadSecret reads a version of the secret. If version is 0, the current version is read.
+func (c *Client) ReadSecret(ctx context.Context, mount, path string, version int64) (*Secret, error) {
+	p := secretPath(mount, "data", path)
+	if version > 0 {
+		p += "?version=" + strconv.FormatInt(version, 10)
+	}
+	var resp struct {
+		Data *struct {
+			Data     map[string]json.RawMessage `json:"data"`
+			Metadata struct {
+				Version int64 `json:"version"`
+			} `json:"metadata"`
+		} `json:"data"`
+	}
+	err := c.do(ctx, http.MethodGet, p, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	// deleted versions are returned with empty data
+	if resp.Data == nil || resp.Data.Data == nil {
+		return nil, &Error{StatusCode: http.StatusNotFound, Errors: []string{"secret version has been deleted"}}
+	}
+	data, err := decodeData(resp.Data.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &Secret{
+		Data:    data,
+		Version: resp.Data.Metadata.Version,
+	}, nil
+}
+
+// decodeData unquotes the string values of a secret and keeps all other values as compact JSON.
+func decodeData(raw map[string]json.RawMessage) (map[string]string, error) {
+	data := make(map[string]string, len(raw))
+	for key, value := range raw {
+		if len(value) > 0 && value[0] == '"' {
+			var s string
+			if err := json.Unmarshal(value, &s); err != nil {
+				return nil, fmt.Errorf("decoding value of key %q: %w", key, err)
+			}
+			data[key] = s
+			continue
+		}
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, value); err != nil {
+			return nil, fmt.Errorf("decoding value of key %q: %w", key, err)
+		}
+		data[key] = buf.String()
+	}
+	return data, nil
+}
+
+// ReadMetadata reads the metadata of the secret, without reading any secret values.
+func (c *Client) ReadMetadata(ctx context.Context, mount, path string) (*Metadata, error) {
+	var resp struct {
+		Data *struct {
+			CurrentVersion int64 `json:"current_version"`
+			Versions       map[string]struct {
+				DeletionTime string `json:"deletion_time"`
+				Destroyed    bool   `json:"destroyed"`
+			} `json:"versions"`
+		} `json:"data"`
+	}
+	err := c.do(ctx, http.MethodGet, secretPath(mount, "metadata", path), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, fmt.Errorf("metadata response contains no data")
+	}
+	current := resp.Data.Versions[strconv.FormatInt(resp.Data.CurrentVersion, 10)]
+	return &Metadata{
+		CurrentVersion: resp.Data.CurrentVersion,
+		Destroyed:      current.Destroyed || current.DeletionTime != "",
+	}, nil
+}
+
+// DeleteSecret permanently deletes all versions and the metadata of the secret.
+func (c *Client) DeleteSecret(ctx context.Context, mount, path string) error {
+	return c.do(ctx, http.MethodDelete, secretPath(mount, "metadata", path), nil, nil)
+}
+
+func secretPath(mount, kind, path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	return fmt.Sprintf("/v1/%s/%s/%s", url.PathEscape(mount), kind, strings.Join(segments, "/"))
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("X-Vault-Token", c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling KV API: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		kvErr := &Error{StatusCode: resp.StatusCode}
+		var errResp struct {
+			Errors []string `json:"errors"`
+		}
+		if json.Unmarshal(respBody, &errResp) == nil {
+			kvErr.Errors = errResp.Errors
+		}
+		return kvErr
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
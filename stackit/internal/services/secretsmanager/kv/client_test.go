@@ -0,0 +1,146 @@
+package kv_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/secretsmanager/kv"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/secretsmanager/kv/kvtest"
+)
+
+const (
+	mount    = "instance-id"
+	username = "user"
+	password = "secret-password"
+)
+
+func TestLogin(t *testing.T) {
+	server := kvtest.NewServer(map[string]string{username: password})
+	defer server.Close()
+
+	tests := []struct {
+		description string
+		username    string
+		password    string
+		isValid     bool
+	}{
+		{"valid credentials", username, password, true},
+		{"wrong password", username, "wrong", false},
+		{"unknown user", "unknown", password, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := kv.NewClient(server.URL, nil)
+			err := client.Login(context.Background(), tt.username, tt.password)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestSecretLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server := kvtest.NewServer(map[string]string{username: password})
+	defer server.Close()
+
+	client := kv.NewClient(server.URL+"/", server.Client())
+
+	_, err := client.ReadMetadata(ctx, mount, "app/db")
+	if err == nil || kv.IsNotFound(err) {
+		t.Fatalf("Expected permission denied before login, got: %v", err)
+	}
+
+	if err := client.Login(ctx, username, password); err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+
+	_, err = client.ReadMetadata(ctx, mount, "app/db")
+	if !kv.IsNotFound(err) {
+		t.Fatalf("Expected not found, got: %v", err)
+	}
+
+	version, err := client.WriteSecret(ctx, mount, "app/db", map[string]string{"password": "v1"}, new(int64(0)))
+	if err != nil {
+		t.Fatalf("Writing secret failed: %v", err)
+	}
+	if version != 1 {
+		t.Fatalf("Expected version 1, got %d", version)
+	}
+
+	_, err = client.WriteSecret(ctx, mount, "app/db", map[string]string{"password": "conflict"}, new(int64(0)))
+	if err == nil {
+		t.Fatalf("Write with outdated check-and-set version should have failed")
+	}
+
+	version, err = client.WriteSecret(ctx, mount, "app/db", map[string]string{"password": "v2", "user": "admin"}, nil)
+	if err != nil {
+		t.Fatalf("Writing secret failed: %v", err)
+	}
+	if version != 2 {
+		t.Fatalf("Expected version 2, got %d", version)
+	}
+
+	secret, err := client.ReadSecret(ctx, mount, "app/db", 0)
+	if err != nil {
+		t.Fatalf("Reading secret failed: %v", err)
+	}
+	if diff := cmp.Diff(secret, &kv.Secret{Data: map[string]string{"password": "v2", "user": "admin"}, Version: 2}); diff != "" {
+		t.Fatalf("Secret does not match: %s", diff)
+	}
+
+	secret, err = client.ReadSecret(ctx, mount, "app/db", 1)
+	if err != nil {
+		t.Fatalf("Reading secret version failed: %v", err)
+	}
+	if diff := cmp.Diff(secret, &kv.Secret{Data: map[string]string{"password": "v1"}, Version: 1}); diff != "" {
+		t.Fatalf("Secret does not match: %s", diff)
+	}
+
+	metadata, err := client.ReadMetadata(ctx, mount, "app/db")
+	if err != nil {
+		t.Fatalf("Reading metadata failed: %v", err)
+	}
+	if diff := cmp.Diff(metadata, &kv.Metadata{CurrentVersion: 2}); diff != "" {
+		t.Fatalf("Metadata does not match: %s", diff)
+	}
+
+	// values which aren't strings are returned as JSON
+	server.SetSecret(mount, "app/db", map[string]any{
+		"password": "v3",
+		"port":     5432,
+		"tls":      true,
+		"empty":    nil,
+		"options":  map[string]any{"sslmode": "require", "hosts": []string{"a", "b"}},
+	})
+	secret, err = client.ReadSecret(ctx, mount, "app/db", 0)
+	if err != nil {
+		t.Fatalf("Reading secret with non-string values failed: %v", err)
+	}
+	expected := &kv.Secret{
+		Data: map[string]string{
+			"password": "v3",
+			"port":     "5432",
+			"tls":      "true",
+			"empty":    "null",
+			"options":  `{"hosts":["a","b"],"sslmode":"require"}`,
+		},
+		Version: 3,
+	}
+	if diff := cmp.Diff(secret, expected); diff != "" {
+		t.Fatalf("Secret does not match: %s", diff)
+	}
+
+	if err := client.DeleteSecret(ctx, mount, "app/db"); err != nil {
+		t.Fatalf("Deleting secret failed: %v", err)
+	}
+	_, err = client.ReadSecret(ctx, mount, "app/db", 0)
+	if !kv.IsNotFound(err) {
+		t.Fatalf("Expected not found after delete, got: %v", err)
+	}
+}
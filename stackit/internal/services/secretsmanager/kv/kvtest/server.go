@@ -0,0 +1,197 @@
+// Package kvtest provides an in-memory stand-in for the KV v2 endpoint of STACKIT Secrets Manager, to be used in tests.
+package kvtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type version struct {
+	data        map[string]any
+	createdTime time.Time
+}
+
+// Server is an in-memory KV v2 secrets engine with userpass authentication.
+// Every mount path is accepted, secrets are stored per mount and path.
+type Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	users   map[string]string
+	tokens  map[string]bool
+	secrets map[string][]version
+}
+
+// NewServer starts a stand-in server which accepts the given userpass credentials.
+// The caller must call Close when finished.
+func NewServer(users map[string]string) *Server {
+	s := &Server{
+		users:   users,
+		tokens:  map[string]bool{},
+		secrets: map[string][]version{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// SetSecret stores a new version of a secret, like a write of another client. Unlike the provider, other clients
+// may write values which aren't strings.
+func (s *Server) SetSecret(mount, path string, data map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := mount + "/" + path
+	s.secrets[key] = append(s.secrets[key], version{data: data, createdTime: time.Now().UTC()})
+}
+
+// Versions returns the number of versions stored for a secret.
+func (s *Server) Versions(mount, path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.secrets[mount+"/"+path])
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := strings.TrimPrefix(r.URL.Path, "/v1/")
+	if username, ok := strings.CutPrefix(p, "auth/userpass/login/"); ok {
+		s.login(w, r, username)
+		return
+	}
+
+	if !s.tokens[r.Header.Get("X-Vault-Token")] {
+		writeError(w, http.StatusForbidden, "permission denied")
+		return
+	}
+
+	mount, rest, ok := strings.Cut(p, "/")
+	if !ok {
+		writeError(w, http.StatusNotFound, "no handler for route")
+		return
+	}
+	kind, secretPath, ok := strings.Cut(rest, "/")
+	if !ok || secretPath == "" {
+		writeError(w, http.StatusNotFound, "no handler for route")
+		return
+	}
+	key := mount + "/" + secretPath
+
+	switch {
+	case kind == "data" && r.Method == http.MethodPost:
+		s.write(w, r, key)
+	case kind == "data" && r.Method == http.MethodGet:
+		s.read(w, r, key)
+	case kind == "metadata" && r.Method == http.MethodGet:
+		s.metadata(w, key)
+	case kind == "metadata" && r.Method == http.MethodDelete:
+		delete(s.secrets, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported operation")
+	}
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request, username string) {
+	var body struct {
+		Password string `json:"password"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	password, ok := s.users[username]
+	if !ok || password != body.Password {
+		writeError(w, http.StatusBadRequest, "invalid username or password")
+		return
+	}
+	token := fmt.Sprintf("token-%d", len(s.tokens)+1)
+	s.tokens[token] = true
+	writeJSON(w, map[string]any{"auth": map[string]any{"client_token": token}})
+}
+
+func (s *Server) write(w http.ResponseWriter, r *http.Request, key string) {
+	var body struct {
+		Data    map[string]any `json:"data"`
+		Options *struct {
+			Cas *int `json:"cas"`
+		} `json:"options"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	versions := s.secrets[key]
+	if body.Options != nil && body.Options.Cas != nil && *body.Options.Cas != len(versions) {
+		writeError(w, http.StatusBadRequest, "check-and-set parameter did not match the current version")
+		return
+	}
+	s.secrets[key] = append(versions, version{data: body.Data, createdTime: time.Now().UTC()})
+	writeJSON(w, map[string]any{"data": map[string]any{"version": len(s.secrets[key])}})
+}
+
+func (s *Server) read(w http.ResponseWriter, r *http.Request, key string) {
+	versions := s.secrets[key]
+	if len(versions) == 0 {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+	number := len(versions)
+	if v := r.URL.Query().Get("version"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > len(versions) {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		number = n
+	}
+	writeJSON(w, map[string]any{"data": map[string]any{
+		"data": versions[number-1].data,
+		"metadata": map[string]any{
+			"version":       number,
+			"created_time":  versions[number-1].createdTime.Format(time.RFC3339Nano),
+			"deletion_time": "",
+			"destroyed":     false,
+		},
+	}})
+}
+
+func (s *Server) metadata(w http.ResponseWriter, key string) {
+	versions := s.secrets[key]
+	if len(versions) == 0 {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+	versionsMetadata := map[string]any{}
+	for i, v := range versions {
+		versionsMetadata[strconv.Itoa(i+1)] = map[string]any{
+			"created_time":  v.createdTime.Format(time.RFC3339Nano),
+			"deletion_time": "",
+			"destroyed":     false,
+		}
+	}
+	writeJSON(w, map[string]any{"data": map[string]any{
+		"current_version": len(versions),
+		"versions":        versionsMetadata,
+	}})
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, errs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if errs == nil {
+		errs = []string{}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"errors": errs})
+}
@@ -0,0 +1,166 @@
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/secretsmanager/kv"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &secretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &secretEphemeralResource{}
+)
+
+// NewSecretEphemeralResource is a helper function to simplify the provider implementation.
+func NewSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &secretEphemeralResource{}
+}
+
+// secretEphemeralResource is the ephemeral resource implementation.
+type secretEphemeralResource struct {
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (e *secretEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secretsmanager_secret"
+}
+
+// Configure adds the provider configured data to the resource.
+func (e *secretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	ephemeralProviderData, ok := conversion.ParseEphemeralProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	e.providerData = ephemeralProviderData.ProviderData
+	tflog.Info(ctx, "Secrets Manager secret configured")
+}
+
+// ephemeralModel is the model for the ephemeral resource.
+type ephemeralModel struct {
+	InstanceId types.String `tfsdk:"instance_id"`
+	Path       types.String `tfsdk:"path"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	Endpoint   types.String `tfsdk:"endpoint"`
+	Version    types.Int64  `tfsdk:"version"`
+	Data       types.Map    `tfsdk:"data"`
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *secretEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Ephemeral resource that reads a secret from the KV v2 secrets engine of a Secrets Manager instance, using the credentials of a Secrets Manager user. The secret values are never stored in the state.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Description: "ID of the Secrets Manager instance.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the secret within the instance, e.g. `app/database`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(secretPathRegex, "must be a relative path without leading or trailing slashes"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "Username of a Secrets Manager user with read access, e.g. from `stackit_secretsmanager_user`.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of the Secrets Manager user.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: fmt.Sprintf("Endpoint of the Vault-compatible KV API. Defaults to `%s` with the provider region.", fmt.Sprintf(kv.DefaultEndpointFormat, "<region>")),
+				Optional:    true,
+				Computed:    true,
+			},
+			"version": schema.Int64Attribute{
+				Description: "Version of the secret to read. If not defined, the current version is read.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"data": schema.MapAttribute{
+				Description: "Key/value pairs of the secret. Values which aren't strings, e.g. numbers or objects written by other clients, are returned as JSON.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Open reads the secret and sets the result.
+func (e *secretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ephemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	instanceId := model.InstanceId.ValueString()
+	secretPath := model.Path.ValueString()
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "path", secretPath)
+
+	model.Endpoint = types.StringValue(kvEndpoint(model.Endpoint, &e.providerData))
+
+	err := readSecret(ctx, kv.NewClient(model.Endpoint.ValueString(), nil), &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading secret", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+	tflog.Info(ctx, "Secrets Manager secret read")
+}
+
+// readSecret logs in with the credentials of the model and maps the requested version of the secret into it.
+func readSecret(ctx context.Context, client *kv.Client, model *ephemeralModel) error {
+	err := client.Login(ctx, model.Username.ValueString(), model.Password.ValueString())
+	if err != nil {
+		return fmt.Errorf("logging in as user %q: %w", model.Username.ValueString(), err)
+	}
+
+	secret, err := client.ReadSecret(ctx, model.InstanceId.ValueString(), model.Path.ValueString(), model.Version.ValueInt64())
+	if err != nil {
+		if kv.IsNotFound(err) {
+			return fmt.Errorf("secret %q does not exist in instance %q or the requested version was deleted", model.Path.ValueString(), model.InstanceId.ValueString())
+		}
+		return fmt.Errorf("reading secret: %w", err)
+	}
+
+	data, diags := types.MapValueFrom(ctx, types.StringType, secret.Data)
+	if diags.HasError() {
+		return fmt.Errorf("mapping secret data: %w", core.DiagsToError(diags))
+	}
+	model.Data = data
+	model.Version = types.Int64Value(secret.Version)
+	return nil
+}
@@ -0,0 +1,116 @@
+package secretsmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/secretsmanager/kv"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/secretsmanager/kv/kvtest"
+)
+
+func TestReadSecret(t *testing.T) {
+	server := kvtest.NewServer(map[string]string{"user": "password"})
+	defer server.Close()
+
+	ctx := context.Background()
+	writer := kv.NewClient(server.URL, nil)
+	if err := writer.Login(ctx, "user", "password"); err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	for _, value := range []string{"v1", "v2"} {
+		if _, err := writer.WriteSecret(ctx, "iid", "app/db", map[string]string{"password": value}, nil); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		description string
+		password    string
+		path        string
+		version     types.Int64
+		expected    ephemeralModel
+		isValid     bool
+	}{
+		{
+			"current_version",
+			"password",
+			"app/db",
+			types.Int64Null(),
+			ephemeralModel{
+				Version: types.Int64Value(2),
+				Data: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"password": types.StringValue("v2"),
+				}),
+			},
+			true,
+		},
+		{
+			"specific_version",
+			"password",
+			"app/db",
+			types.Int64Value(1),
+			ephemeralModel{
+				Version: types.Int64Value(1),
+				Data: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"password": types.StringValue("v1"),
+				}),
+			},
+			true,
+		},
+		{
+			"missing_version",
+			"password",
+			"app/db",
+			types.Int64Value(3),
+			ephemeralModel{},
+			false,
+		},
+		{
+			"missing_secret",
+			"password",
+			"app/other",
+			types.Int64Null(),
+			ephemeralModel{},
+			false,
+		},
+		{
+			"wrong_credentials",
+			"wrong",
+			"app/db",
+			types.Int64Null(),
+			ephemeralModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &ephemeralModel{
+				InstanceId: types.StringValue("iid"),
+				Path:       types.StringValue(tt.path),
+				Username:   types.StringValue("user"),
+				Password:   types.StringValue(tt.password),
+				Version:    tt.version,
+			}
+			err := readSecret(ctx, kv.NewClient(server.URL, nil), model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model.Data, tt.expected.Data)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+				if !model.Version.Equal(tt.expected.Version) {
+					t.Fatalf("Version does not match: got %v, expected %v", model.Version, tt.expected.Version)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,463 @@
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/secretsmanager/kv"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &secretResource{}
+	_ resource.ResourceWithConfigure  = &secretResource{}
+	_ resource.ResourceWithModifyPlan = &secretResource{}
+)
+
+var secretPathRegex = regexp.MustCompile(`^[^/\s]+(/[^/\s]+)*$`)
+
+type Model struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	ProjectId      types.String `tfsdk:"project_id"`
+	InstanceId     types.String `tfsdk:"instance_id"`
+	Path           types.String `tfsdk:"path"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	Endpoint       types.String `tfsdk:"endpoint"`
+	DataWriteOnly  types.Map    `tfsdk:"data_wo"`
+	DataWOVersion  types.Int64  `tfsdk:"data_wo_version"`
+	Version        types.Int64  `tfsdk:"version"`
+	CurrentVersion types.Int64  `tfsdk:"current_version"`
+}
+
+// NewSecretResource is a helper function to simplify the provider implementation.
+func NewSecretResource() resource.Resource {
+	return &secretResource{}
+}
+
+// secretResource is the resource implementation.
+type secretResource struct {
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *secretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secretsmanager_secret"
+}
+
+// Configure adds the provider configured data to the resource.
+func (r *secretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	tflog.Info(ctx, "Secrets Manager secret configured")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to plan an update if the secret was changed outside of Terraform.
+func (r *secretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// nothing to compare on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateModel Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !secretDrifted(&stateModel) {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the values of data_wo are written again, which creates a new version
+	planModel.Version = types.Int64Unknown()
+	planModel.CurrentVersion = types.Int64Unknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *secretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":            "Secrets Manager secret resource schema. Writes a secret into the KV v2 secrets engine of a Secrets Manager instance, using the credentials of a Secrets Manager user with write access.",
+		"note":            "-> **Note:** The secret values are write-only and never stored in the state. Write-only arguments are supported in HashiCorp Terraform 1.11.0 and later. [Learn more](https://developer.hashicorp.com/terraform/language/resources/ephemeral#write-only-arguments). Use the ephemeral resource `stackit_secretsmanager_secret` to read the values.",
+		"id":              "Terraform's internal resource identifier. It is structured as \"`project_id`,`instance_id`,`path`\".",
+		"project_id":      "STACKIT Project ID to which the instance is associated.",
+		"instance_id":     "ID of the Secrets Manager instance.",
+		"path":            "Path of the secret within the instance, e.g. `app/database`.",
+		"username":        "Username of a Secrets Manager user with write access, e.g. from `stackit_secretsmanager_user`.",
+		"password":        "Password of the Secrets Manager user. It is kept in the state, because it is needed to refresh and delete the secret. The secret values themselves are write-only, see `data_wo`.",
+		"endpoint":        fmt.Sprintf("Endpoint of the Vault-compatible KV API. Defaults to `%s` with the provider region.", fmt.Sprintf(kv.DefaultEndpointFormat, "<region>")),
+		"data_wo":         "Key/value pairs of the secret. Write-only - never stored in state. To write new values, update this value AND increment `data_wo_version`.",
+		"data_wo_version": "Used together with `data_wo` to trigger an update. Increment this value to write a new version of the secret.",
+		"version":         "Version of the secret in the KV secrets engine, which was written by Terraform.",
+		"current_version": "Current version of the secret in the KV secrets engine. If it differs from `version`, the secret was changed outside of Terraform and the next apply writes the values of `data_wo` again.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         descriptions["main"],
+		MarkdownDescription: fmt.Sprintf("%s\n\n%s", descriptions["main"], descriptions["note"]),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: descriptions["instance_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: descriptions["path"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+					stringvalidator.RegexMatches(secretPathRegex, "must be a relative path without leading or trailing slashes"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: descriptions["username"],
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: descriptions["password"],
+				Required:    true,
+				Sensitive:   true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: descriptions["endpoint"],
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"data_wo": schema.MapAttribute{
+				Description: descriptions["data_wo"],
+				ElementType: types.StringType,
+				Required:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"data_wo_version": schema.Int64Attribute{
+				Description: descriptions["data_wo_version"],
+				Required:    true,
+			},
+			"version": schema.Int64Attribute{
+				Description: descriptions["version"],
+				Computed:    true,
+			},
+			"current_version": schema.Int64Attribute{
+				Description: descriptions["current_version"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available in the config, the plan just contains null values for them.
+	var configModel Model
+	diags = req.Config.Get(ctx, &configModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	secretPath := model.Path.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "path", secretPath)
+
+	model.Endpoint = types.StringValue(kvEndpoint(model.Endpoint, &r.providerData))
+
+	client, err := newKVClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating secret", err.Error())
+		return
+	}
+
+	data, err := toSecretData(ctx, configModel.DataWriteOnly)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating secret", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	// check-and-set with version 0 makes sure that an existing secret is not overwritten
+	version, err := client.WriteSecret(ctx, instanceId, secretPath, data, new(int64(0)))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating secret", fmt.Sprintf("Writing secret: %v. If the secret already exists, delete it or choose another path.", err))
+		return
+	}
+
+	mapFields(version, &model)
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Secrets Manager secret created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	secretPath := model.Path.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "path", secretPath)
+
+	client, err := newKVClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading secret", err.Error())
+		return
+	}
+
+	// Only the metadata is read, so the secret values never end up in the state
+	metadata, err := client.ReadMetadata(ctx, instanceId, secretPath)
+	if err != nil {
+		if kv.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading secret", fmt.Sprintf("Reading secret metadata: %v", err))
+		return
+	}
+	if metadata.Destroyed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// version keeps the version written by Terraform, so a version written outside of Terraform shows up as drift
+	model.CurrentVersion = types.Int64Value(metadata.CurrentVersion)
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Secrets Manager secret read")
+}
+
+// Update writes a new version of the secret if data_wo_version changed or the secret was changed outside of Terraform.
+func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configModel Model
+	diags = req.Config.Get(ctx, &configModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateModel Model
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	secretPath := model.Path.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "path", secretPath)
+
+	if model.DataWOVersion.Equal(stateModel.DataWOVersion) && !secretDrifted(&stateModel) {
+		// only the credentials changed, there is nothing to write
+		model.Version = stateModel.Version
+		model.CurrentVersion = stateModel.CurrentVersion
+		diags = resp.State.Set(ctx, model)
+		resp.Diagnostics.Append(diags...)
+		tflog.Info(ctx, "Secrets Manager secret updated")
+		return
+	}
+
+	client, err := newKVClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating secret", err.Error())
+		return
+	}
+
+	data, err := toSecretData(ctx, configModel.DataWriteOnly)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating secret", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	version, err := client.WriteSecret(ctx, instanceId, secretPath, data, nil)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating secret", fmt.Sprintf("Writing secret: %v", err))
+		return
+	}
+
+	mapFields(version, &model)
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Secrets Manager secret updated")
+}
+
+// Delete deletes all versions of the secret and removes it from the Terraform state on success.
+func (r *secretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	secretPath := model.Path.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "path", secretPath)
+
+	client, err := newKVClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting secret", err.Error())
+		return
+	}
+
+	err = client.DeleteSecret(ctx, instanceId, secretPath)
+	if err != nil && !kv.IsNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting secret", fmt.Sprintf("Deleting secret: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Secrets Manager secret deleted")
+}
+
+// kvEndpoint returns the configured endpoint or the default endpoint of the provider region.
+func kvEndpoint(endpoint types.String, providerData *core.ProviderData) string {
+	if !utils.IsUndefined(endpoint) {
+		return endpoint.ValueString()
+	}
+	return fmt.Sprintf(kv.DefaultEndpointFormat, providerData.GetRegion())
+}
+
+// newKVClient creates a KV client, which is logged in with the credentials of the model.
+func newKVClient(ctx context.Context, model *Model) (*kv.Client, error) {
+	client := kv.NewClient(model.Endpoint.ValueString(), nil)
+	err := client.Login(ctx, model.Username.ValueString(), model.Password.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("logging in as user %q: %w", model.Username.ValueString(), err)
+	}
+	return client, nil
+}
+
+func toSecretData(ctx context.Context, data types.Map) (map[string]string, error) {
+	if utils.IsUndefined(data) {
+		return nil, fmt.Errorf("data_wo must be set")
+	}
+	secretData := map[string]string{}
+	diags := data.ElementsAs(ctx, &secretData, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("converting data: %w", core.DiagsToError(diags))
+	}
+	return secretData, nil
+}
+
+// mapFields maps the version written by Terraform, which is also the current version of the secret.
+func mapFields(version int64, model *Model) {
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.InstanceId.ValueString(), model.Path.ValueString())
+	model.Version = types.Int64Value(version)
+	model.CurrentVersion = types.Int64Value(version)
+}
+
+// secretDrifted returns true if the current version of the secret is not the version written by Terraform.
+func secretDrifted(model *Model) bool {
+	if utils.IsUndefined(model.Version) || utils.IsUndefined(model.CurrentVersion) {
+		return false
+	}
+	return model.Version.ValueInt64() != model.CurrentVersion.ValueInt64()
+}
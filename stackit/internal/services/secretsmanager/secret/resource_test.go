@@ -0,0 +1,184 @@
+package secretsmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/secretsmanager/kv/kvtest"
+)
+
+func TestMapFields(t *testing.T) {
+	model := Model{
+		ProjectId:  types.StringValue("pid"),
+		InstanceId: types.StringValue("iid"),
+		Path:       types.StringValue("app/db"),
+		// write-only values are always null
+		DataWriteOnly: types.MapNull(types.StringType),
+	}
+	mapFields(3, &model)
+	expected := Model{
+		Id:             types.StringValue("pid,iid,app/db"),
+		ProjectId:      types.StringValue("pid"),
+		InstanceId:     types.StringValue("iid"),
+		Path:           types.StringValue("app/db"),
+		DataWriteOnly:  types.MapNull(types.StringType),
+		Version:        types.Int64Value(3),
+		CurrentVersion: types.Int64Value(3),
+	}
+	diff := cmp.Diff(model, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestSecretDrifted(t *testing.T) {
+	tests := []struct {
+		description    string
+		version        types.Int64
+		currentVersion types.Int64
+		expected       bool
+	}{
+		{"same_version", types.Int64Value(3), types.Int64Value(3), false},
+		{"written_outside", types.Int64Value(3), types.Int64Value(4), true},
+		{"rolled_back", types.Int64Value(3), types.Int64Value(2), true},
+		// state written before current_version was tracked
+		{"no_current_version", types.Int64Value(3), types.Int64Null(), false},
+		{"unknown_version", types.Int64Unknown(), types.Int64Value(4), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{Version: tt.version, CurrentVersion: tt.currentVersion}
+			if output := secretDrifted(model); output != tt.expected {
+				t.Fatalf("Drift does not match: got %t, expected %t", output, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToSecretData(t *testing.T) {
+	tests := []struct {
+		description string
+		input       types.Map
+		expected    map[string]string
+		isValid     bool
+	}{
+		{
+			"ok",
+			types.MapValueMust(types.StringType, map[string]attr.Value{
+				"username": types.StringValue("admin"),
+				"password": types.StringValue("secret"),
+			}),
+			map[string]string{
+				"username": "admin",
+				"password": "secret",
+			},
+			true,
+		},
+		{
+			"null",
+			types.MapNull(types.StringType),
+			nil,
+			false,
+		},
+		{
+			"unknown",
+			types.MapUnknown(types.StringType),
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toSecretData(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestKVEndpoint(t *testing.T) {
+	providerData := &core.ProviderData{DefaultRegion: "eu01"}
+	tests := []struct {
+		description string
+		input       types.String
+		expected    string
+	}{
+		{
+			"default",
+			types.StringNull(),
+			"https://prod.sm.eu01.stackit.cloud",
+		},
+		{
+			"unknown",
+			types.StringUnknown(),
+			"https://prod.sm.eu01.stackit.cloud",
+		},
+		{
+			"custom",
+			types.StringValue("http://localhost:8200"),
+			"http://localhost:8200",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := kvEndpoint(tt.input, providerData)
+			if output != tt.expected {
+				t.Fatalf("Endpoint does not match: got %q, expected %q", output, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewKVClient(t *testing.T) {
+	server := kvtest.NewServer(map[string]string{"user": "password"})
+	defer server.Close()
+
+	tests := []struct {
+		description string
+		username    string
+		password    string
+		isValid     bool
+	}{
+		{"ok", "user", "password", true},
+		{"wrong_password", "user", "wrong", false},
+		{"unknown_user", "other", "password", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				Endpoint: types.StringValue(server.URL),
+				Username: types.StringValue(tt.username),
+				Password: types.StringValue(tt.password),
+			}
+			client, err := newKVClient(context.Background(), model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				// a logged in client is allowed to write
+				_, err = client.WriteSecret(context.Background(), "iid", "app/"+tt.description, map[string]string{"k": "v"}, new(int64(0)))
+				if err != nil {
+					t.Fatalf("Writing with logged in client failed: %v", err)
+				}
+			}
+		})
+	}
+}
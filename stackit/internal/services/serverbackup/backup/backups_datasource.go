@@ -0,0 +1,263 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	serverbackup "github.com/stackitcloud/stackit-sdk-go/services/serverbackup/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	serverbackupUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverbackup/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &backupsDataSource{}
+)
+
+// NewBackupsDataSource is a helper function to simplify the provider implementation.
+func NewBackupsDataSource() datasource.DataSource {
+	return &backupsDataSource{}
+}
+
+// backupsDataSource is the data source implementation.
+type backupsDataSource struct {
+	client       *serverbackup.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (r *backupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_backups"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *backupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := serverbackupUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client = apiClient
+	tflog.Info(ctx, "Server backup client configured")
+}
+
+// Schema defines the schema for the data source.
+func (r *backupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Server backups datasource schema. Lists all backups of a server, including the ones created by backup schedules. Must have a `region` specified in the provider configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source identifier. It is structured as \"`project_id`,`region`,`server_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT Project ID (UUID) to which the server is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: "Server ID (UUID) to which the backups are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of backups of the server.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"backup_id": schema.StringAttribute{
+							Description: "Backup ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The backup name.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The backup status.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Date and time when the backup was created.",
+							Computed:    true,
+						},
+						"expire_at": schema.StringAttribute{
+							Description: "Date and time when the backup expires.",
+							Computed:    true,
+						},
+						"last_restored_at": schema.StringAttribute{
+							Description: "Date and time when the backup was last restored.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "Size of the backup in GB.",
+							Computed:    true,
+						},
+						"volume_backups": schema.ListNestedAttribute{
+							Description: "Backups of the single volumes contained in this backup.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"volume_backup_id": schema.StringAttribute{
+										Description: "Volume backup ID.",
+										Computed:    true,
+									},
+									"volume_id": schema.StringAttribute{
+										Description: "ID of the backed up volume.",
+										Computed:    true,
+									},
+									"size": schema.Int64Attribute{
+										Description: "Size of the volume backup in GB.",
+										Computed:    true,
+									},
+									"status": schema.StringAttribute{
+										Description: "The volume backup status.",
+										Computed:    true,
+									},
+									"last_restored_at": schema.StringAttribute{
+										Description: "Date and time when the volume backup was last restored.",
+										Computed:    true,
+									},
+									"last_restored_volume_id": schema.StringAttribute{
+										Description: "ID of the volume the volume backup was last restored to.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"region": schema.StringAttribute{
+				// the region cannot be found, so it has to be passed
+				Optional:    true,
+				Description: "The resource region. If not defined, the provider region is used.",
+			},
+		},
+	}
+}
+
+// backupsDataSourceModel maps the data source schema data.
+type backupsDataSourceModel struct {
+	ID        types.String                 `tfsdk:"id"`
+	ProjectId types.String                 `tfsdk:"project_id"`
+	ServerId  types.String                 `tfsdk:"server_id"`
+	Items     []backupsDatasourceItemModel `tfsdk:"items"`
+	Region    types.String                 `tfsdk:"region"`
+}
+
+// backupsDatasourceItemModel maps backup schema data.
+type backupsDatasourceItemModel struct {
+	BackupId       types.String `tfsdk:"backup_id"`
+	Name           types.String `tfsdk:"name"`
+	Status         types.String `tfsdk:"status"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	ExpireAt       types.String `tfsdk:"expire_at"`
+	LastRestoredAt types.String `tfsdk:"last_restored_at"`
+	Size           types.Int64  `tfsdk:"size"`
+	VolumeBackups  types.List   `tfsdk:"volume_backups"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *backupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model backupsDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	serverId := model.ServerId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "server_id", serverId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	backups, err := r.client.DefaultAPI.ListBackups(ctx, projectId, serverId, region).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading server backups",
+			fmt.Sprintf("Server with ID %q does not exist in project %q.", serverId, projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Map response body to schema
+	err = mapBackupsDatasourceFields(backups.GetItems(), &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server backups", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Server backups read")
+}
+
+func mapBackupsDatasourceFields(backups []serverbackup.Backup, model *backupsDataSourceModel, region string) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.ID = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.ServerId.ValueString())
+	model.Region = types.StringValue(region)
+
+	model.Items = nil
+	for i := range backups {
+		backup := &backups[i]
+		volumeBackups, err := mapVolumeBackups(backup.GetVolumeBackups())
+		if err != nil {
+			return fmt.Errorf("mapping backup %q: %w", backup.GetId(), err)
+		}
+		model.Items = append(model.Items, backupsDatasourceItemModel{
+			BackupId:       types.StringValue(backup.GetId()),
+			Name:           types.StringValue(backup.GetName()),
+			Status:         types.StringValue(string(backup.GetStatus())),
+			CreatedAt:      types.StringValue(backup.GetCreatedAt()),
+			ExpireAt:       types.StringValue(backup.GetExpireAt()),
+			LastRestoredAt: optionalString(backup.GetLastRestoredAtOk()),
+			Size:           optionalInt64(backup.GetSizeOk()),
+			VolumeBackups:  volumeBackups,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package backup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	serverbackup "github.com/stackitcloud/stackit-sdk-go/services/serverbackup/v2api"
+)
+
+func TestMapBackupsDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []serverbackup.Backup
+		expected    *backupsDataSourceModel
+		isValid     bool
+	}{
+		{
+			"empty_response",
+			[]serverbackup.Backup{},
+			&backupsDataSourceModel{
+				ID:        types.StringValue(testProjectId + "," + testRegion + "," + testServerId),
+				ProjectId: types.StringValue(testProjectId),
+				ServerId:  types.StringValue(testServerId),
+				Items:     nil,
+				Region:    types.StringValue(testRegion),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			[]serverbackup.Backup{
+				*fixtureBackup(func(backup *serverbackup.Backup) {
+					backup.SetSize(20)
+					backup.SetVolumeBackups([]serverbackup.BackupVolumeBackupsInner{fixtureVolumeBackup()})
+				}),
+				*fixtureBackup(func(backup *serverbackup.Backup) {
+					backup.SetId("backup-id-2")
+					backup.SetStatus("creating")
+				}),
+			},
+			&backupsDataSourceModel{
+				ID:        types.StringValue(testProjectId + "," + testRegion + "," + testServerId),
+				ProjectId: types.StringValue(testProjectId),
+				ServerId:  types.StringValue(testServerId),
+				Items: []backupsDatasourceItemModel{
+					{
+						BackupId:       types.StringValue(testBackupId),
+						Name:           types.StringValue("backup"),
+						Status:         types.StringValue("available"),
+						CreatedAt:      types.StringValue("2026-01-02T03:04:05Z"),
+						ExpireAt:       types.StringValue("2026-01-16T03:04:05Z"),
+						LastRestoredAt: types.StringNull(),
+						Size:           types.Int64Value(20),
+						VolumeBackups: fixtureVolumeBackups(map[string]attr.Value{
+							"volume_backup_id":        types.StringValue("volume-backup-id"),
+							"volume_id":               types.StringValue(testVolumeId),
+							"size":                    types.Int64Value(20),
+							"status":                  types.StringValue("available"),
+							"last_restored_at":        types.StringValue("2026-01-03T03:04:05Z"),
+							"last_restored_volume_id": types.StringValue(testVolumeId),
+						}),
+					},
+					{
+						BackupId:       types.StringValue("backup-id-2"),
+						Name:           types.StringValue("backup"),
+						Status:         types.StringValue("creating"),
+						CreatedAt:      types.StringValue("2026-01-02T03:04:05Z"),
+						ExpireAt:       types.StringValue("2026-01-16T03:04:05Z"),
+						LastRestoredAt: types.StringNull(),
+						Size:           types.Int64Null(),
+						VolumeBackups:  fixtureVolumeBackups(),
+					},
+				},
+				Region: types.StringValue(testRegion),
+			},
+			true,
+		},
+		{
+			"nil_model",
+			[]serverbackup.Backup{},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var state *backupsDataSourceModel
+			if tt.expected != nil {
+				state = &backupsDataSourceModel{
+					ProjectId: tt.expected.ProjectId,
+					ServerId:  tt.expected.ServerId,
+				}
+			}
+			err := mapBackupsDatasourceFields(tt.input, state, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,578 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	serverbackup "github.com/stackitcloud/stackit-sdk-go/services/serverbackup/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	serverbackupUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverbackup/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &backupResource{}
+	_ resource.ResourceWithConfigure   = &backupResource{}
+	_ resource.ResourceWithImportState = &backupResource{}
+	_ resource.ResourceWithModifyPlan  = &backupResource{}
+)
+
+type Model struct {
+	ID              types.String `tfsdk:"id"`
+	ProjectId       types.String `tfsdk:"project_id"`
+	ServerId        types.String `tfsdk:"server_id"`
+	BackupId        types.String `tfsdk:"backup_id"`
+	Name            types.String `tfsdk:"name"`
+	RetentionPeriod types.Int32  `tfsdk:"retention_period"`
+	VolumeIds       types.List   `tfsdk:"volume_ids"`
+	Status          types.String `tfsdk:"status"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	ExpireAt        types.String `tfsdk:"expire_at"`
+	LastRestoredAt  types.String `tfsdk:"last_restored_at"`
+	Size            types.Int64  `tfsdk:"size"`
+	VolumeBackups   types.List   `tfsdk:"volume_backups"`
+	Region          types.String `tfsdk:"region"`
+}
+
+// Types corresponding to Model.VolumeBackups[i]
+var volumeBackupTypes = map[string]attr.Type{
+	"volume_backup_id":        types.StringType,
+	"volume_id":               types.StringType,
+	"size":                    types.Int64Type,
+	"status":                  types.StringType,
+	"last_restored_at":        types.StringType,
+	"last_restored_volume_id": types.StringType,
+}
+
+// NewBackupResource is a helper function to simplify the provider implementation.
+func NewBackupResource() resource.Resource {
+	return &backupResource{}
+}
+
+// backupResource is the resource implementation.
+type backupResource struct {
+	client       *serverbackup.APIClient
+	providerData core.ProviderData
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *backupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *backupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_backup"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *backupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := serverbackupUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "Server backup client configured.")
+}
+
+// Schema defines the schema for the resource.
+func (r *backupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":             "Server backup resource schema. Creates an on-demand backup of a server. The server backup service must be enabled for the server, e.g. with the `stackit_server_backup_enable` resource. Must have a `region` specified in the provider configuration.",
+		"id":               "Terraform's internal resource identifier. It is structured as \"`project_id`,`region`,`server_id`,`backup_id`\".",
+		"project_id":       "STACKIT Project ID to which the server is associated.",
+		"server_id":        "Server ID (UUID) of the server to back up.",
+		"backup_id":        "Backup ID.",
+		"name":             "The backup name.",
+		"retention_period": "Number of days the backup is kept before it expires.",
+		"volume_ids":       "IDs of the volumes to back up. If not set, all volumes attached to the server are backed up.",
+		"status":           "The backup status.",
+		"created_at":       "Date and time when the backup was created.",
+		"expire_at":        "Date and time when the backup expires.",
+		"last_restored_at": "Date and time when the backup was last restored.",
+		"size":             "Size of the backup in GB.",
+		"volume_backups":   "Backups of the single volumes contained in this backup.",
+		"region":           "The resource region. If not defined, the provider region is used.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: descriptions["server_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				Description: descriptions["backup_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: descriptions["name"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"retention_period": schema.Int32Attribute{
+				Description: descriptions["retention_period"],
+				Required:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"volume_ids": schema.ListAttribute{
+				Description: descriptions["volume_ids"],
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						validate.UUID(),
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: descriptions["created_at"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expire_at": schema.StringAttribute{
+				Description: descriptions["expire_at"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_restored_at": schema.StringAttribute{
+				Description: descriptions["last_restored_at"],
+				Computed:    true,
+			},
+			"size": schema.Int64Attribute{
+				Description: descriptions["size"],
+				Computed:    true,
+			},
+			"volume_backups": schema.ListNestedAttribute{
+				Description: descriptions["volume_backups"],
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"volume_backup_id": schema.StringAttribute{
+							Description: "Volume backup ID.",
+							Computed:    true,
+						},
+						"volume_id": schema.StringAttribute{
+							Description: "ID of the backed up volume.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "Size of the volume backup in GB.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The volume backup status.",
+							Computed:    true,
+						},
+						"last_restored_at": schema.StringAttribute{
+							Description: "Date and time when the volume backup was last restored.",
+							Computed:    true,
+						},
+						"last_restored_volume_id": schema.StringAttribute{
+							Description: "ID of the volume the volume backup was last restored to.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	serverId := model.ServerId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "server_id", serverId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	payload, err := toCreatePayload(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server backup", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	backupJob, err := r.client.DefaultAPI.CreateBackup(ctx, projectId, serverId, region).CreateBackupPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server backup", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	backupId := backupJob.GetId()
+	// Write id attributes to state before polling via the wait handler - just in case anything goes wrong during the wait handler
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": projectId,
+		"server_id":  serverId,
+		"region":     region,
+		"backup_id":  backupId,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := createBackupWaitHandler(ctx, r.client.DefaultAPI, projectId, serverId, region, backupId).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server backup", fmt.Sprintf("Backup creation waiting: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(backup, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server backup", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Server backup created.")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *backupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	serverId := model.ServerId.ValueString()
+	backupId := model.BackupId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "server_id", serverId)
+	ctx = tflog.SetField(ctx, "backup_id", backupId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	backup, err := r.client.DefaultAPI.GetBackup(ctx, projectId, serverId, region, backupId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server backup", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Map response body to schema
+	err = mapFields(backup, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server backup", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Server backup read.")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *backupResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Update shouldn't be called
+	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server backup", "Server backup can't be updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *backupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	serverId := model.ServerId.ValueString()
+	backupId := model.BackupId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "server_id", serverId)
+	ctx = tflog.SetField(ctx, "backup_id", backupId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	err := r.client.DefaultAPI.DeleteBackup(ctx, projectId, serverId, region, backupId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server backup", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	_, err = deleteBackupWaitHandler(ctx, r.client.DefaultAPI, projectId, serverId, region, backupId).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server backup", fmt.Sprintf("Backup deletion waiting: %v", err))
+		return
+	}
+
+	tflog.Info(ctx, "Server backup deleted.")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,region,server_id,backup_id
+func (r *backupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing server backup",
+			fmt.Sprintf("Expected import identifier with format [project_id],[region],[server_id],[backup_id], got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("backup_id"), idParts[3])...)
+	tflog.Info(ctx, "Server backup state imported.")
+}
+
+func mapFields(backup *serverbackup.Backup, model *Model, region string) error {
+	if backup == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var backupId string
+	if model.BackupId.ValueString() != "" {
+		backupId = model.BackupId.ValueString()
+	} else if backup.GetId() != "" {
+		backupId = backup.GetId()
+	} else {
+		return fmt.Errorf("backup id not present")
+	}
+
+	model.ID = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.ServerId.ValueString(), backupId)
+	model.BackupId = types.StringValue(backupId)
+	model.Name = types.StringValue(backup.GetName())
+	model.Status = types.StringValue(string(backup.GetStatus()))
+	model.CreatedAt = types.StringValue(backup.GetCreatedAt())
+	model.ExpireAt = types.StringValue(backup.GetExpireAt())
+	model.LastRestoredAt = optionalString(backup.GetLastRestoredAtOk())
+	model.Size = optionalInt64(backup.GetSizeOk())
+	model.Region = types.StringValue(region)
+
+	volumeBackups, err := mapVolumeBackups(backup.GetVolumeBackups())
+	if err != nil {
+		return err
+	}
+	model.VolumeBackups = volumeBackups
+
+	if model.VolumeIds.IsNull() || model.VolumeIds.IsUnknown() {
+		// volume_ids is only an input, the API doesn't return it explicitly
+		model.VolumeIds = types.ListNull(types.StringType)
+	}
+	return nil
+}
+
+func mapVolumeBackups(volumeBackups []serverbackup.BackupVolumeBackupsInner) (types.List, error) {
+	values := make([]attr.Value, 0, len(volumeBackups))
+	for i := range volumeBackups {
+		volumeBackup := &volumeBackups[i]
+		value, diags := types.ObjectValue(volumeBackupTypes, map[string]attr.Value{
+			"volume_backup_id":        optionalString(volumeBackup.GetIdOk()),
+			"volume_id":               optionalString(volumeBackup.GetVolumeIdOk()),
+			"size":                    optionalInt64(volumeBackup.GetSizeOk()),
+			"status":                  types.StringValue(string(volumeBackup.GetStatus())),
+			"last_restored_at":        optionalString(volumeBackup.GetLastRestoredAtOk()),
+			"last_restored_volume_id": optionalString(volumeBackup.GetLastRestoredVolumeIdOk()),
+		})
+		if diags.HasError() {
+			return types.ListNull(types.ObjectType{AttrTypes: volumeBackupTypes}), fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		values = append(values, value)
+	}
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: volumeBackupTypes}, values)
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: volumeBackupTypes}), fmt.Errorf("mapping volume backups: %w", core.DiagsToError(diags))
+	}
+	return list, nil
+}
+
+func optionalString(value *string, ok bool) types.String {
+	if !ok || value == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+func optionalInt64[T ~int32 | ~int64](value *T, ok bool) types.Int64 {
+	if !ok || value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
+
+func toCreatePayload(model *Model) (*serverbackup.CreateBackupPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	var volumeIds []string
+	if !model.VolumeIds.IsNull() && !model.VolumeIds.IsUnknown() {
+		var err error
+		volumeIds, err = utils.ListValueToStringSlice(model.VolumeIds)
+		if err != nil {
+			return nil, fmt.Errorf("convert volume ids: %w", err)
+		}
+	}
+	// we should provide null to the API in case no volumeIds were chosen, else it errors
+	if len(volumeIds) == 0 {
+		volumeIds = nil
+	}
+
+	return &serverbackup.CreateBackupPayload{
+		Name:            model.Name.ValueString(),
+		RetentionPeriod: model.RetentionPeriod.ValueInt32(),
+		VolumeIds:       volumeIds,
+	}, nil
+}
@@ -0,0 +1,227 @@
+package backup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	serverbackup "github.com/stackitcloud/stackit-sdk-go/services/serverbackup/v2api"
+)
+
+const (
+	testProjectId = "b8c3fbaa-3ab4-4a8e-9584-de22453d046f"
+	testServerId  = "e2e9f3c7-5b1c-4a1b-9c52-67d7e6b1f0a4"
+	testVolumeId  = "0a1d9c5e-2f4b-4c6a-8e3d-7b9f1a2c3d4e"
+	testBackupId  = "backup-id"
+	testRegion    = "eu01"
+)
+
+func fixtureBackup(mods ...func(*serverbackup.Backup)) *serverbackup.Backup {
+	backup := &serverbackup.Backup{}
+	backup.SetId(testBackupId)
+	backup.SetName("backup")
+	backup.SetStatus("available")
+	backup.SetCreatedAt("2026-01-02T03:04:05Z")
+	backup.SetExpireAt("2026-01-16T03:04:05Z")
+	for _, mod := range mods {
+		mod(backup)
+	}
+	return backup
+}
+
+func fixtureVolumeBackup() serverbackup.BackupVolumeBackupsInner {
+	volumeBackup := serverbackup.BackupVolumeBackupsInner{}
+	volumeBackup.SetId("volume-backup-id")
+	volumeBackup.SetVolumeId(testVolumeId)
+	volumeBackup.SetSize(20)
+	volumeBackup.SetStatus("available")
+	volumeBackup.SetLastRestoredAt("2026-01-03T03:04:05Z")
+	volumeBackup.SetLastRestoredVolumeId(testVolumeId)
+	return volumeBackup
+}
+
+func fixtureVolumeBackups(volumeBackups ...map[string]attr.Value) types.List {
+	values := make([]attr.Value, 0, len(volumeBackups))
+	for _, volumeBackup := range volumeBackups {
+		values = append(values, types.ObjectValueMust(volumeBackupTypes, volumeBackup))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: volumeBackupTypes}, values)
+}
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       *Model
+		input       *serverbackup.Backup
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&Model{
+				ProjectId: types.StringValue(testProjectId),
+				ServerId:  types.StringValue(testServerId),
+			},
+			fixtureBackup(),
+			&Model{
+				ID:             types.StringValue(testProjectId + "," + testRegion + "," + testServerId + "," + testBackupId),
+				ProjectId:      types.StringValue(testProjectId),
+				ServerId:       types.StringValue(testServerId),
+				BackupId:       types.StringValue(testBackupId),
+				Name:           types.StringValue("backup"),
+				VolumeIds:      types.ListNull(types.StringType),
+				Status:         types.StringValue("available"),
+				CreatedAt:      types.StringValue("2026-01-02T03:04:05Z"),
+				ExpireAt:       types.StringValue("2026-01-16T03:04:05Z"),
+				LastRestoredAt: types.StringNull(),
+				Size:           types.Int64Null(),
+				VolumeBackups:  fixtureVolumeBackups(),
+				Region:         types.StringValue(testRegion),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&Model{
+				ProjectId:       types.StringValue(testProjectId),
+				ServerId:        types.StringValue(testServerId),
+				BackupId:        types.StringValue(testBackupId),
+				RetentionPeriod: types.Int32Value(14),
+				VolumeIds:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testVolumeId)}),
+			},
+			fixtureBackup(func(backup *serverbackup.Backup) {
+				backup.SetLastRestoredAt("2026-01-03T03:04:05Z")
+				backup.SetSize(20)
+				backup.SetVolumeBackups([]serverbackup.BackupVolumeBackupsInner{fixtureVolumeBackup()})
+			}),
+			&Model{
+				ID:              types.StringValue(testProjectId + "," + testRegion + "," + testServerId + "," + testBackupId),
+				ProjectId:       types.StringValue(testProjectId),
+				ServerId:        types.StringValue(testServerId),
+				BackupId:        types.StringValue(testBackupId),
+				Name:            types.StringValue("backup"),
+				RetentionPeriod: types.Int32Value(14),
+				VolumeIds:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testVolumeId)}),
+				Status:          types.StringValue("available"),
+				CreatedAt:       types.StringValue("2026-01-02T03:04:05Z"),
+				ExpireAt:        types.StringValue("2026-01-16T03:04:05Z"),
+				LastRestoredAt:  types.StringValue("2026-01-03T03:04:05Z"),
+				Size:            types.Int64Value(20),
+				VolumeBackups: fixtureVolumeBackups(map[string]attr.Value{
+					"volume_backup_id":        types.StringValue("volume-backup-id"),
+					"volume_id":               types.StringValue(testVolumeId),
+					"size":                    types.Int64Value(20),
+					"status":                  types.StringValue("available"),
+					"last_restored_at":        types.StringValue("2026-01-03T03:04:05Z"),
+					"last_restored_volume_id": types.StringValue(testVolumeId),
+				}),
+				Region: types.StringValue(testRegion),
+			},
+			true,
+		},
+		{
+			"no_id",
+			&Model{
+				ProjectId: types.StringValue(testProjectId),
+				ServerId:  types.StringValue(testServerId),
+			},
+			fixtureBackup(func(backup *serverbackup.Backup) {
+				backup.SetId("")
+			}),
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			fixtureBackup(),
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *serverbackup.CreateBackupPayload
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&Model{
+				Name:            types.StringValue("backup"),
+				RetentionPeriod: types.Int32Value(14),
+				VolumeIds:       types.ListNull(types.StringType),
+			},
+			&serverbackup.CreateBackupPayload{
+				Name:            "backup",
+				RetentionPeriod: 14,
+			},
+			true,
+		},
+		{
+			"volume_ids",
+			&Model{
+				Name:            types.StringValue("backup"),
+				RetentionPeriod: types.Int32Value(1),
+				VolumeIds:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testVolumeId)}),
+			},
+			&serverbackup.CreateBackupPayload{
+				Name:            "backup",
+				RetentionPeriod: 1,
+				VolumeIds:       []string{testVolumeId},
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toCreatePayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	serverbackup "github.com/stackitcloud/stackit-sdk-go/services/serverbackup/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	serverbackupUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverbackup/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &restoreAction{}
+	_ action.ActionWithConfigure = &restoreAction{}
+)
+
+type RestoreModel struct {
+	ProjectId               types.String `tfsdk:"project_id"`
+	ServerId                types.String `tfsdk:"server_id"`
+	BackupId                types.String `tfsdk:"backup_id"`
+	StartServerAfterRestore types.Bool   `tfsdk:"start_server_after_restore"`
+	VolumeIds               types.List   `tfsdk:"volume_ids"`
+	Region                  types.String `tfsdk:"region"`
+}
+
+// NewRestoreAction is a helper function to simplify the provider implementation.
+func NewRestoreAction() action.Action {
+	return &restoreAction{}
+}
+
+// restoreAction is the action implementation.
+type restoreAction struct {
+	client       *serverbackup.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the action type name.
+func (a *restoreAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_backup_restore"
+}
+
+// Configure adds the provider configured client to the action.
+func (a *restoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	var ok bool
+	a.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := serverbackupUtils.ConfigureClient(ctx, &a.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	a.client = apiClient
+	tflog.Info(ctx, "Server backup client configured")
+}
+
+// Schema defines the schema for the action.
+func (a *restoreAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "Restores a server or single volumes of a server from a backup and waits until the restore is finished. " +
+			"Use it with `terraform apply -invoke` or in an `action_trigger` of a `lifecycle` block. Must have a `region` specified in the provider configuration.",
+		"project_id":                 "STACKIT Project ID to which the server is associated.",
+		"server_id":                  "Server ID (UUID) of the backed up server.",
+		"backup_id":                  "ID of the backup to restore.",
+		"start_server_after_restore": "Whether the server is started after the restore is finished. Defaults to `false`.",
+		"volume_ids":                 "IDs of the volumes to restore. If not set, all volumes contained in the backup are restored.",
+		"region":                     "The resource region. If not defined, the provider region is used.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: descriptions["server_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				Description: descriptions["backup_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"start_server_after_restore": schema.BoolAttribute{
+				Description: descriptions["start_server_after_restore"],
+				Optional:    true,
+			},
+			"volume_ids": schema.ListAttribute{
+				Description: descriptions["volume_ids"],
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						validate.UUID(),
+					),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: descriptions["region"],
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Invoke restores the backup.
+func (a *restoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model RestoreModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	serverId := model.ServerId.ValueString()
+	backupId := model.BackupId.ValueString()
+	region := a.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "server_id", serverId)
+	ctx = tflog.SetField(ctx, "backup_id", backupId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	payload, err := toRestorePayload(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error restoring server backup", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	err = restoreBackup(ctx, a.client.DefaultAPI, projectId, serverId, region, backupId, payload, func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error restoring server backup", err.Error())
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	tflog.Info(ctx, "Server backup restored")
+}
+
+// restoreBackup triggers the restore of the backup and waits until it is finished.
+func restoreBackup(ctx context.Context, client serverbackup.DefaultAPI, projectId, serverId, region, backupId string, payload *serverbackup.RestoreBackupPayload, progress func(string)) error {
+	backup, err := client.GetBackup(ctx, projectId, serverId, region, backupId).Execute()
+	if err != nil {
+		return fmt.Errorf("reading backup: %w", err)
+	}
+	if status := string(backup.GetStatus()); status != backupStatusAvailable {
+		return fmt.Errorf("backup %q can't be restored in status %q", backupId, status)
+	}
+	lastRestoredAt := backup.GetLastRestoredAt()
+
+	progress(fmt.Sprintf("Restoring backup %s of server %s", backupId, serverId))
+	err = client.RestoreBackup(ctx, projectId, serverId, region, backupId).RestoreBackupPayload(*payload).Execute()
+	if err != nil {
+		return fmt.Errorf("restoring backup: %w", err)
+	}
+
+	_, err = restoreBackupWaitHandler(ctx, client, projectId, serverId, region, backupId, lastRestoredAt).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("waiting for restore: %w", err)
+	}
+	progress(fmt.Sprintf("Restore of backup %s finished", backupId))
+	return nil
+}
+
+func toRestorePayload(ctx context.Context, model *RestoreModel) (*serverbackup.RestoreBackupPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	var volumeIds []string
+	if !model.VolumeIds.IsNull() && !model.VolumeIds.IsUnknown() {
+		diags := model.VolumeIds.ElementsAs(ctx, &volumeIds, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("convert volume ids: %w", core.DiagsToError(diags))
+		}
+	}
+	// we should provide null to the API in case no volumeIds were chosen, else it errors
+	if len(volumeIds) == 0 {
+		volumeIds = nil
+	}
+
+	return &serverbackup.RestoreBackupPayload{
+		StartServerAfterRestore: model.StartServerAfterRestore.ValueBool(),
+		VolumeIds:               volumeIds,
+	}, nil
+}
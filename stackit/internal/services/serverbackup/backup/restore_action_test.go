@@ -0,0 +1,67 @@
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	serverbackup "github.com/stackitcloud/stackit-sdk-go/services/serverbackup/v2api"
+)
+
+func TestToRestorePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *RestoreModel
+		expected    *serverbackup.RestoreBackupPayload
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&RestoreModel{
+				StartServerAfterRestore: types.BoolNull(),
+				VolumeIds:               types.ListNull(types.StringType),
+			},
+			&serverbackup.RestoreBackupPayload{
+				StartServerAfterRestore: false,
+			},
+			true,
+		},
+		{
+			"volume_ids",
+			&RestoreModel{
+				StartServerAfterRestore: types.BoolValue(true),
+				VolumeIds:               types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testVolumeId)}),
+			},
+			&serverbackup.RestoreBackupPayload{
+				StartServerAfterRestore: true,
+				VolumeIds:               []string{testVolumeId},
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toRestorePayload(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
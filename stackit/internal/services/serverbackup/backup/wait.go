@@ -0,0 +1,85 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	serverbackup "github.com/stackitcloud/stackit-sdk-go/services/serverbackup/v2api"
+)
+
+const (
+	backupStatusAvailable = "available"
+	backupStatusError     = "error"
+	backupStatusRestoring = "restoring"
+
+	createBackupTimeout  = 2 * time.Hour
+	deleteBackupTimeout  = 30 * time.Minute
+	restoreBackupTimeout = 2 * time.Hour
+)
+
+// createBackupWaitHandler waits until the backup is available.
+func createBackupWaitHandler(ctx context.Context, client serverbackup.DefaultAPI, projectId, serverId, region, backupId string) *wait.AsyncActionHandler[serverbackup.Backup] {
+	handler := wait.New(func() (waitFinished bool, response *serverbackup.Backup, err error) {
+		backup, err := client.GetBackup(ctx, projectId, serverId, region, backupId).Execute()
+		if err != nil {
+			return false, nil, err
+		}
+		switch string(backup.GetStatus()) {
+		case backupStatusAvailable:
+			return true, backup, nil
+		case backupStatusError:
+			return true, backup, fmt.Errorf("backup %q has status %q", backupId, backupStatusError)
+		}
+		return false, backup, nil
+	})
+	handler.SetTimeout(createBackupTimeout)
+	return handler
+}
+
+// deleteBackupWaitHandler waits until the backup is gone.
+func deleteBackupWaitHandler(ctx context.Context, client serverbackup.DefaultAPI, projectId, serverId, region, backupId string) *wait.AsyncActionHandler[serverbackup.Backup] {
+	handler := wait.New(func() (waitFinished bool, response *serverbackup.Backup, err error) {
+		backup, err := client.GetBackup(ctx, projectId, serverId, region, backupId).Execute()
+		if err != nil {
+			var oapiErr *oapierror.GenericOpenAPIError
+			if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+				return true, nil, nil
+			}
+			return false, nil, err
+		}
+		if string(backup.GetStatus()) == backupStatusError {
+			return true, backup, fmt.Errorf("backup %q has status %q", backupId, backupStatusError)
+		}
+		return false, backup, nil
+	})
+	handler.SetTimeout(deleteBackupTimeout)
+	return handler
+}
+
+// restoreBackupWaitHandler waits until the backup is available again and its last restore time differs from the given one.
+// Comparing with a time reported by the API avoids problems with clock skew of the local machine.
+func restoreBackupWaitHandler(ctx context.Context, client serverbackup.DefaultAPI, projectId, serverId, region, backupId, lastRestoredAt string) *wait.AsyncActionHandler[serverbackup.Backup] {
+	handler := wait.New(func() (waitFinished bool, response *serverbackup.Backup, err error) {
+		backup, err := client.GetBackup(ctx, projectId, serverId, region, backupId).Execute()
+		if err != nil {
+			return false, nil, err
+		}
+		switch string(backup.GetStatus()) {
+		case backupStatusRestoring:
+			return false, backup, nil
+		case backupStatusError:
+			return true, backup, fmt.Errorf("backup %q has status %q", backupId, backupStatusError)
+		}
+		if backup.GetLastRestoredAt() != lastRestoredAt {
+			return true, backup, nil
+		}
+		return false, backup, nil
+	})
+	handler.SetTimeout(restoreBackupTimeout)
+	return handler
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 
 	serverbackupUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverbackup/utils"
+	serverupdateUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverupdate/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -32,6 +34,14 @@ import (
 
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	serverbackup "github.com/stackitcloud/stackit-sdk-go/services/serverbackup/v2api"
+	serverupdate "github.com/stackitcloud/stackit-sdk-go/services/serverupdate/v2api"
+)
+
+const (
+	// nextRunsCount is the number of upcoming backups shown in next_runs
+	nextRunsCount = 5
+	// overlapCheckPeriod is the period in which the schedule is checked for overlaps with update schedules
+	overlapCheckPeriod = 30 * 24 * time.Hour
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -51,6 +61,7 @@ type Model struct {
 	Rrule            types.String                   `tfsdk:"rrule"`
 	Enabled          types.Bool                     `tfsdk:"enabled"`
 	BackupProperties *scheduleBackupPropertiesModel `tfsdk:"backup_properties"`
+	NextRuns         types.List                     `tfsdk:"next_runs"`
 	Region           types.String                   `tfsdk:"region"`
 }
 
@@ -69,6 +80,7 @@ func NewScheduleResource() resource.Resource {
 // scheduleResource is the resource implementation.
 type scheduleResource struct {
 	client       *serverbackup.APIClient
+	updateClient *serverupdate.APIClient
 	providerData core.ProviderData
 }
 
@@ -96,6 +108,19 @@ func (r *scheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 		return
 	}
 
+	// the overlap check calls the API, so it only runs if the schedule changed
+	var stateModel *Model
+	if !req.State.Raw.IsNull() {
+		stateModel = &Model{}
+		resp.Diagnostics.Append(req.State.Get(ctx, stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if scheduleChanged(&planModel, stateModel) {
+		r.checkUpdateScheduleOverlap(ctx, &planModel, &resp.Diagnostics)
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -120,6 +145,12 @@ func (r *scheduleResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 	r.client = apiClient
+
+	updateClient := serverupdateUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.updateClient = updateClient
 	tflog.Info(ctx, "Server backup client configured.")
 }
 
@@ -218,6 +249,11 @@ func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					},
 				},
 			},
+			"next_runs": schema.ListAttribute{
+				Description: fmt.Sprintf("The next %d points in time (RFC 3339, UTC) at which the schedule creates a backup, computed from `rrule`. The list moves forward over time, since it is recomputed from the current time on every refresh.", nextRunsCount),
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"region": schema.StringAttribute{
 				Optional: true,
 				// must be computed to allow for storing the override value from the provider
@@ -285,6 +321,7 @@ func (r *scheduleResource) Create(ctx context.Context, req resource.CreateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server backup schedule", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.NextRuns = utils.RruleNextRuns(model.Rrule.ValueString(), time.Now(), nextRunsCount)
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -338,6 +375,7 @@ func (r *scheduleResource) Read(ctx context.Context, req resource.ReadRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading backup schedule", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.NextRuns = utils.RruleNextRuns(model.Rrule.ValueString(), time.Now(), nextRunsCount)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
@@ -390,6 +428,7 @@ func (r *scheduleResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server backup schedule", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.NextRuns = utils.RruleNextRuns(model.Rrule.ValueString(), time.Now(), nextRunsCount)
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -519,6 +558,44 @@ func mapFields(ctx context.Context, schedule *serverbackup.BackupSchedule, model
 	return nil
 }
 
+// checkUpdateScheduleOverlap warns if the backup schedule creates a backup within the maintenance window of an update schedule of the same server.
+// The check is best effort, if the update schedules can't be listed it is skipped with a warning.
+func (r *scheduleResource) checkUpdateScheduleOverlap(ctx context.Context, model *Model, diags *diag.Diagnostics) {
+	if model.Rrule.IsUnknown() || model.ProjectId.IsUnknown() || model.ServerId.IsUnknown() || model.Region.IsUnknown() || !model.Enabled.ValueBool() {
+		return
+	}
+
+	updateSchedules, err := r.updateClient.DefaultAPI.ListUpdateSchedules(ctx, model.ProjectId.ValueString(), model.ServerId.ValueString(), model.Region.ValueString()).Execute()
+	if err != nil {
+		core.LogAndAddWarning(ctx, diags, "Skipped overlap check with server update schedules",
+			fmt.Sprintf("The update schedules of the server couldn't be listed, so the backup schedule isn't checked for overlaps with their maintenance windows: %v", err))
+		return
+	}
+
+	now := time.Now()
+	for _, updateSchedule := range updateSchedules.GetItems() {
+		if !updateSchedule.GetEnabled() {
+			continue
+		}
+		maintenanceWindow := time.Duration(updateSchedule.GetMaintenanceWindow()) * time.Hour
+		overlap, found, err := utils.RruleOverlap(model.Rrule.ValueString(), 0, updateSchedule.GetRrule(), maintenanceWindow, now, now.Add(overlapCheckPeriod))
+		if err != nil || !found {
+			continue
+		}
+		diags.AddAttributeWarning(path.Root("rrule"), "Server backup schedule overlaps with server update schedule",
+			fmt.Sprintf("The backup at %s is created within the maintenance window of the update schedule %q of the same server. "+
+				"Backups created while updates are installed may be inconsistent, consider moving one of the schedules.", overlap.Format(time.RFC3339), updateSchedule.GetName()))
+	}
+}
+
+// scheduleChanged reports if the planned schedule is new or changes the attributes the overlap check depends on.
+func scheduleChanged(plan, state *Model) bool {
+	if state == nil {
+		return true
+	}
+	return !plan.Rrule.Equal(state.Rrule) || !plan.Enabled.Equal(state.Enabled)
+}
+
 // If already enabled, just continues
 
 // Deprecated: This function will be removed on 26.09.2026. Use `server_backup_enable` resource instead.
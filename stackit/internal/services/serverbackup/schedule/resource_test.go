@@ -232,3 +232,51 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduleChanged(t *testing.T) {
+	const rrule = "DTSTART;TZID=Europe/Sofia:20200803T023000 RRULE:FREQ=DAILY;INTERVAL=1"
+	state := &Model{
+		Name:    types.StringValue("name"),
+		Rrule:   types.StringValue(rrule),
+		Enabled: types.BoolValue(true),
+	}
+	tests := []struct {
+		description string
+		plan        *Model
+		state       *Model
+		expected    bool
+	}{
+		{
+			"create",
+			state,
+			nil,
+			true,
+		},
+		{
+			"unchanged",
+			&Model{Name: types.StringValue("other"), Rrule: types.StringValue(rrule), Enabled: types.BoolValue(true)},
+			state,
+			false,
+		},
+		{
+			"rrule_changed",
+			&Model{Rrule: types.StringValue("DTSTART;TZID=Europe/Sofia:20200803T043000 RRULE:FREQ=DAILY;INTERVAL=1"), Enabled: types.BoolValue(true)},
+			state,
+			true,
+		},
+		{
+			"enabled_changed",
+			&Model{Rrule: types.StringValue(rrule), Enabled: types.BoolValue(false)},
+			state,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := scheduleChanged(tt.plan, tt.state)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
@@ -7,10 +7,13 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 
+	serverbackupUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverbackup/utils"
 	serverupdateUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverupdate/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -30,9 +33,17 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	serverbackup "github.com/stackitcloud/stackit-sdk-go/services/serverbackup/v2api"
 	serverupdate "github.com/stackitcloud/stackit-sdk-go/services/serverupdate/v2api"
 )
 
+const (
+	// nextRunsCount is the number of upcoming updates shown in next_runs
+	nextRunsCount = 5
+	// overlapCheckPeriod is the period in which the schedule is checked for overlaps with backup schedules
+	overlapCheckPeriod = 30 * 24 * time.Hour
+)
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &scheduleResource{}
@@ -50,6 +61,7 @@ type Model struct {
 	Rrule             types.String `tfsdk:"rrule"`
 	Enabled           types.Bool   `tfsdk:"enabled"`
 	MaintenanceWindow types.Int32  `tfsdk:"maintenance_window"`
+	NextRuns          types.List   `tfsdk:"next_runs"`
 	Region            types.String `tfsdk:"region"`
 }
 
@@ -61,6 +73,7 @@ func NewScheduleResource() resource.Resource {
 // scheduleResource is the resource implementation.
 type scheduleResource struct {
 	client       *serverupdate.APIClient
+	backupClient *serverbackup.APIClient
 	providerData core.ProviderData
 }
 
@@ -88,6 +101,19 @@ func (r *scheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 		return
 	}
 
+	// the overlap check calls the API, so it only runs if the schedule changed
+	var stateModel *Model
+	if !req.State.Raw.IsNull() {
+		stateModel = &Model{}
+		resp.Diagnostics.Append(req.State.Get(ctx, stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if scheduleChanged(&planModel, stateModel) {
+		r.checkBackupScheduleOverlap(ctx, &planModel, &resp.Diagnostics)
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -112,6 +138,12 @@ func (r *scheduleResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 	r.client = apiClient
+
+	backupClient := serverbackupUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.backupClient = backupClient
 	tflog.Info(ctx, "Server update client configured.")
 }
 
@@ -196,6 +228,11 @@ func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					int32validator.AtMost(24),
 				},
 			},
+			"next_runs": schema.ListAttribute{
+				Description: fmt.Sprintf("The next %d points in time (RFC 3339, UTC) at which the schedule starts an update, computed from `rrule`. The list moves forward over time, since it is recomputed from the current time on every refresh.", nextRunsCount),
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"region": schema.StringAttribute{
 				Optional: true,
 				// must be computed to allow for storing the override value from the provider
@@ -262,6 +299,7 @@ func (r *scheduleResource) Create(ctx context.Context, req resource.CreateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server update schedule", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.NextRuns = utils.RruleNextRuns(model.Rrule.ValueString(), time.Now(), nextRunsCount)
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -314,6 +352,7 @@ func (r *scheduleResource) Read(ctx context.Context, req resource.ReadRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading update schedule", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.NextRuns = utils.RruleNextRuns(model.Rrule.ValueString(), time.Now(), nextRunsCount)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
@@ -365,6 +404,7 @@ func (r *scheduleResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server update schedule", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.NextRuns = utils.RruleNextRuns(model.Rrule.ValueString(), time.Now(), nextRunsCount)
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -457,6 +497,44 @@ func mapFields(schedule *serverupdate.UpdateSchedule, model *Model, region strin
 	return nil
 }
 
+// checkBackupScheduleOverlap warns if a backup schedule of the same server creates a backup within the maintenance window of the update schedule.
+// The check is best effort, if the backup schedules can't be listed it is skipped with a warning.
+func (r *scheduleResource) checkBackupScheduleOverlap(ctx context.Context, model *Model, diags *diag.Diagnostics) {
+	if model.Rrule.IsUnknown() || model.MaintenanceWindow.IsUnknown() || model.ProjectId.IsUnknown() || model.ServerId.IsUnknown() || model.Region.IsUnknown() || !model.Enabled.ValueBool() {
+		return
+	}
+
+	backupSchedules, err := r.backupClient.DefaultAPI.ListBackupSchedules(ctx, model.ProjectId.ValueString(), model.ServerId.ValueString(), model.Region.ValueString()).Execute()
+	if err != nil {
+		core.LogAndAddWarning(ctx, diags, "Skipped overlap check with server backup schedules",
+			fmt.Sprintf("The backup schedules of the server couldn't be listed, so the maintenance window isn't checked for overlaps with backups: %v", err))
+		return
+	}
+
+	now := time.Now()
+	maintenanceWindow := time.Duration(model.MaintenanceWindow.ValueInt32()) * time.Hour
+	for _, backupSchedule := range backupSchedules.GetItems() {
+		if !backupSchedule.GetEnabled() {
+			continue
+		}
+		overlap, found, err := utils.RruleOverlap(model.Rrule.ValueString(), maintenanceWindow, backupSchedule.GetRrule(), 0, now, now.Add(overlapCheckPeriod))
+		if err != nil || !found {
+			continue
+		}
+		diags.AddAttributeWarning(path.Root("rrule"), "Server update schedule overlaps with server backup schedule",
+			fmt.Sprintf("The maintenance window starting at %s contains a backup of the backup schedule %q of the same server. "+
+				"Backups created while updates are installed may be inconsistent, consider moving one of the schedules.", overlap.Format(time.RFC3339), backupSchedule.GetName()))
+	}
+}
+
+// scheduleChanged reports if the planned schedule is new or changes the attributes the overlap check depends on.
+func scheduleChanged(plan, state *Model) bool {
+	if state == nil {
+		return true
+	}
+	return !plan.Rrule.Equal(state.Rrule) || !plan.Enabled.Equal(state.Enabled) || !plan.MaintenanceWindow.Equal(state.MaintenanceWindow)
+}
+
 // If already enabled, just continues
 
 // Deprecated: This function will be removed on 26.09.2026. Use `server_update_enable` resource instead.
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	serverupdateUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverupdate/utils"
@@ -104,6 +105,11 @@ func (r *scheduleDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "Maintenance window [1..24]. Updates start within the defined hourly window. Depending on the updates, the process may exceed this timeframe and require an automatic restart.",
 				Computed:    true,
 			},
+			"next_runs": schema.ListAttribute{
+				Description: fmt.Sprintf("The next %d points in time (RFC 3339, UTC) at which the schedule starts an update, computed from `rrule`. The list moves forward over time, since it is recomputed from the current time on every read.", nextRunsCount),
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"region": schema.StringAttribute{
 				// the region cannot be found, so it has to be passed
 				Optional:    true,
@@ -157,6 +163,7 @@ func (r *scheduleDataSource) Read(ctx context.Context, req datasource.ReadReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server update schedule", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.NextRuns = utils.RruleNextRuns(model.Rrule.ValueString(), time.Now(), nextRunsCount)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
@@ -0,0 +1,198 @@
+package update
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	serverupdate "github.com/stackitcloud/stackit-sdk-go/services/serverupdate/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	serverupdateUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverupdate/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+const (
+	updateStatusFailed = "failed"
+
+	// An update may exceed the maintenance window of at most 24 hours, e.g. because of an automatic restart
+	runTimeout = 26 * time.Hour
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &runAction{}
+	_ action.ActionWithConfigure = &runAction{}
+)
+
+type RunModel struct {
+	ProjectId         types.String `tfsdk:"project_id"`
+	ServerId          types.String `tfsdk:"server_id"`
+	MaintenanceWindow types.Int32  `tfsdk:"maintenance_window"`
+	Region            types.String `tfsdk:"region"`
+}
+
+// NewRunAction is a helper function to simplify the provider implementation.
+func NewRunAction() action.Action {
+	return &runAction{}
+}
+
+// runAction is the action implementation.
+type runAction struct {
+	client       *serverupdate.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the action type name.
+func (a *runAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_update_run"
+}
+
+// Configure adds the provider configured client to the action.
+func (a *runAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	var ok bool
+	a.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := serverupdateUtils.ConfigureClient(ctx, &a.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	a.client = apiClient
+	tflog.Info(ctx, "Server update client configured")
+}
+
+// Schema defines the schema for the action.
+func (a *runAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "Triggers an immediate update of a server and waits until the update is finished. " +
+			"The server update service must be enabled for the server, e.g. with the `stackit_server_update_enable` resource. " +
+			"Use it with `terraform apply -invoke` or in an `action_trigger` of a `lifecycle` block. Must have a `region` specified in the provider configuration.",
+		"project_id":         "STACKIT Project ID to which the server is associated.",
+		"server_id":          "Server ID (UUID) of the server to update.",
+		"maintenance_window": "Maintenance window [1..24]. Updates start within the defined hourly window. Depending on the updates, the process may exceed this timeframe and require an automatic restart.",
+		"region":             "The resource region. If not defined, the provider region is used.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: descriptions["server_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"maintenance_window": schema.Int32Attribute{
+				Description: descriptions["maintenance_window"],
+				Required:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.AtMost(24),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: descriptions["region"],
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Invoke triggers the update.
+func (a *runAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model RunModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	serverId := model.ServerId.ValueString()
+	region := a.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "server_id", serverId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	update, err := runUpdate(ctx, a.client.DefaultAPI, projectId, serverId, region, toCreatePayload(&model), func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error running server update", err.Error())
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	tflog.Info(ctx, "Server update finished", map[string]any{
+		"update_id":         update.GetId(),
+		"installed_updates": update.GetInstalledUpdates(),
+		"failed_updates":    update.GetFailedUpdates(),
+	})
+}
+
+// runUpdate triggers an update of the server and waits until it is finished.
+func runUpdate(ctx context.Context, client serverupdate.DefaultAPI, projectId, serverId, region string, payload *serverupdate.CreateUpdatePayload, progress func(string)) (*serverupdate.Update, error) {
+	progress(fmt.Sprintf("Starting update of server %s", serverId))
+	update, err := client.CreateUpdate(ctx, projectId, serverId, region).CreateUpdatePayload(*payload).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("starting update: %w", err)
+	}
+	updateId := strconv.FormatInt(int64(update.GetId()), 10)
+
+	update, err = runUpdateWaitHandler(ctx, client, projectId, serverId, region, updateId).WaitWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for update %s: %w", updateId, err)
+	}
+	progress(fmt.Sprintf("Update %s of server %s finished: %d updates installed, %d updates failed", updateId, serverId, update.GetInstalledUpdates(), update.GetFailedUpdates()))
+	return update, nil
+}
+
+// runUpdateWaitHandler waits until the update has an end date, i.e. it is finished.
+func runUpdateWaitHandler(ctx context.Context, client serverupdate.DefaultAPI, projectId, serverId, region, updateId string) *wait.AsyncActionHandler[serverupdate.Update] {
+	handler := wait.New(func() (waitFinished bool, response *serverupdate.Update, err error) {
+		update, err := client.GetUpdate(ctx, projectId, serverId, updateId, region).Execute()
+		if err != nil {
+			return false, nil, err
+		}
+		if string(update.GetStatus()) == updateStatusFailed {
+			return true, update, fmt.Errorf("update failed: %s", update.GetFailReason())
+		}
+		if update.GetEndDate() != "" {
+			return true, update, nil
+		}
+		return false, update, nil
+	})
+	handler.SetTimeout(runTimeout)
+	return handler
+}
+
+func toCreatePayload(model *RunModel) *serverupdate.CreateUpdatePayload {
+	return &serverupdate.CreateUpdatePayload{
+		MaintenanceWindow: model.MaintenanceWindow.ValueInt32(),
+	}
+}
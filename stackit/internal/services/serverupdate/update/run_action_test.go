@@ -0,0 +1,38 @@
+package update
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	serverupdate "github.com/stackitcloud/stackit-sdk-go/services/serverupdate/v2api"
+)
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *RunModel
+		expected    *serverupdate.CreateUpdatePayload
+	}{
+		{
+			"maintenance_window",
+			&RunModel{
+				ProjectId:         types.StringValue(testProjectId),
+				ServerId:          types.StringValue(testServerId),
+				MaintenanceWindow: types.Int32Value(3),
+			},
+			&serverupdate.CreateUpdatePayload{
+				MaintenanceWindow: 3,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toCreatePayload(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
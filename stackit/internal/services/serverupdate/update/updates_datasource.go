@@ -0,0 +1,239 @@
+package update
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	serverupdate "github.com/stackitcloud/stackit-sdk-go/services/serverupdate/v2api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	serverupdateUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverupdate/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &updatesDataSource{}
+)
+
+// NewUpdatesDataSource is a helper function to simplify the provider implementation.
+func NewUpdatesDataSource() datasource.DataSource {
+	return &updatesDataSource{}
+}
+
+// updatesDataSource is the data source implementation.
+type updatesDataSource struct {
+	client       *serverupdate.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (r *updatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_updates"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *updatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := serverupdateUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client = apiClient
+	tflog.Info(ctx, "Server update client configured")
+}
+
+// Schema defines the schema for the data source.
+func (r *updatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Server updates datasource schema. Lists the past and running updates of a server, including the ones started by update schedules. Must have a `region` specified in the provider configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source identifier. It is structured as \"`project_id`,`region`,`server_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT Project ID (UUID) to which the server is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: "Server ID (UUID) to which the updates are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of updates of the server.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"update_id": schema.Int32Attribute{
+							Description: "Update ID.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The update status.",
+							Computed:    true,
+						},
+						"start_date": schema.StringAttribute{
+							Description: "Date and time when the update was started.",
+							Computed:    true,
+						},
+						"end_date": schema.StringAttribute{
+							Description: "Date and time when the update was finished.",
+							Computed:    true,
+						},
+						"installed_updates": schema.Int64Attribute{
+							Description: "Number of installed updates.",
+							Computed:    true,
+						},
+						"failed_updates": schema.Int64Attribute{
+							Description: "Number of updates that failed to install.",
+							Computed:    true,
+						},
+						"fail_reason": schema.StringAttribute{
+							Description: "Reason why the update failed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"region": schema.StringAttribute{
+				// the region cannot be found, so it has to be passed
+				Optional:    true,
+				Description: "The resource region. If not defined, the provider region is used.",
+			},
+		},
+	}
+}
+
+// updatesDataSourceModel maps the data source schema data.
+type updatesDataSourceModel struct {
+	ID        types.String                 `tfsdk:"id"`
+	ProjectId types.String                 `tfsdk:"project_id"`
+	ServerId  types.String                 `tfsdk:"server_id"`
+	Items     []updatesDatasourceItemModel `tfsdk:"items"`
+	Region    types.String                 `tfsdk:"region"`
+}
+
+// updatesDatasourceItemModel maps update schema data.
+type updatesDatasourceItemModel struct {
+	UpdateId         types.Int32  `tfsdk:"update_id"`
+	Status           types.String `tfsdk:"status"`
+	StartDate        types.String `tfsdk:"start_date"`
+	EndDate          types.String `tfsdk:"end_date"`
+	InstalledUpdates types.Int64  `tfsdk:"installed_updates"`
+	FailedUpdates    types.Int64  `tfsdk:"failed_updates"`
+	FailReason       types.String `tfsdk:"fail_reason"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *updatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model updatesDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	serverId := model.ServerId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "server_id", serverId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	updates, err := r.client.DefaultAPI.ListUpdates(ctx, projectId, serverId, region).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading server updates",
+			fmt.Sprintf("Server with ID %q does not exist in project %q.", serverId, projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Map response body to schema
+	err = mapUpdatesDatasourceFields(updates.GetItems(), &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server updates", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Server updates read")
+}
+
+func mapUpdatesDatasourceFields(updates []serverupdate.Update, model *updatesDataSourceModel, region string) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.ID = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.ServerId.ValueString())
+	model.Region = types.StringValue(region)
+
+	model.Items = nil
+	for i := range updates {
+		update := &updates[i]
+		model.Items = append(model.Items, updatesDatasourceItemModel{
+			UpdateId:         types.Int32Value(int32(update.GetId())),
+			Status:           types.StringValue(string(update.GetStatus())),
+			StartDate:        optionalString(update.GetStartDateOk()),
+			EndDate:          optionalString(update.GetEndDateOk()),
+			InstalledUpdates: optionalInt64(update.GetInstalledUpdatesOk()),
+			FailedUpdates:    optionalInt64(update.GetFailedUpdatesOk()),
+			FailReason:       optionalString(update.GetFailReasonOk()),
+		})
+	}
+	return nil
+}
+
+func optionalString(value *string, ok bool) types.String {
+	if !ok || value == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+func optionalInt64[T ~int32 | ~int64](value *T, ok bool) types.Int64 {
+	if !ok || value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
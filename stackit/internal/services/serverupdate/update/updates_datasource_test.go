@@ -0,0 +1,120 @@
+package update
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	serverupdate "github.com/stackitcloud/stackit-sdk-go/services/serverupdate/v2api"
+)
+
+const (
+	testProjectId = "b8c3fbaa-3ab4-4a8e-9584-de22453d046f"
+	testServerId  = "e2e9f3c7-5b1c-4a1b-9c52-67d7e6b1f0a4"
+	testRegion    = "eu01"
+)
+
+func fixtureUpdate(mods ...func(*serverupdate.Update)) serverupdate.Update {
+	update := serverupdate.Update{}
+	update.SetId(5)
+	update.SetStatus("running")
+	update.SetStartDate("2026-01-02T03:04:05Z")
+	for _, mod := range mods {
+		mod(&update)
+	}
+	return update
+}
+
+func TestMapUpdatesDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []serverupdate.Update
+		expected    *updatesDataSourceModel
+		isValid     bool
+	}{
+		{
+			"empty_response",
+			[]serverupdate.Update{},
+			&updatesDataSourceModel{
+				ID:        types.StringValue(testProjectId + "," + testRegion + "," + testServerId),
+				ProjectId: types.StringValue(testProjectId),
+				ServerId:  types.StringValue(testServerId),
+				Items:     nil,
+				Region:    types.StringValue(testRegion),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			[]serverupdate.Update{
+				fixtureUpdate(func(update *serverupdate.Update) {
+					update.SetStatus("finished")
+					update.SetEndDate("2026-01-02T04:04:05Z")
+					update.SetInstalledUpdates(12)
+					update.SetFailedUpdates(1)
+					update.SetFailReason("package conflict")
+				}),
+				fixtureUpdate(func(update *serverupdate.Update) {
+					update.SetId(6)
+				}),
+			},
+			&updatesDataSourceModel{
+				ID:        types.StringValue(testProjectId + "," + testRegion + "," + testServerId),
+				ProjectId: types.StringValue(testProjectId),
+				ServerId:  types.StringValue(testServerId),
+				Items: []updatesDatasourceItemModel{
+					{
+						UpdateId:         types.Int32Value(5),
+						Status:           types.StringValue("finished"),
+						StartDate:        types.StringValue("2026-01-02T03:04:05Z"),
+						EndDate:          types.StringValue("2026-01-02T04:04:05Z"),
+						InstalledUpdates: types.Int64Value(12),
+						FailedUpdates:    types.Int64Value(1),
+						FailReason:       types.StringValue("package conflict"),
+					},
+					{
+						UpdateId:         types.Int32Value(6),
+						Status:           types.StringValue("running"),
+						StartDate:        types.StringValue("2026-01-02T03:04:05Z"),
+						EndDate:          types.StringNull(),
+						InstalledUpdates: types.Int64Null(),
+						FailedUpdates:    types.Int64Null(),
+						FailReason:       types.StringNull(),
+					},
+				},
+				Region: types.StringValue(testRegion),
+			},
+			true,
+		},
+		{
+			"nil_model",
+			[]serverupdate.Update{},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var state *updatesDataSourceModel
+			if tt.expected != nil {
+				state = &updatesDataSourceModel{
+					ProjectId: tt.expected.ProjectId,
+					ServerId:  tt.expected.ServerId,
+				}
+			}
+			err := mapUpdatesDatasourceFields(tt.input, state, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
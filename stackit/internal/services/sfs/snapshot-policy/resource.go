@@ -0,0 +1,529 @@
+package snapshot_policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sfs "github.com/stackitcloud/stackit-sdk-go/services/sfs/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	sfsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sfs/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &policyResource{}
+	_ resource.ResourceWithConfigure   = &policyResource{}
+	_ resource.ResourceWithImportState = &policyResource{}
+)
+
+// cronRegex matches a cron expression with the five fields minute, hour, day of month, month and day of week
+var cronRegex = regexp.MustCompile(`^\S+(\s+\S+){4}$`)
+
+// retentionPeriodRegex matches an ISO 8601 duration or "infinite"
+var retentionPeriodRegex = regexp.MustCompile(`^(infinite|P(\d+Y)?(\d+M)?(\d+W)?(\d+D)?(T(\d+H)?(\d+M)?(\d+S)?)?)$`)
+
+type Model struct {
+	Id                types.String `tfsdk:"id"` // needed by TF
+	ProjectId         types.String `tfsdk:"project_id"`
+	PolicyId          types.String `tfsdk:"policy_id"`
+	Name              types.String `tfsdk:"name"`
+	Comment           types.String `tfsdk:"comment"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	SnapshotSchedules types.List   `tfsdk:"snapshot_schedules"`
+}
+
+type scheduleModel struct {
+	Name            types.String `tfsdk:"name"`
+	Interval        types.String `tfsdk:"interval"`
+	Prefix          types.String `tfsdk:"prefix"`
+	RetentionCount  types.Int32  `tfsdk:"retention_count"`
+	RetentionPeriod types.String `tfsdk:"retention_period"`
+}
+
+// Types corresponding to scheduleModel
+var scheduleTypes = map[string]attr.Type{
+	"name":             types.StringType,
+	"interval":         types.StringType,
+	"prefix":           types.StringType,
+	"retention_count":  types.Int32Type,
+	"retention_period": types.StringType,
+}
+
+func NewSnapshotPolicyResource() resource.Resource {
+	return &policyResource{}
+}
+
+type policyResource struct {
+	client       *sfs.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *policyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sfs_snapshot_policy"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *policyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &r.providerData, &resp.Diagnostics, "stackit_sfs_snapshot_policy", core.Resource)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := sfsUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "SFS client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *policyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "SFS snapshot policy resource schema. Snapshot policies create snapshots of the resource pools they are assigned to on a schedule, " +
+		"see the `snapshot_policy` attribute of `stackit_sfs_resource_pool`."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: features.AddBetaDescription(description, core.Resource),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`policy_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the snapshot policy is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				Description: "Snapshot policy ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the snapshot policy.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment of the snapshot policy.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the snapshot policy is enabled. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Created At timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_schedules": schema.ListNestedAttribute{
+				Description: "Schedules of the snapshot policy.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the snapshot schedule.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"interval": schema.StringAttribute{
+							Description: "Interval of the snapshot schedule as cron expression, e.g. `0 2 * * *` for every day at 2am.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(cronRegex, "must be a cron expression with five fields"),
+							},
+						},
+						"prefix": schema.StringAttribute{
+							Description: "Prefix used for snapshots created by this schedule.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"retention_count": schema.Int32Attribute{
+							Description: "Number of snapshots of this schedule which are kept.",
+							Optional:    true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"retention_period": schema.StringAttribute{
+							Description: "Period for which the snapshots of this schedule are kept, in ISO 8601 format (e.g. `P7D`) or `infinite`.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(retentionPeriodRegex, "must be an ISO 8601 duration or \"infinite\""),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { //nolint:gocritic // defined by terraform api
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	ctx = core.InitProviderContext(ctx)
+
+	payload, err := toCreatePayload(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating snapshot policy", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	createResp, err := r.client.DefaultAPI.CreateSnapshotPolicy(ctx, projectId).CreateSnapshotPolicyPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating snapshot policy", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	if createResp == nil || createResp.SnapshotPolicy == nil || createResp.SnapshotPolicy.Id == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating snapshot policy", "response did not contain an ID")
+		return
+	}
+	policyId := *createResp.SnapshotPolicy.Id
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": projectId,
+		"policy_id":  policyId,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.DefaultAPI.GetSnapshotPolicy(ctx, projectId, policyId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating snapshot policy", fmt.Sprintf("Calling API to get snapshot policy: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, getResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating snapshot policy", fmt.Sprintf("Processing API response: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SFS snapshot policy created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { //nolint:gocritic // defined by terraform api
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	policyId := model.PolicyId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "policy_id", policyId)
+
+	ctx = core.InitProviderContext(ctx)
+
+	getResp, err := r.client.DefaultAPI.GetSnapshotPolicy(ctx, projectId, policyId).Execute()
+	if err != nil {
+		var openapiError *oapierror.GenericOpenAPIError
+		if errors.As(err, &openapiError) {
+			if openapiError.StatusCode == http.StatusNotFound {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading snapshot policy", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(ctx, getResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading snapshot policy", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SFS snapshot policy read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { //nolint:gocritic // defined by terraform api
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	policyId := model.PolicyId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "policy_id", policyId)
+
+	ctx = core.InitProviderContext(ctx)
+
+	payload, err := toUpdatePayload(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating snapshot policy", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	_, err = r.client.DefaultAPI.UpdateSnapshotPolicy(ctx, projectId, policyId).UpdateSnapshotPolicyPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating snapshot policy", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	getResp, err := r.client.DefaultAPI.GetSnapshotPolicy(ctx, projectId, policyId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating snapshot policy", fmt.Sprintf("Calling API to get snapshot policy: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, getResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating snapshot policy", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SFS snapshot policy updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { //nolint:gocritic // defined by terraform api
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	policyId := model.PolicyId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "policy_id", policyId)
+
+	ctx = core.InitProviderContext(ctx)
+
+	_, err := r.client.DefaultAPI.DeleteSnapshotPolicy(ctx, projectId, policyId).Execute()
+	if err != nil {
+		var openapiError *oapierror.GenericOpenAPIError
+		if errors.As(err, &openapiError) {
+			if openapiError.StatusCode == http.StatusNotFound {
+				return
+			}
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting snapshot policy", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	tflog.Info(ctx, "SFS snapshot policy deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the snapshot policy resource import identifier is: project_id,policy_id
+func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing snapshot policy",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[policy_id]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": idParts[0],
+		"policy_id":  idParts[1],
+	})
+	tflog.Info(ctx, "SFS snapshot policy state imported")
+}
+
+func mapFields(ctx context.Context, resp *sfs.GetSnapshotPolicyResponse, model *Model) error {
+	if resp == nil || resp.SnapshotPolicy == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	policy := resp.SnapshotPolicy
+
+	var policyId string
+	if model.PolicyId.ValueString() != "" {
+		policyId = model.PolicyId.ValueString()
+	} else if policy.Id != nil {
+		policyId = *policy.Id
+	} else {
+		return fmt.Errorf("snapshot policy id not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), policyId)
+	model.PolicyId = types.StringValue(policyId)
+	model.Name = types.StringPointerValue(policy.Name)
+	model.Comment = types.StringPointerValue(policy.Comment)
+	model.Enabled = types.BoolPointerValue(policy.Enabled)
+	model.CreatedAt = types.StringNull()
+	if policy.CreatedAt != nil {
+		model.CreatedAt = types.StringValue(policy.CreatedAt.String())
+	}
+
+	schedules := make([]attr.Value, 0, len(policy.SnapshotSchedules))
+	for _, schedule := range policy.SnapshotSchedules {
+		scheduleValue, diags := types.ObjectValue(scheduleTypes, map[string]attr.Value{
+			"name":             types.StringPointerValue(schedule.Name),
+			"interval":         types.StringPointerValue(schedule.Interval),
+			"prefix":           types.StringPointerValue(schedule.Prefix),
+			"retention_count":  types.Int32PointerValue(schedule.RetentionCount),
+			"retention_period": types.StringPointerValue(schedule.RetentionPeriod),
+		})
+		if diags.HasError() {
+			return fmt.Errorf("converting snapshot schedule to TF types: %w", core.DiagsToError(diags))
+		}
+		schedules = append(schedules, scheduleValue)
+	}
+	schedulesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: scheduleTypes}, schedules)
+	if diags.HasError() {
+		return fmt.Errorf("mapping snapshot schedules: %w", core.DiagsToError(diags))
+	}
+	model.SnapshotSchedules = schedulesList
+	return nil
+}
+
+func toSchedulesPayload(ctx context.Context, model *Model) ([]sfs.SnapshotScheduleRequest, error) {
+	var schedules []scheduleModel
+	if !utils.IsUndefined(model.SnapshotSchedules) {
+		diags := model.SnapshotSchedules.ElementsAs(ctx, &schedules, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting snapshot schedules: %w", core.DiagsToError(diags))
+		}
+	}
+
+	// the schedules are never sent as null, the API expects at least an empty list
+	payload := make([]sfs.SnapshotScheduleRequest, 0, len(schedules))
+	for _, schedule := range schedules {
+		payload = append(payload, sfs.SnapshotScheduleRequest{
+			Name:            schedule.Name.ValueString(),
+			Interval:        schedule.Interval.ValueString(),
+			Prefix:          conversion.StringValueToPointer(schedule.Prefix),
+			RetentionCount:  conversion.Int32ValueToPointer(schedule.RetentionCount),
+			RetentionPeriod: conversion.StringValueToPointer(schedule.RetentionPeriod),
+		})
+	}
+	return payload, nil
+}
+
+func toCreatePayload(ctx context.Context, model *Model) (*sfs.CreateSnapshotPolicyPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	schedules, err := toSchedulesPayload(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	return &sfs.CreateSnapshotPolicyPayload{
+		Name:              model.Name.ValueString(),
+		Comment:           conversion.StringValueToPointer(model.Comment),
+		Enabled:           conversion.BoolValueToPointer(model.Enabled),
+		SnapshotSchedules: schedules,
+	}, nil
+}
+
+func toUpdatePayload(ctx context.Context, model *Model) (*sfs.UpdateSnapshotPolicyPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	schedules, err := toSchedulesPayload(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	return &sfs.UpdateSnapshotPolicyPayload{
+		Name: conversion.StringValueToPointer(model.Name),
+		// a null comment removes the comment of the policy
+		Comment:           *sfs.NewNullableString(conversion.StringValueToPointer(model.Comment)),
+		Enabled:           conversion.BoolValueToPointer(model.Enabled),
+		SnapshotSchedules: schedules,
+	}, nil
+}
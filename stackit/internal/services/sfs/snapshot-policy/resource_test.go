@@ -0,0 +1,312 @@
+package snapshot_policy
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sfs "github.com/stackitcloud/stackit-sdk-go/services/sfs/v1api"
+)
+
+const (
+	testProjectId = "b8c3fbaa-3ab4-4a8e-9584-de22453d046f"
+	testPolicyId  = "policy-id"
+)
+
+func fixtureSchedules(schedules ...map[string]attr.Value) types.List {
+	values := make([]attr.Value, 0, len(schedules))
+	for _, schedule := range schedules {
+		values = append(values, types.ObjectValueMust(scheduleTypes, schedule))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: scheduleTypes}, values)
+}
+
+func TestMapResourceFields(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		state    *Model
+		input    *sfs.GetSnapshotPolicyResponse
+		expected *Model
+		isValid  bool
+	}{
+		{
+			"default_values",
+			&Model{
+				ProjectId: types.StringValue(testProjectId),
+				PolicyId:  types.StringValue(testPolicyId),
+			},
+			&sfs.GetSnapshotPolicyResponse{
+				SnapshotPolicy: &sfs.SnapshotPolicy{},
+			},
+			&Model{
+				Id:                types.StringValue(testProjectId + "," + testPolicyId),
+				ProjectId:         types.StringValue(testProjectId),
+				PolicyId:          types.StringValue(testPolicyId),
+				Name:              types.StringNull(),
+				Comment:           types.StringNull(),
+				Enabled:           types.BoolNull(),
+				CreatedAt:         types.StringNull(),
+				SnapshotSchedules: fixtureSchedules(),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&Model{
+				ProjectId: types.StringValue(testProjectId),
+			},
+			&sfs.GetSnapshotPolicyResponse{
+				SnapshotPolicy: &sfs.SnapshotPolicy{
+					Id:        new(testPolicyId),
+					Name:      new("daily"),
+					Comment:   new("comment"),
+					Enabled:   new(true),
+					CreatedAt: new(now),
+					SnapshotSchedules: []sfs.SnapshotPolicySnapshotPolicySchedule{
+						{
+							Id:              new("schedule-id"),
+							CreatedAt:       new(now),
+							Name:            new("nightly"),
+							Interval:        new("0 2 * * *"),
+							Prefix:          new("nightly"),
+							RetentionCount:  new(int32(7)),
+							RetentionPeriod: new("P7D"),
+						},
+						{
+							Name:     new("hourly"),
+							Interval: new("0 * * * *"),
+						},
+					},
+				},
+			},
+			&Model{
+				Id:        types.StringValue(testProjectId + "," + testPolicyId),
+				ProjectId: types.StringValue(testProjectId),
+				PolicyId:  types.StringValue(testPolicyId),
+				Name:      types.StringValue("daily"),
+				Comment:   types.StringValue("comment"),
+				Enabled:   types.BoolValue(true),
+				CreatedAt: types.StringValue(now.String()),
+				SnapshotSchedules: fixtureSchedules(
+					map[string]attr.Value{
+						"name":             types.StringValue("nightly"),
+						"interval":         types.StringValue("0 2 * * *"),
+						"prefix":           types.StringValue("nightly"),
+						"retention_count":  types.Int32Value(7),
+						"retention_period": types.StringValue("P7D"),
+					},
+					map[string]attr.Value{
+						"name":             types.StringValue("hourly"),
+						"interval":         types.StringValue("0 * * * *"),
+						"prefix":           types.StringNull(),
+						"retention_count":  types.Int32Null(),
+						"retention_period": types.StringNull(),
+					},
+				),
+			},
+			true,
+		},
+		{
+			"no_id",
+			&Model{
+				ProjectId: types.StringValue(testProjectId),
+			},
+			&sfs.GetSnapshotPolicyResponse{
+				SnapshotPolicy: &sfs.SnapshotPolicy{},
+			},
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mapFields(context.Background(), tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *Model
+		expected *sfs.CreateSnapshotPolicyPayload
+		isValid  bool
+	}{
+		{
+			"default_values",
+			&Model{
+				Name:              types.StringValue("daily"),
+				Enabled:           types.BoolValue(true),
+				SnapshotSchedules: fixtureSchedules(),
+			},
+			&sfs.CreateSnapshotPolicyPayload{
+				Name:              "daily",
+				Enabled:           new(true),
+				SnapshotSchedules: []sfs.SnapshotScheduleRequest{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&Model{
+				Name:    types.StringValue("daily"),
+				Comment: types.StringValue("comment"),
+				Enabled: types.BoolValue(false),
+				SnapshotSchedules: fixtureSchedules(map[string]attr.Value{
+					"name":             types.StringValue("nightly"),
+					"interval":         types.StringValue("0 2 * * *"),
+					"prefix":           types.StringValue("nightly"),
+					"retention_count":  types.Int32Value(7),
+					"retention_period": types.StringNull(),
+				}),
+			},
+			&sfs.CreateSnapshotPolicyPayload{
+				Name:    "daily",
+				Comment: new("comment"),
+				Enabled: new(false),
+				SnapshotSchedules: []sfs.SnapshotScheduleRequest{
+					{
+						Name:           "nightly",
+						Interval:       "0 2 * * *",
+						Prefix:         new("nightly"),
+						RetentionCount: new(int32(7)),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := toCreatePayload(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *Model
+		expected *sfs.UpdateSnapshotPolicyPayload
+		isValid  bool
+	}{
+		{
+			"remove_comment",
+			&Model{
+				Name:    types.StringValue("daily"),
+				Comment: types.StringNull(),
+				Enabled: types.BoolValue(true),
+				SnapshotSchedules: fixtureSchedules(map[string]attr.Value{
+					"name":             types.StringValue("nightly"),
+					"interval":         types.StringValue("0 2 * * *"),
+					"prefix":           types.StringNull(),
+					"retention_count":  types.Int32Null(),
+					"retention_period": types.StringValue("infinite"),
+				}),
+			},
+			&sfs.UpdateSnapshotPolicyPayload{
+				Name:    new("daily"),
+				Comment: *sfs.NewNullableString(nil),
+				Enabled: new(true),
+				SnapshotSchedules: []sfs.SnapshotScheduleRequest{
+					{
+						Name:            "nightly",
+						Interval:        "0 2 * * *",
+						RetentionPeriod: new("infinite"),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := toUpdatePayload(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if !reflect.DeepEqual(output, tt.expected) {
+					t.Fatalf("Data does not match: %v, want %v", output, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestRegexes(t *testing.T) {
+	tests := []struct {
+		name    string
+		regex   interface{ MatchString(string) bool }
+		input   string
+		matches bool
+	}{
+		{"cron daily", cronRegex, "0 2 * * *", true},
+		{"cron ranges", cronRegex, "*/15 8-18 * * 1-5", true},
+		{"cron too short", cronRegex, "0 2 * *", false},
+		{"cron too long", cronRegex, "0 0 2 * * *", false},
+		{"period days", retentionPeriodRegex, "P7D", true},
+		{"period time", retentionPeriodRegex, "PT12H", true},
+		{"period combined", retentionPeriodRegex, "P1Y2M3DT4H", true},
+		{"period infinite", retentionPeriodRegex, "infinite", true},
+		{"period invalid", retentionPeriodRegex, "7 days", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.regex.MatchString(tt.input); got != tt.matches {
+				t.Errorf("expected match %v for %q, got %v", tt.matches, tt.input, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,491 @@
+package snapshots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sfs "github.com/stackitcloud/stackit-sdk-go/services/sfs/v1api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	sfsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sfs/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &snapshotResource{}
+	_ resource.ResourceWithConfigure   = &snapshotResource{}
+	_ resource.ResourceWithImportState = &snapshotResource{}
+	_ resource.ResourceWithModifyPlan  = &snapshotResource{}
+)
+
+type Model struct {
+	Id                   types.String `tfsdk:"id"` // needed by TF
+	ProjectId            types.String `tfsdk:"project_id"`
+	Region               types.String `tfsdk:"region"`
+	ResourcePoolId       types.String `tfsdk:"resource_pool_id"`
+	Name                 types.String `tfsdk:"name"`
+	Comment              types.String `tfsdk:"comment"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	SizeGigabytes        types.Int32  `tfsdk:"size_gigabytes"`
+	LogicalSizeGigabytes types.Int32  `tfsdk:"logical_size_gigabytes"`
+}
+
+func NewSnapshotResource() resource.Resource {
+	return &snapshotResource{}
+}
+
+type snapshotResource struct {
+	client       *sfs.APIClient
+	providerData core.ProviderData
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *snapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *snapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sfs_snapshot"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *snapshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &r.providerData, &resp.Diagnostics, "stackit_sfs_snapshot", core.Resource)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := sfsUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "SFS client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *snapshotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// the SFS API provides no operation to restore a resource pool or share from a snapshot or to create one from it
+	description := "SFS snapshot resource schema. Creates an on-demand snapshot of a resource pool. Must have a `region` specified in the provider configuration. " +
+		"Snapshots can't be restored with this provider. To recover files, set `snapshots_are_visible` of the `stackit_sfs_resource_pool` and copy them from the snapshot."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: features.AddBetaDescription(description, core.Resource),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`resource_pool_id`,`name`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the snapshot is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: "The resource region. If not defined, the provider region is used.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_pool_id": schema.StringAttribute{
+				Description: "ID of the resource pool to snapshot.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the snapshot. Must be unique within the resource pool.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`),
+						"must start with a letter or digit and may only contain letters, digits, dots, underscores and hyphens",
+					),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "A comment to add more information about the snapshot.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation date of the snapshot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size_gigabytes": schema.Int32Attribute{
+				Description: "Reflects the actual storage footprint in the backend at snapshot time (e.g. how much storage from the Resource Pool does it use)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"logical_size_gigabytes": schema.Int32Attribute{
+				Description: "Represents the user-visible data size at the time of the snapshot (e.g. what’s in the snapshot)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *snapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { //nolint:gocritic // defined by terraform api
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	resourcePoolId := model.ResourcePoolId.ValueString()
+	snapshotName := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "resource_pool_id", resourcePoolId)
+	ctx = tflog.SetField(ctx, "snapshot_name", snapshotName)
+
+	ctx = core.InitProviderContext(ctx)
+
+	payload, err := toCreatePayload(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating snapshot", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	_, err = r.client.DefaultAPI.CreateResourcePoolSnapshot(ctx, projectId, region, resourcePoolId).CreateResourcePoolSnapshotPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating snapshot", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id":       projectId,
+		"region":           region,
+		"resource_pool_id": resourcePoolId,
+		"name":             snapshotName,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.DefaultAPI.GetResourcePoolSnapshot(ctx, projectId, region, resourcePoolId, snapshotName).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating snapshot", fmt.Sprintf("Calling API to get snapshot: %v", err))
+		return
+	}
+
+	err = mapFields(getResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating snapshot", fmt.Sprintf("Processing API response: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SFS snapshot created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *snapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { //nolint:gocritic // defined by terraform api
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	resourcePoolId := model.ResourcePoolId.ValueString()
+	snapshotName := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "resource_pool_id", resourcePoolId)
+	ctx = tflog.SetField(ctx, "snapshot_name", snapshotName)
+
+	ctx = core.InitProviderContext(ctx)
+
+	getResp, err := r.client.DefaultAPI.GetResourcePoolSnapshot(ctx, projectId, region, resourcePoolId, snapshotName).Execute()
+	if err != nil {
+		var openapiError *oapierror.GenericOpenAPIError
+		if errors.As(err, &openapiError) {
+			if openapiError.StatusCode == http.StatusNotFound {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading snapshot", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(getResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading snapshot", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SFS snapshot read")
+}
+
+// Update updates the comment of the snapshot, all other attributes require a replacement.
+func (r *snapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { //nolint:gocritic // defined by terraform api
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	resourcePoolId := model.ResourcePoolId.ValueString()
+	snapshotName := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "resource_pool_id", resourcePoolId)
+	ctx = tflog.SetField(ctx, "snapshot_name", snapshotName)
+
+	ctx = core.InitProviderContext(ctx)
+
+	payload, err := toUpdatePayload(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating snapshot", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	_, err = r.client.DefaultAPI.UpdateResourcePoolSnapshot(ctx, projectId, region, resourcePoolId, snapshotName).UpdateResourcePoolSnapshotPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating snapshot", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	getResp, err := r.client.DefaultAPI.GetResourcePoolSnapshot(ctx, projectId, region, resourcePoolId, snapshotName).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating snapshot", fmt.Sprintf("Calling API to get snapshot: %v", err))
+		return
+	}
+
+	err = mapFields(getResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating snapshot", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SFS snapshot updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *snapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { //nolint:gocritic // defined by terraform api
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	resourcePoolId := model.ResourcePoolId.ValueString()
+	snapshotName := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "resource_pool_id", resourcePoolId)
+	ctx = tflog.SetField(ctx, "snapshot_name", snapshotName)
+
+	ctx = core.InitProviderContext(ctx)
+
+	_, err := r.client.DefaultAPI.DeleteResourcePoolSnapshot(ctx, projectId, region, resourcePoolId, snapshotName).Execute()
+	if err != nil {
+		var openapiError *oapierror.GenericOpenAPIError
+		if errors.As(err, &openapiError) {
+			if openapiError.StatusCode == http.StatusNotFound {
+				return
+			}
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting snapshot", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	tflog.Info(ctx, "SFS snapshot deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the snapshot resource import identifier is: project_id,region,resource_pool_id,name
+func (r *snapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing snapshot",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[resource_pool_id],[name]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id":       idParts[0],
+		"region":           idParts[1],
+		"resource_pool_id": idParts[2],
+		"name":             idParts[3],
+	})
+	tflog.Info(ctx, "SFS snapshot state imported")
+}
+
+func mapFields(resp *sfs.GetResourcePoolSnapshotResponse, model *Model, region string) error {
+	if resp == nil || resp.ResourcePoolSnapshot == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	snapshot := resp.ResourcePoolSnapshot
+
+	var snapshotName string
+	if model.Name.ValueString() != "" {
+		snapshotName = model.Name.ValueString()
+	} else if snapshot.SnapshotName != nil {
+		snapshotName = *snapshot.SnapshotName
+	} else {
+		return fmt.Errorf("snapshot name not present")
+	}
+
+	var resourcePoolId string
+	if model.ResourcePoolId.ValueString() != "" {
+		resourcePoolId = model.ResourcePoolId.ValueString()
+	} else if snapshot.ResourcePoolId != nil {
+		resourcePoolId = *snapshot.ResourcePoolId
+	} else {
+		return fmt.Errorf("resource pool id not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, resourcePoolId, snapshotName)
+	model.Region = types.StringValue(region)
+	model.ResourcePoolId = types.StringValue(resourcePoolId)
+	model.Name = types.StringValue(snapshotName)
+	model.Comment = types.StringPointerValue(snapshot.Comment.Get())
+	model.CreatedAt = types.StringNull()
+	if snapshot.CreatedAt != nil {
+		model.CreatedAt = types.StringValue(snapshot.CreatedAt.Format(time.RFC3339))
+	}
+	model.SizeGigabytes = types.Int32PointerValue(snapshot.SizeGigabytes)
+	model.LogicalSizeGigabytes = types.Int32PointerValue(snapshot.LogicalSizeGigabytes)
+	return nil
+}
+
+func toCreatePayload(model *Model) (*sfs.CreateResourcePoolSnapshotPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	return &sfs.CreateResourcePoolSnapshotPayload{
+		Name:    model.Name.ValueString(),
+		Comment: *sfs.NewNullableString(conversion.StringValueToPointer(model.Comment)),
+	}, nil
+}
+
+func toUpdatePayload(model *Model) (*sfs.UpdateResourcePoolSnapshotPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	// a null comment removes the comment of the snapshot
+	return &sfs.UpdateResourcePoolSnapshotPayload{
+		Comment: *sfs.NewNullableString(conversion.StringValueToPointer(model.Comment)),
+	}, nil
+}
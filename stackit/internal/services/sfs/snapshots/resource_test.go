@@ -0,0 +1,226 @@
+package snapshots
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sfs "github.com/stackitcloud/stackit-sdk-go/services/sfs/v1api"
+)
+
+func TestMapFields(t *testing.T) {
+	testTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	testSnapshotId := types.StringValue(testProjectId.ValueString() + "," + testRegion.ValueString() + "," + testResourcePoolId.ValueString() + ",snapshot-1")
+	tests := []struct {
+		name     string
+		state    *Model
+		input    *sfs.GetResourcePoolSnapshotResponse
+		expected *Model
+		isValid  bool
+	}{
+		{
+			"default_values",
+			&Model{
+				ProjectId:      testProjectId,
+				ResourcePoolId: testResourcePoolId,
+				Name:           types.StringValue("snapshot-1"),
+			},
+			&sfs.GetResourcePoolSnapshotResponse{
+				ResourcePoolSnapshot: &sfs.ResourcePoolSnapshot{},
+			},
+			&Model{
+				Id:                   testSnapshotId,
+				ProjectId:            testProjectId,
+				Region:               testRegion,
+				ResourcePoolId:       testResourcePoolId,
+				Name:                 types.StringValue("snapshot-1"),
+				Comment:              types.StringNull(),
+				CreatedAt:            types.StringNull(),
+				SizeGigabytes:        types.Int32Null(),
+				LogicalSizeGigabytes: types.Int32Null(),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&Model{
+				ProjectId: testProjectId,
+			},
+			&sfs.GetResourcePoolSnapshotResponse{
+				ResourcePoolSnapshot: &sfs.ResourcePoolSnapshot{
+					Comment:              *sfs.NewNullableString(new("comment")),
+					CreatedAt:            new(testTime),
+					ResourcePoolId:       testResourcePoolId.ValueStringPointer(),
+					SnapshotName:         new("snapshot-1"),
+					SizeGigabytes:        new(int32(10)),
+					LogicalSizeGigabytes: new(int32(50)),
+				},
+			},
+			&Model{
+				Id:                   testSnapshotId,
+				ProjectId:            testProjectId,
+				Region:               testRegion,
+				ResourcePoolId:       testResourcePoolId,
+				Name:                 types.StringValue("snapshot-1"),
+				Comment:              types.StringValue("comment"),
+				CreatedAt:            types.StringValue("2026-01-02T03:04:05Z"),
+				SizeGigabytes:        types.Int32Value(10),
+				LogicalSizeGigabytes: types.Int32Value(50),
+			},
+			true,
+		},
+		{
+			"no_name",
+			&Model{
+				ProjectId:      testProjectId,
+				ResourcePoolId: testResourcePoolId,
+			},
+			&sfs.GetResourcePoolSnapshotResponse{
+				ResourcePoolSnapshot: &sfs.ResourcePoolSnapshot{},
+			},
+			nil,
+			false,
+		},
+		{
+			"nil_snapshot",
+			&Model{},
+			&sfs.GetResourcePoolSnapshotResponse{},
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			&Model{},
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state, testRegion.ValueString())
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *Model
+		expected *sfs.CreateResourcePoolSnapshotPayload
+		isValid  bool
+	}{
+		{
+			"default_values",
+			&Model{
+				Name:    types.StringValue("snapshot-1"),
+				Comment: types.StringNull(),
+			},
+			&sfs.CreateResourcePoolSnapshotPayload{
+				Name:    "snapshot-1",
+				Comment: *sfs.NewNullableString(nil),
+			},
+			true,
+		},
+		{
+			"comment",
+			&Model{
+				Name:    types.StringValue("snapshot-1"),
+				Comment: types.StringValue("before upgrade"),
+			},
+			&sfs.CreateResourcePoolSnapshotPayload{
+				Name:    "snapshot-1",
+				Comment: *sfs.NewNullableString(new("before upgrade")),
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := toCreatePayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if !reflect.DeepEqual(output, tt.expected) {
+					t.Fatalf("Data does not match: %v, want %v", output, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *Model
+		expected *sfs.UpdateResourcePoolSnapshotPayload
+		isValid  bool
+	}{
+		{
+			"remove_comment",
+			&Model{
+				Comment: types.StringNull(),
+			},
+			&sfs.UpdateResourcePoolSnapshotPayload{
+				Comment: *sfs.NewNullableString(nil),
+			},
+			true,
+		},
+		{
+			"comment",
+			&Model{
+				Comment: types.StringValue("after upgrade"),
+			},
+			&sfs.UpdateResourcePoolSnapshotPayload{
+				Comment: *sfs.NewNullableString(new("after upgrade")),
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := toUpdatePayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if !reflect.DeepEqual(output, tt.expected) {
+					t.Fatalf("Data does not match: %v, want %v", output, tt.expected)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	sqlserverflexUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+var (
+	_ datasource.DataSource              = new(backups)
+	_ datasource.DataSourceWithConfigure = new(backups)
+)
+
+type model struct {
+	ID         types.String   `tfsdk:"id"`
+	ProjectId  types.String   `tfsdk:"project_id"`
+	Region     types.String   `tfsdk:"region"`
+	InstanceId types.String   `tfsdk:"instance_id"`
+	Backups    []backup       `tfsdk:"backups"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+type backup struct {
+	Id             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Size           types.Int64  `tfsdk:"size"`
+	Type           types.String `tfsdk:"type"`
+	CompletionTime types.String `tfsdk:"completion_time"`
+	RetainedUntil  types.String `tfsdk:"retained_until"`
+}
+
+type backups struct {
+	client       *sqlserverflex.APIClient
+	providerData core.ProviderData
+}
+
+func NewBackupsDataSource() datasource.DataSource {
+	return new(backups)
+}
+
+func (b *backups) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sqlserverflex_backups"
+}
+
+func (b *backups) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	b.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := sqlserverflexUtils.ConfigureClient(ctx, &b.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	b.client = apiClient
+	tflog.Info(ctx, "SQLServer Flex backups client configured")
+}
+
+func (b *backups) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "SQLServer Flex backups data source schema. Lists the backups of an instance, sorted by their completion time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID, structured as \"`project_id`,`region`,`instance_id`\"",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "SQLServer Flex backups data source region. If undefined, the provider region is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "ID of the SQLServer Flex instance.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx),
+			"backups": schema.ListNestedAttribute{
+				Description: "A list of backups of the instance.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of the backup. Can be used as `restore.backup_id` of a `stackit_sqlserverflex_database`.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the backup.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "Size of the backup in bytes.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the backup, e.g. full or differential.",
+							Computed:    true,
+						},
+						"completion_time": schema.StringAttribute{
+							Description: "Time when the backup was completed.",
+							Computed:    true,
+						},
+						"retained_until": schema.StringAttribute{
+							Description: "Time until the backup is retained.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (b *backups) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := model.Timeouts.Read(ctx, core.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	region := b.providerData.GetRegionWithOverride(model.Region)
+	model.Region = types.StringValue(region)
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id":  projectId,
+		"region":      region,
+		"instance_id": instanceId,
+	})
+
+	ctx = core.InitProviderContext(ctx)
+
+	const pageSize = 100
+	backupsResp, err := b.client.DefaultAPI.ListBackups(ctx, projectId, region, instanceId).Size(pageSize).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Reading backups", fmt.Sprintf("Error calling ListBackups: %v", err))
+		return
+	}
+	if backupsResp.Pagination.TotalRows > pageSize {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics,
+			"Truncated results",
+			fmt.Sprintf("Due to API limitations we currently do not support more than %d backups, but %d exist. The result is truncated", pageSize, backupsResp.Pagination.TotalRows),
+		)
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(backupsResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Reading backups", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SQLServer Flex backups read")
+}
+
+func mapFields(resp *sqlserverflex.ListBackupResponse, m *model) error {
+	if resp == nil {
+		return fmt.Errorf("nil response")
+	}
+	if m == nil {
+		return fmt.Errorf("nil model")
+	}
+
+	m.ID = utils.BuildInternalTerraformId(m.ProjectId.ValueString(), m.Region.ValueString(), m.InstanceId.ValueString())
+
+	slices.SortFunc(resp.Backups, func(a, b sqlserverflex.ListBackup) int {
+		return strings.Compare(a.CompletionTime, b.CompletionTime)
+	})
+
+	m.Backups = make([]backup, 0, len(resp.Backups))
+	for _, respBackup := range resp.Backups {
+		m.Backups = append(m.Backups, backup{
+			Id:             types.Int64Value(respBackup.Id),
+			Name:           types.StringValue(respBackup.Name),
+			Size:           types.Int64Value(respBackup.Size),
+			Type:           types.StringValue(respBackup.Type),
+			CompletionTime: types.StringValue(respBackup.CompletionTime),
+			RetainedUntil:  types.StringValue(respBackup.RetainedUntil),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package backups
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *sqlserverflex.ListBackupResponse
+		state       *model
+		expected    *model
+		isValid     bool
+	}{
+		{
+			description: "default_values_and_sorting",
+			input: &sqlserverflex.ListBackupResponse{
+				Backups: []sqlserverflex.ListBackup{
+					{
+						Id:             2,
+						Name:           "backup2",
+						Size:           2048,
+						Type:           "differential",
+						CompletionTime: "2026-01-02T00:00:00Z",
+						RetainedUntil:  "2026-01-30T00:00:00Z",
+					},
+					{
+						Id:             1,
+						Name:           "backup1",
+						Size:           1024,
+						Type:           "full",
+						CompletionTime: "2026-01-01T00:00:00Z",
+						RetainedUntil:  "2026-01-29T00:00:00Z",
+					},
+				},
+			},
+			state: &model{
+				ProjectId:  types.StringValue("project_id"),
+				Region:     types.StringValue("region"),
+				InstanceId: types.StringValue("instance_id"),
+			},
+			expected: &model{
+				ID:         types.StringValue("project_id,region,instance_id"),
+				ProjectId:  types.StringValue("project_id"),
+				Region:     types.StringValue("region"),
+				InstanceId: types.StringValue("instance_id"),
+				Backups: []backup{
+					{
+						Id:             types.Int64Value(1),
+						Name:           types.StringValue("backup1"),
+						Size:           types.Int64Value(1024),
+						Type:           types.StringValue("full"),
+						CompletionTime: types.StringValue("2026-01-01T00:00:00Z"),
+						RetainedUntil:  types.StringValue("2026-01-29T00:00:00Z"),
+					},
+					{
+						Id:             types.Int64Value(2),
+						Name:           types.StringValue("backup2"),
+						Size:           types.Int64Value(2048),
+						Type:           types.StringValue("differential"),
+						CompletionTime: types.StringValue("2026-01-02T00:00:00Z"),
+						RetainedUntil:  types.StringValue("2026-01-30T00:00:00Z"),
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "empty_response",
+			input:       &sqlserverflex.ListBackupResponse{},
+			state: &model{
+				ProjectId:  types.StringValue("project_id"),
+				Region:     types.StringValue("region"),
+				InstanceId: types.StringValue("instance_id"),
+			},
+			expected: &model{
+				ID:         types.StringValue("project_id,region,instance_id"),
+				ProjectId:  types.StringValue("project_id"),
+				Region:     types.StringValue("region"),
+				InstanceId: types.StringValue("instance_id"),
+				Backups:    []backup{},
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			state:       &model{},
+			expected:    &model{},
+			isValid:     false,
+		},
+		{
+			description: "nil_model",
+			input:       &sqlserverflex.ListBackupResponse{},
+			state:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.expected, tt.state)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
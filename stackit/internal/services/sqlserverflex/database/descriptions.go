@@ -12,4 +12,11 @@ const (
 	descriptionCompatibility = "Compatibility level of the database."
 	descriptionOwner         = "The owner of the database."
 	descriptionDatabaseId    = "The id of the database."
+
+	descriptionRestore                   = "Seeds the database from a backup instead of creating an empty database. Exactly one of `backup_id`, `restore_time` and `backup_url` must be set. The restore only happens on creation, changing this attribute recreates the database."
+	descriptionRestoreBackupId           = "ID of an instance backup to restore. See the `stackit_sqlserverflex_backups` data source."
+	descriptionRestoreRestoreTime        = "Point in time to restore the source database at, in RFC3339 format."
+	descriptionRestoreSourceInstanceId   = "ID of the instance the backup belongs to. Defaults to `instance_id`. Only used together with `backup_id` or `restore_time`."
+	descriptionRestoreSourceDatabaseName = "Name of the database in the backup. Defaults to `name`. Only used together with `backup_id` or `restore_time`."
+	descriptionRestoreBackupUrl          = "HTTPS URL of a SQL Server backup file (.bak) in an object storage bucket, e.g. a pre-signed URL. Used to migrate databases from outside of STACKIT."
 )
@@ -5,20 +5,28 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	sdk "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+	"github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api/wait"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
@@ -45,9 +53,19 @@ type databaseResource struct {
 
 type Model struct {
 	SharedModel
+	Restore  types.Object   `tfsdk:"restore"`
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
+// Struct corresponding to Model.Restore
+type restoreModel struct {
+	BackupId           types.Int64  `tfsdk:"backup_id"`
+	RestoreTime        types.String `tfsdk:"restore_time"`
+	SourceInstanceId   types.String `tfsdk:"source_instance_id"`
+	SourceDatabaseName types.String `tfsdk:"source_database_name"`
+	BackupUrl          types.String `tfsdk:"backup_url"`
+}
+
 func (r *databaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_sqlserverflex_database"
 }
@@ -149,6 +167,9 @@ func (r *databaseResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("restore")),
+				},
 			},
 			"compatibility": schema.Int64Attribute{
 				Description: descriptionCompatibility,
@@ -158,6 +179,9 @@ func (r *databaseResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 					int64planmodifier.RequiresReplace(),
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("restore")),
+				},
 			},
 			"owner": schema.StringAttribute{
 				Description: descriptionOwner,
@@ -170,6 +194,60 @@ func (r *databaseResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				Description: descriptionDatabaseId,
 				Computed:    true,
 			},
+			"restore": schema.SingleNestedAttribute{
+				Description: descriptionRestore,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"backup_id": schema.Int64Attribute{
+						Description: descriptionRestoreBackupId,
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("restore_time"),
+								path.MatchRelative().AtParent().AtName("backup_url"),
+							),
+						},
+					},
+					"restore_time": schema.StringAttribute{
+						Description: descriptionRestoreRestoreTime,
+						Optional:    true,
+						Validators: []validator.String{
+							validate.RFC3339SecondsOnly(),
+						},
+					},
+					"source_instance_id": schema.StringAttribute{
+						Description: descriptionRestoreSourceInstanceId,
+						Optional:    true,
+						Validators: []validator.String{
+							validate.UUID(),
+							validate.NoSeparator(),
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("backup_url")),
+						},
+					},
+					"source_database_name": schema.StringAttribute{
+						Description: descriptionRestoreSourceDatabaseName,
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("backup_url")),
+						},
+					},
+					"backup_url": schema.StringAttribute{
+						Description: descriptionRestoreBackupUrl,
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^https://[^?]+\.bak(\?.*)?$`),
+								"must be an HTTPS URL of a .bak file",
+							),
+						},
+					},
+				},
+			},
 			"timeouts": timeouts.AttributesAll(ctx),
 		},
 	}
@@ -194,23 +272,35 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 	instanceId := model.InstanceId.ValueString()
 	region := model.Region.ValueString()
 
-	payload, err := toCreatePayload(&model)
-	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating database", fmt.Sprintf("Creating API payload: %v", err))
-		return
-	}
-
 	ctx = core.InitProviderContext(ctx)
 
-	// Workaround: The database creation will be tried 5 times. In some cases the instance might be
-	// in maintenance mode and the database API is temporarily unavailable. Usually this is only for 1-2 seconds.
-	_, err = utils.RetryRequest(ctx, r.client.DefaultAPI.CreateDatabase(ctx, projectId, region, instanceId).CreateDatabasePayload(*payload).Execute, sqlserverflexUtils.RetryConfig)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating database", err.Error())
-		return
-	}
+	if model.Restore.IsNull() || model.Restore.IsUnknown() {
+		payload, err := toCreatePayload(&model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating database", fmt.Sprintf("Creating API payload: %v", err))
+			return
+		}
 
-	ctx = core.LogResponse(ctx)
+		// Workaround: The database creation will be tried 5 times. In some cases the instance might be
+		// in maintenance mode and the database API is temporarily unavailable. Usually this is only for 1-2 seconds.
+		_, err = utils.RetryRequest(ctx, r.client.DefaultAPI.CreateDatabase(ctx, projectId, region, instanceId).CreateDatabasePayload(*payload).Execute, sqlserverflexUtils.RetryConfig)
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating database", err.Error())
+			return
+		}
+
+		ctx = core.LogResponse(ctx)
+	} else {
+		var restore restoreModel
+		resp.Diagnostics.Append(model.Restore.As(ctx, &restore, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ctx = r.restoreDatabase(ctx, &model, &restore, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
 		"project_id":  projectId,
@@ -243,6 +333,48 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 	tflog.Info(ctx, "SqlserverFlex database created")
 }
 
+// restoreDatabase seeds the database either from an instance backup or from a backup file in an object storage
+// and waits until the restore is finished.
+func (r *databaseResource) restoreDatabase(ctx context.Context, model *Model, restore *restoreModel, diags *diag.Diagnostics) context.Context {
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	region := model.Region.ValueString()
+	name := model.Name.ValueString()
+
+	var err error
+	if restore.BackupUrl.IsNull() {
+		var payload *sdk.RestoreDatabasePayload
+		payload, err = toRestorePayload(model, restore)
+		if err != nil {
+			core.LogAndAddError(ctx, diags, "Error restoring database", fmt.Sprintf("Creating API payload: %v", err))
+			return ctx
+		}
+		err = utils.RetryRequestWithoutResponse(ctx, r.client.DefaultAPI.RestoreDatabase(ctx, projectId, region, instanceId).RestoreDatabasePayload(*payload).Execute, sqlserverflexUtils.RetryConfig)
+	} else {
+		var payload *sdk.ImportDatabasePayload
+		payload, err = toImportPayload(model, restore)
+		if err != nil {
+			core.LogAndAddError(ctx, diags, "Error restoring database", fmt.Sprintf("Creating API payload: %v", err))
+			return ctx
+		}
+		err = utils.RetryRequestWithoutResponse(ctx, r.client.DefaultAPI.ImportDatabase(ctx, projectId, region, instanceId).ImportDatabasePayload(*payload).Execute, sqlserverflexUtils.RetryConfig)
+	}
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error restoring database", fmt.Sprintf("Calling API: %v", err))
+		return ctx
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	_, err = wait.RestoreDatabaseWaitHandler(ctx, r.client.DefaultAPI, projectId, region, instanceId, name).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error restoring database", fmt.Sprintf("Database restore waiting: %v", err))
+		return ctx
+	}
+	tflog.Info(ctx, "SqlserverFlex database restored")
+	return ctx
+}
+
 func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
 	var model Model
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
@@ -396,3 +528,51 @@ func toCreatePayload(model *Model) (*sdk.CreateDatabasePayload, error) {
 	}
 	return payload, nil
 }
+
+func toRestorePayload(model *Model, restore *restoreModel) (*sdk.RestoreDatabasePayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	if restore == nil {
+		return nil, fmt.Errorf("nil restore model")
+	}
+	if restore.BackupId.IsNull() && restore.RestoreTime.IsNull() {
+		return nil, fmt.Errorf("either backup_id or restore_time must be set")
+	}
+
+	sourceInstanceId := restore.SourceInstanceId.ValueString()
+	if sourceInstanceId == "" {
+		sourceInstanceId = model.InstanceId.ValueString()
+	}
+	sourceDatabaseName := restore.SourceDatabaseName.ValueString()
+	if sourceDatabaseName == "" {
+		sourceDatabaseName = model.Name.ValueString()
+	}
+
+	return &sdk.RestoreDatabasePayload{
+		Name:               model.Name.ValueString(),
+		Owner:              model.Owner.ValueString(),
+		SourceInstanceId:   sourceInstanceId,
+		SourceDatabaseName: sourceDatabaseName,
+		BackupId:           conversion.Int64ValueToPointer(restore.BackupId),
+		RestoreTime:        conversion.StringValueToPointer(restore.RestoreTime),
+	}, nil
+}
+
+func toImportPayload(model *Model, restore *restoreModel) (*sdk.ImportDatabasePayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	if restore == nil {
+		return nil, fmt.Errorf("nil restore model")
+	}
+	if restore.BackupUrl.ValueString() == "" {
+		return nil, fmt.Errorf("backup_url must be set")
+	}
+
+	return &sdk.ImportDatabasePayload{
+		Name:      model.Name.ValueString(),
+		Owner:     model.Owner.ValueString(),
+		BackupUrl: restore.BackupUrl.ValueString(),
+	}, nil
+}
@@ -138,3 +138,167 @@ func TestMapFields(t *testing.T) {
 		})
 	}
 }
+
+func TestToRestorePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		model       *Model
+		restore     *restoreModel
+		expected    *sdk.RestoreDatabasePayload
+		isValid     bool
+	}{
+		{
+			description: "backup_id_defaults",
+			model: &Model{
+				SharedModel: SharedModel{
+					InstanceId: types.StringValue("iid"),
+					Name:       types.StringValue("db-name"),
+					Owner:      types.StringValue("db-owner"),
+				},
+			},
+			restore: &restoreModel{
+				BackupId:           types.Int64Value(42),
+				RestoreTime:        types.StringNull(),
+				SourceInstanceId:   types.StringNull(),
+				SourceDatabaseName: types.StringNull(),
+				BackupUrl:          types.StringNull(),
+			},
+			expected: &sdk.RestoreDatabasePayload{
+				Name:               "db-name",
+				Owner:              "db-owner",
+				SourceInstanceId:   "iid",
+				SourceDatabaseName: "db-name",
+				BackupId:           utils.Ptr(int64(42)),
+			},
+			isValid: true,
+		},
+		{
+			description: "restore_time_from_other_instance",
+			model: &Model{
+				SharedModel: SharedModel{
+					InstanceId: types.StringValue("iid"),
+					Name:       types.StringValue("db-name"),
+					Owner:      types.StringValue("db-owner"),
+				},
+			},
+			restore: &restoreModel{
+				BackupId:           types.Int64Null(),
+				RestoreTime:        types.StringValue("2026-01-02T03:04:05Z"),
+				SourceInstanceId:   types.StringValue("sid"),
+				SourceDatabaseName: types.StringValue("source-db"),
+				BackupUrl:          types.StringNull(),
+			},
+			expected: &sdk.RestoreDatabasePayload{
+				Name:               "db-name",
+				Owner:              "db-owner",
+				SourceInstanceId:   "sid",
+				SourceDatabaseName: "source-db",
+				RestoreTime:        utils.Ptr("2026-01-02T03:04:05Z"),
+			},
+			isValid: true,
+		},
+		{
+			description: "no_source",
+			model:       &Model{},
+			restore: &restoreModel{
+				BackupId:    types.Int64Null(),
+				RestoreTime: types.StringNull(),
+			},
+			expected: nil,
+			isValid:  false,
+		},
+		{
+			description: "nil_restore",
+			model:       &Model{},
+			restore:     nil,
+			expected:    nil,
+			isValid:     false,
+		},
+		{
+			description: "nil_model",
+			model:       nil,
+			restore:     &restoreModel{},
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toRestorePayload(tt.model, tt.restore)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToImportPayload(t *testing.T) {
+	tests := []struct {
+		description string
+		model       *Model
+		restore     *restoreModel
+		expected    *sdk.ImportDatabasePayload
+		isValid     bool
+	}{
+		{
+			description: "default_ok",
+			model: &Model{
+				SharedModel: SharedModel{
+					Name:  types.StringValue("db-name"),
+					Owner: types.StringValue("db-owner"),
+				},
+			},
+			restore: &restoreModel{
+				BackupUrl: types.StringValue("https://bucket.object.storage.eu01.onstackit.cloud/db.bak"),
+			},
+			expected: &sdk.ImportDatabasePayload{
+				Name:      "db-name",
+				Owner:     "db-owner",
+				BackupUrl: "https://bucket.object.storage.eu01.onstackit.cloud/db.bak",
+			},
+			isValid: true,
+		},
+		{
+			description: "no_backup_url",
+			model:       &Model{},
+			restore: &restoreModel{
+				BackupUrl: types.StringNull(),
+			},
+			expected: nil,
+			isValid:  false,
+		},
+		{
+			description: "nil_model",
+			model:       nil,
+			restore:     &restoreModel{},
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toImportPayload(tt.model, tt.restore)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
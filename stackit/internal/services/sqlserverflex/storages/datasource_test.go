@@ -0,0 +1,96 @@
+package storages
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *sqlserverflex.ListStoragesResponse
+		state       *model
+		expected    *model
+		isValid     bool
+	}{
+		{
+			description: "default_values_and_sorting",
+			input: &sqlserverflex.ListStoragesResponse{
+				StorageClasses: []string{"premium-perf2-stackit", "premium-perf12-stackit"},
+				StorageRange: sqlserverflex.StorageRange{
+					Min: 5,
+					Max: 4000,
+				},
+			},
+			state: &model{
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				FlavorId:  types.StringValue("fid"),
+			},
+			expected: &model{
+				ID:             types.StringValue("pid,eu01,fid"),
+				ProjectId:      types.StringValue("pid"),
+				Region:         types.StringValue("eu01"),
+				FlavorId:       types.StringValue("fid"),
+				StorageClasses: []string{"premium-perf12-stackit", "premium-perf2-stackit"},
+				MinSize:        types.Int64Value(5),
+				MaxSize:        types.Int64Value(4000),
+			},
+			isValid: true,
+		},
+		{
+			description: "empty_response",
+			input:       &sqlserverflex.ListStoragesResponse{},
+			state: &model{
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				FlavorId:  types.StringValue("fid"),
+			},
+			expected: &model{
+				ID:             types.StringValue("pid,eu01,fid"),
+				ProjectId:      types.StringValue("pid"),
+				Region:         types.StringValue("eu01"),
+				FlavorId:       types.StringValue("fid"),
+				StorageClasses: []string{},
+				MinSize:        types.Int64Value(0),
+				MaxSize:        types.Int64Value(0),
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			state:       &model{},
+			expected:    &model{},
+			isValid:     false,
+		},
+		{
+			description: "nil_model",
+			input:       &sqlserverflex.ListStoragesResponse{},
+			state:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.expected, tt.state)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package versions
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *sqlserverflex.ListVersionResponse
+		state       *model
+		expected    *model
+		isValid     bool
+	}{
+		{
+			description: "default_values_and_sorting",
+			input: &sqlserverflex.ListVersionResponse{
+				Versions: []sqlserverflex.Version{
+					{Id: "2025", Recommend: true},
+					{Id: "2019", Deprecated: "2026-11-13"},
+					{Id: "2022", Beta: true},
+				},
+			},
+			state: &model{
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+			},
+			expected: &model{
+				ID:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				Versions: []version{
+					{
+						Version:     types.StringValue("2019"),
+						Beta:        types.BoolValue(false),
+						Deprecated:  types.StringValue("2026-11-13"),
+						Recommended: types.BoolValue(false),
+					},
+					{
+						Version:     types.StringValue("2022"),
+						Beta:        types.BoolValue(true),
+						Deprecated:  types.StringValue(""),
+						Recommended: types.BoolValue(false),
+					},
+					{
+						Version:     types.StringValue("2025"),
+						Beta:        types.BoolValue(false),
+						Deprecated:  types.StringValue(""),
+						Recommended: types.BoolValue(true),
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "empty_response",
+			input:       &sqlserverflex.ListVersionResponse{},
+			state: &model{
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+			},
+			expected: &model{
+				ID:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				Versions:  []version{},
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			state:       &model{},
+			expected:    &model{},
+			isValid:     false,
+		},
+		{
+			description: "nil_model",
+			input:       &sqlserverflex.ListVersionResponse{},
+			state:       nil,
+			expected:    nil,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.expected, tt.state)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
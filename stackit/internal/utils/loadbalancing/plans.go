@@ -0,0 +1,230 @@
+package loadbalancing
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &PlansDataSource[struct{}]{}
+	_ datasource.DataSourceWithConfigure = &PlansDataSource[struct{}]{}
+)
+
+type PlansModel struct {
+	Id       types.String   `tfsdk:"id"` // needed by TF
+	Region   types.String   `tfsdk:"region"`
+	Plans    []plan         `tfsdk:"plans"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type plan struct {
+	PlanId         types.String `tfsdk:"plan_id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	FlavorName     types.String `tfsdk:"flavor_name"`
+	MaxConnections types.Int32  `tfsdk:"max_connections"`
+}
+
+// Plan is a service plan, independent of the SDK of the load balancer API.
+type Plan struct {
+	PlanId         *string
+	Name           *string
+	Description    *string
+	FlavorName     *string
+	MaxConnections *int32
+}
+
+// GetPlanId returns the plan ID, or an empty string if it isn't set.
+func (p *Plan) GetPlanId() string {
+	if p.PlanId == nil {
+		return ""
+	}
+	return *p.PlanId
+}
+
+// GetMaxConnections returns the maximum number of connections, or 0 if it isn't set.
+func (p *Plan) GetMaxConnections() int32 {
+	if p.MaxConnections == nil {
+		return 0
+	}
+	return *p.MaxConnections
+}
+
+// PlansDataSource lists the service plans of a load balancer product. It is shared by the load balancer products,
+// which only differ in their API client C.
+type PlansDataSource[C any] struct {
+	// Product describes the load balancer product
+	Product          Product
+	ApiClientFactory func(ctx context.Context, providerData *core.ProviderData, diags *diag.Diagnostics) *C
+	ExecListPlans    func(ctx context.Context, client *C, region string) ([]Plan, error)
+
+	client       *C
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (d *PlansDataSource[C]) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_plans", req.ProviderTypeName, d.Product.TypeName)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PlansDataSource[C]) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := d.ApiClientFactory(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, fmt.Sprintf("%s client configured", d.Product.title()))
+}
+
+// Schema defines the schema for the data source.
+func (d *PlansDataSource[C]) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":            fmt.Sprintf("%s plans data source schema. Lists the service plans, which can be used as `plan_id` of a `stackit_%s`.", d.Product.title(), d.Product.TypeName),
+		"id":              "Terraform's internal data source ID. It is structured as \"`region`\".",
+		"region":          "The resource region. If not defined, the provider region is used.",
+		"plans":           "List of the available service plans, ordered by their maximum number of connections.",
+		"plan_id":         "Service plan ID.",
+		"name":            "Service plan name.",
+		"description":     "Service plan description.",
+		"flavor_name":     fmt.Sprintf("Flavor of the %s VM instances.", d.Product.Name),
+		"max_connections": fmt.Sprintf("Maximum number of concurrent connections per %s VM instance.", d.Product.Name),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions["region"],
+			},
+			"plans": schema.ListNestedAttribute{
+				Description: descriptions["plans"],
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"plan_id": schema.StringAttribute{
+							Description: descriptions["plan_id"],
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: descriptions["name"],
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: descriptions["description"],
+							Computed:    true,
+						},
+						"flavor_name": schema.StringAttribute{
+							Description: descriptions["flavor_name"],
+							Computed:    true,
+						},
+						"max_connections": schema.Int32Attribute{
+							Description: descriptions["max_connections"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *PlansDataSource[C]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model PlansModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := model.Timeouts.Read(ctx, core.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	ctx = core.InitProviderContext(ctx)
+
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	plans, err := d.ExecListPlans(ctx, d.client, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error reading %s plans", d.Product.Name), fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapPlansFields(plans, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error reading %s plans", d.Product.Name), fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("%s plans read", d.Product.title()))
+}
+
+func mapPlansFields(plans []Plan, model *PlansModel, region string) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(region)
+	model.Region = types.StringValue(region)
+
+	validPlans := slices.Clone(plans)
+	slices.SortStableFunc(validPlans, func(a, b Plan) int {
+		return cmp.Or(
+			cmp.Compare(a.GetMaxConnections(), b.GetMaxConnections()),
+			cmp.Compare(a.GetPlanId(), b.GetPlanId()),
+		)
+	})
+
+	model.Plans = make([]plan, 0, len(validPlans))
+	for i := range validPlans {
+		model.Plans = append(model.Plans, plan{
+			PlanId:         types.StringPointerValue(validPlans[i].PlanId),
+			Name:           types.StringPointerValue(validPlans[i].Name),
+			Description:    types.StringPointerValue(validPlans[i].Description),
+			FlavorName:     types.StringPointerValue(validPlans[i].FlavorName),
+			MaxConnections: types.Int32PointerValue(validPlans[i].MaxConnections),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package loadbalancing
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMapPlansFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []Plan
+		expected    *PlansModel
+	}{
+		{
+			"default_values",
+			nil,
+			&PlansModel{
+				Id:     types.StringValue("eu01"),
+				Region: types.StringValue("eu01"),
+				Plans:  []plan{},
+			},
+		},
+		{
+			"sorted_by_size",
+			[]Plan{
+				{
+					PlanId:         new("p250"),
+					Name:           new("Network-Loadbalancer-Medium"),
+					Description:    new("medium"),
+					FlavorName:     new("c1.4"),
+					MaxConnections: new(int32(250000)),
+				},
+				{
+					PlanId:         new("p10"),
+					Name:           new("Network-Loadbalancer-Starter"),
+					Description:    new("starter"),
+					FlavorName:     new("c1.2"),
+					MaxConnections: new(int32(10000)),
+				},
+				{
+					PlanId: new("p50"),
+				},
+			},
+			&PlansModel{
+				Id:     types.StringValue("eu01"),
+				Region: types.StringValue("eu01"),
+				Plans: []plan{
+					{
+						PlanId:         types.StringValue("p50"),
+						Name:           types.StringNull(),
+						Description:    types.StringNull(),
+						FlavorName:     types.StringNull(),
+						MaxConnections: types.Int32Null(),
+					},
+					{
+						PlanId:         types.StringValue("p10"),
+						Name:           types.StringValue("Network-Loadbalancer-Starter"),
+						Description:    types.StringValue("starter"),
+						FlavorName:     types.StringValue("c1.2"),
+						MaxConnections: types.Int32Value(10000),
+					},
+					{
+						PlanId:         types.StringValue("p250"),
+						Name:           types.StringValue("Network-Loadbalancer-Medium"),
+						Description:    types.StringValue("medium"),
+						FlavorName:     types.StringValue("c1.4"),
+						MaxConnections: types.Int32Value(250000),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &PlansModel{}
+			err := mapPlansFields(tt.input, model, "eu01")
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(model, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package loadbalancing
+
+import "strings"
+
+// Product describes a load balancer product, whose data sources share their implementation.
+type Product struct {
+	// Name is used in descriptions and messages, e.g. "load balancer"
+	Name string
+	// TypeName is the type name of the load balancer resource without the provider prefix, e.g. "loadbalancer"
+	TypeName string
+}
+
+// title returns the name with a capitalized first letter, e.g. "Load balancer".
+func (p Product) title() string {
+	if p.Name == "" {
+		return ""
+	}
+	return strings.ToUpper(p.Name[:1]) + p.Name[1:]
+}
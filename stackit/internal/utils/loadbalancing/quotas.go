@@ -0,0 +1,194 @@
+package loadbalancing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &QuotasDataSource[struct{}]{}
+	_ datasource.DataSourceWithConfigure = &QuotasDataSource[struct{}]{}
+)
+
+type QuotasModel struct {
+	Id                types.String   `tfsdk:"id"` // needed by TF
+	ProjectId         types.String   `tfsdk:"project_id"`
+	Region            types.String   `tfsdk:"region"`
+	MaxLoadBalancers  types.Int32    `tfsdk:"max_load_balancers"`
+	UsedLoadBalancers types.Int32    `tfsdk:"used_load_balancers"`
+	MaxCredentials    types.Int32    `tfsdk:"max_credentials"`
+	UsedCredentials   types.Int32    `tfsdk:"used_credentials"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Quota contains the quotas of a project and their usage, independent of the SDK of the load balancer API.
+type Quota struct {
+	MaxLoadBalancers  *int32
+	UsedLoadBalancers *int32
+	MaxCredentials    *int32
+	UsedCredentials   *int32
+}
+
+// QuotasDataSource shows the quotas of a load balancer product. It is shared by the load balancer products,
+// which only differ in their API client C.
+type QuotasDataSource[C any] struct {
+	// Product describes the load balancer product
+	Product          Product
+	ApiClientFactory func(ctx context.Context, providerData *core.ProviderData, diags *diag.Diagnostics) *C
+	ExecGetQuota     func(ctx context.Context, client *C, projectId, region string) (*Quota, error)
+
+	client       *C
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (d *QuotasDataSource[C]) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_quotas", req.ProviderTypeName, d.Product.TypeName)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *QuotasDataSource[C]) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := d.ApiClientFactory(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, fmt.Sprintf("%s client configured", d.Product.title()))
+}
+
+// Schema defines the schema for the data source.
+func (d *QuotasDataSource[C]) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":                fmt.Sprintf("%s quotas data source schema. Shows the quotas of a project and their current usage.", d.Product.title()),
+		"id":                  "Terraform's internal data source ID. It is structured as \"`project_id`\",\"`region`\".",
+		"project_id":          "STACKIT project ID.",
+		"region":              "The resource region. If not defined, the provider region is used.",
+		"max_load_balancers":  fmt.Sprintf("The maximum number of %ss in this project.", d.Product.Name),
+		"used_load_balancers": fmt.Sprintf("The number of %ss that currently exist in this project.", d.Product.Name),
+		"max_credentials":     "The maximum number of observability credentials that can be stored in this project.",
+		"used_credentials":    "The number of observability credentials that currently exist in this project.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions["region"],
+			},
+			"max_load_balancers": schema.Int32Attribute{
+				Description: descriptions["max_load_balancers"],
+				Computed:    true,
+			},
+			"used_load_balancers": schema.Int32Attribute{
+				Description: descriptions["used_load_balancers"],
+				Computed:    true,
+			},
+			"max_credentials": schema.Int32Attribute{
+				Description: descriptions["max_credentials"],
+				Computed:    true,
+			},
+			"used_credentials": schema.Int32Attribute{
+				Description: descriptions["used_credentials"],
+				Computed:    true,
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *QuotasDataSource[C]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model QuotasModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := model.Timeouts.Read(ctx, core.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	quota, err := d.ExecGetQuota(ctx, d.client, projectId, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error reading %s quotas", d.Product.Name), fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapQuotasFields(quota, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error reading %s quotas", d.Product.Name), fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("%s quotas read", d.Product.title()))
+}
+
+func mapQuotasFields(quota *Quota, model *QuotasModel, region string) error {
+	if quota == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region)
+	model.Region = types.StringValue(region)
+	model.MaxLoadBalancers = types.Int32PointerValue(quota.MaxLoadBalancers)
+	model.UsedLoadBalancers = types.Int32PointerValue(quota.UsedLoadBalancers)
+	model.MaxCredentials = types.Int32PointerValue(quota.MaxCredentials)
+	model.UsedCredentials = types.Int32PointerValue(quota.UsedCredentials)
+	return nil
+}
@@ -0,0 +1,77 @@
+package loadbalancing
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMapQuotasFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Quota
+		expected    *QuotasModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&Quota{},
+			&QuotasModel{
+				Id:                types.StringValue("pid,eu01"),
+				ProjectId:         types.StringValue("pid"),
+				Region:            types.StringValue("eu01"),
+				MaxLoadBalancers:  types.Int32Null(),
+				UsedLoadBalancers: types.Int32Null(),
+				MaxCredentials:    types.Int32Null(),
+				UsedCredentials:   types.Int32Null(),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&Quota{
+				MaxLoadBalancers:  new(int32(10)),
+				UsedLoadBalancers: new(int32(3)),
+				MaxCredentials:    new(int32(20)),
+				UsedCredentials:   new(int32(1)),
+			},
+			&QuotasModel{
+				Id:                types.StringValue("pid,eu01"),
+				ProjectId:         types.StringValue("pid"),
+				Region:            types.StringValue("eu01"),
+				MaxLoadBalancers:  types.Int32Value(10),
+				UsedLoadBalancers: types.Int32Value(3),
+				MaxCredentials:    types.Int32Value(20),
+				UsedCredentials:   types.Int32Value(1),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			&QuotasModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &QuotasModel{
+				ProjectId: types.StringValue("pid"),
+			}
+			err := mapQuotasFields(tt.input, model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
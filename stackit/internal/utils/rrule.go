@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/teambition/rrule-go"
+)
+
+// ParseRrule parses a recurrence rule as used by the STACKIT APIs (e.g. "DTSTART;TZID=Europe/Berlin:20200803T023000 RRULE:FREQ=DAILY;INTERVAL=1").
+// The APIs separate DTSTART and RRULE with a space, whereas the rrule-go library expects a newline.
+func ParseRrule(value string) (*rrule.Set, error) {
+	return rrule.StrToRRuleSet(strings.ReplaceAll(value, " ", "\n"))
+}
+
+// RruleNextOccurrences returns up to count occurrences of the recurrence rule after the given time, in UTC.
+func RruleNextOccurrences(value string, after time.Time, count int) ([]time.Time, error) {
+	set, err := ParseRrule(value)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := []time.Time{}
+	next := after
+	for len(occurrences) < count {
+		next = set.After(next, false)
+		if next.IsZero() {
+			break
+		}
+		occurrences = append(occurrences, next.UTC())
+	}
+	return occurrences, nil
+}
+
+// RruleNextRuns returns up to count occurrences of the recurrence rule after now as a list of RFC 3339 timestamps in UTC.
+// The list is null if the recurrence rule can't be parsed.
+func RruleNextRuns(value string, now time.Time, count int) types.List {
+	occurrences, err := RruleNextOccurrences(value, now, count)
+	if err != nil {
+		return types.ListNull(types.StringType)
+	}
+	nextRuns := make([]attr.Value, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		nextRuns = append(nextRuns, types.StringValue(occurrence.Format(time.RFC3339)))
+	}
+	return types.ListValueMust(types.StringType, nextRuns)
+}
+
+// RruleOverlap returns the first occurrence of the first recurrence rule between from and until that overlaps with an occurrence of the second one.
+// Each occurrence lasts for the given duration, an occurrence with a zero duration is a single point in time.
+func RruleOverlap(first string, firstDuration time.Duration, second string, secondDuration time.Duration, from, until time.Time) (overlap time.Time, found bool, err error) {
+	firstSet, err := ParseRrule(first)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	secondSet, err := ParseRrule(second)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	// occurrences of the second rule which started before from may still last into the checked period
+	secondOccurrences := secondSet.Between(from.Add(-secondDuration), until, true)
+	for _, firstStart := range firstSet.Between(from, until, true) {
+		firstEnd := occurrenceEnd(firstStart, firstDuration)
+		for _, secondStart := range secondOccurrences {
+			if firstStart.Before(occurrenceEnd(secondStart, secondDuration)) && secondStart.Before(firstEnd) {
+				return firstStart.UTC(), true, nil
+			}
+		}
+	}
+	return time.Time{}, false, nil
+}
+
+// occurrenceEnd returns the exclusive end of an occurrence. Occurrences with a zero duration still cover their start time.
+func occurrenceEnd(start time.Time, duration time.Duration) time.Time {
+	if duration <= 0 {
+		return start.Add(time.Nanosecond)
+	}
+	return start.Add(duration)
+}
@@ -0,0 +1,234 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRruleNextOccurrences(t *testing.T) {
+	after := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		rrule       string
+		count       int
+		expected    []time.Time
+		isValid     bool
+	}{
+		{
+			"daily",
+			"DTSTART;TZID=UTC:20260101T023000 RRULE:FREQ=DAILY;INTERVAL=1",
+			3,
+			[]time.Time{
+				time.Date(2026, 1, 11, 2, 30, 0, 0, time.UTC),
+				time.Date(2026, 1, 12, 2, 30, 0, 0, time.UTC),
+				time.Date(2026, 1, 13, 2, 30, 0, 0, time.UTC),
+			},
+			true,
+		},
+		{
+			"time zone is converted to UTC",
+			"DTSTART;TZID=Europe/Berlin:20260101T023000 RRULE:FREQ=DAILY;INTERVAL=1",
+			1,
+			[]time.Time{
+				time.Date(2026, 1, 11, 1, 30, 0, 0, time.UTC),
+			},
+			true,
+		},
+		{
+			"newline separator",
+			"DTSTART;TZID=UTC:20260101T023000\nRRULE:FREQ=WEEKLY;BYDAY=MO",
+			2,
+			[]time.Time{
+				time.Date(2026, 1, 12, 2, 30, 0, 0, time.UTC),
+				time.Date(2026, 1, 19, 2, 30, 0, 0, time.UTC),
+			},
+			true,
+		},
+		{
+			"rule ends before count is reached",
+			"DTSTART;TZID=UTC:20260109T000000 RRULE:FREQ=DAILY;COUNT=3",
+			5,
+			[]time.Time{
+				time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC),
+			},
+			true,
+		},
+		{
+			"rule already ended",
+			"DTSTART;TZID=UTC:20250101T000000 RRULE:FREQ=DAILY;COUNT=3",
+			5,
+			[]time.Time{},
+			true,
+		},
+		{
+			"invalid rule",
+			"FREQ=SOMETIMES",
+			5,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := RruleNextOccurrences(tt.rrule, after, tt.count)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRruleNextRuns(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		rrule       string
+		expected    types.List
+	}{
+		{
+			"daily",
+			"DTSTART;TZID=Europe/Berlin:20260101T023000 RRULE:FREQ=DAILY;INTERVAL=1",
+			types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("2026-01-11T01:30:00Z"),
+				types.StringValue("2026-01-12T01:30:00Z"),
+			}),
+		},
+		{
+			"rule already ended",
+			"DTSTART;TZID=UTC:20250101T000000 RRULE:FREQ=DAILY;COUNT=3",
+			types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		{
+			"invalid rule",
+			"FREQ=SOMETIMES",
+			types.ListNull(types.StringType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := RruleNextRuns(tt.rrule, now, 2)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRruleOverlap(t *testing.T) {
+	from := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	until := from.AddDate(0, 0, 14)
+	tests := []struct {
+		description    string
+		first          string
+		firstDuration  time.Duration
+		second         string
+		secondDuration time.Duration
+		expected       time.Time
+		found          bool
+		isValid        bool
+	}{
+		{
+			"point within window",
+			"DTSTART;TZID=UTC:20260101T023000 RRULE:FREQ=DAILY",
+			0,
+			"DTSTART;TZID=UTC:20260101T020000 RRULE:FREQ=DAILY",
+			time.Hour,
+			time.Date(2026, 1, 11, 2, 30, 0, 0, time.UTC),
+			true,
+			true,
+		},
+		{
+			"point at window start",
+			"DTSTART;TZID=UTC:20260101T020000 RRULE:FREQ=DAILY",
+			0,
+			"DTSTART;TZID=UTC:20260101T020000 RRULE:FREQ=DAILY",
+			time.Hour,
+			time.Date(2026, 1, 11, 2, 0, 0, 0, time.UTC),
+			true,
+			true,
+		},
+		{
+			"point at window end",
+			"DTSTART;TZID=UTC:20260101T030000 RRULE:FREQ=DAILY",
+			0,
+			"DTSTART;TZID=UTC:20260101T020000 RRULE:FREQ=DAILY",
+			time.Hour,
+			time.Time{},
+			false,
+			true,
+		},
+		{
+			"window started before the checked period",
+			"DTSTART;TZID=UTC:20260110T130000 RRULE:FREQ=DAILY;COUNT=1",
+			0,
+			"DTSTART;TZID=UTC:20260110T110000 RRULE:FREQ=DAILY;COUNT=1",
+			3 * time.Hour,
+			time.Date(2026, 1, 10, 13, 0, 0, 0, time.UTC),
+			true,
+			true,
+		},
+		{
+			"different week days",
+			"DTSTART;TZID=UTC:20260101T020000 RRULE:FREQ=WEEKLY;BYDAY=MO",
+			0,
+			"DTSTART;TZID=UTC:20260101T020000 RRULE:FREQ=WEEKLY;BYDAY=TU",
+			2 * time.Hour,
+			time.Time{},
+			false,
+			true,
+		},
+		{
+			"invalid first rule",
+			"FREQ=SOMETIMES",
+			0,
+			"DTSTART;TZID=UTC:20260101T020000 RRULE:FREQ=DAILY",
+			time.Hour,
+			time.Time{},
+			false,
+			false,
+		},
+		{
+			"invalid second rule",
+			"DTSTART;TZID=UTC:20260101T020000 RRULE:FREQ=DAILY",
+			0,
+			"FREQ=SOMETIMES",
+			time.Hour,
+			time.Time{},
+			false,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			overlap, found, err := RruleOverlap(tt.first, tt.firstDuration, tt.second, tt.secondDuration, from, until)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if found != tt.found {
+					t.Fatalf("Expected found to be %t, got %t", tt.found, found)
+				}
+				if !overlap.Equal(tt.expected) {
+					t.Fatalf("Expected overlap at %v, got %v", tt.expected, overlap)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,414 @@
+package targetpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &TargetResource[struct{}]{}
+	_ resource.ResourceWithConfigure   = &TargetResource[struct{}]{}
+	_ resource.ResourceWithImportState = &TargetResource[struct{}]{}
+	_ resource.ResourceWithModifyPlan  = &TargetResource[struct{}]{}
+)
+
+type Model struct {
+	Id               types.String `tfsdk:"id"` // needed by TF
+	ProjectId        types.String `tfsdk:"project_id"`
+	Region           types.String `tfsdk:"region"`
+	LoadBalancerName types.String `tfsdk:"load_balancer_name"`
+	TargetPoolName   types.String `tfsdk:"target_pool_name"`
+	Ip               types.String `tfsdk:"ip"`
+	DisplayName      types.String `tfsdk:"display_name"`
+}
+
+// TargetResource adds a single target to a target pool of a load balancer. It is shared by the load balancer products,
+// which only differ in their API client C and in the validation of the display name.
+type TargetResource[C any] struct {
+	// TypeName is the resource type name without the provider prefix, e.g. "loadbalancer_target"
+	TypeName string
+	// ProductName is used in descriptions and messages, e.g. "load balancer"
+	ProductName string
+	// LoadBalancerResource is the resource managing the load balancer, e.g. "stackit_loadbalancer"
+	LoadBalancerResource string
+	DisplayNameOptional  bool
+	DisplayNameValidator validator.String
+	ApiClientFactory     func(ctx context.Context, providerData *core.ProviderData, diags *diag.Diagnostics) *C
+	// LockTargetPool acquires the lock, which is shared with the load balancer resource of the product
+	LockTargetPool   func(projectId, region, loadBalancerName string, targetPoolNames ...string) func()
+	NewTargetPoolAPI func(client *C, projectId, region, loadBalancerName, targetPoolName string) TargetPoolAPI
+
+	client       *C
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *TargetResource[C]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, r.TypeName)
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *TargetResource[C]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TargetResource[C]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := r.ApiClientFactory(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, fmt.Sprintf("%s client configured", r.title()))
+}
+
+// Schema defines the schema for the resource.
+func (r *TargetResource[C]) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": fmt.Sprintf("%s target resource schema. Adds a single target to a target pool of %s, which allows to register targets independently of the `%s` resource. ", r.title(), r.withArticle(), r.LoadBalancerResource) +
+			fmt.Sprintf("Don't set `targets` for the target pool in the `%s` resource, otherwise both resources overwrite each other. ", r.LoadBalancerResource) +
+			"Multiple targets can be added to the same pool in parallel, also from several Terraform runs or modules. " +
+			"Concurrent changes of the load balancer are detected by its version and the change of the targets is retried.",
+		"id":                 "Terraform's internal resource ID. It is structured as \"`project_id`\",\"`region`\",\"`load_balancer_name`\",\"`target_pool_name`\",\"`ip`\".",
+		"project_id":         fmt.Sprintf("STACKIT project ID to which the %s is associated.", r.ProductName),
+		"region":             "The resource region. If not defined, the provider region is used.",
+		"load_balancer_name": fmt.Sprintf("Name of the %s.", r.ProductName),
+		"target_pool_name":   fmt.Sprintf("Name of the target pool of the %s.", r.ProductName),
+		"ip":                 "Target IP. Must be unique within the target pool.",
+		"display_name":       "Target display name.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"load_balancer_name": schema.StringAttribute{
+				Description: descriptions["load_balancer_name"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"target_pool_name": schema.StringAttribute{
+				Description: descriptions["target_pool_name"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Description: descriptions["ip"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.IP(false),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: descriptions["display_name"],
+				Required:    !r.DisplayNameOptional,
+				Optional:    r.DisplayNameOptional,
+				Validators: []validator.String{
+					r.DisplayNameValidator,
+				},
+			},
+		},
+	}
+}
+
+// Create adds the target to the target pool and sets the initial Terraform state.
+func (r *TargetResource[C]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = setLogFields(ctx, &model)
+
+	target := toTarget(&model)
+	err := r.updateTargets(ctx, &model, func(targets []Target) ([]Target, error) {
+		if FindTarget(targets, target.Ip) != nil {
+			return nil, fmt.Errorf("target with IP %q already exists in target pool %q, import it to manage it with Terraform", target.Ip, model.TargetPoolName.ValueString())
+		}
+		return append(targets, target), nil
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error creating %s target", r.ProductName), err.Error())
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	mapFields(&target, &model)
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("%s target created", r.title()))
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *TargetResource[C]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = setLogFields(ctx, &model)
+
+	targets, err := r.targetPoolAPI(&model).GetTargets(ctx)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error reading %s target", r.ProductName), fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	target := FindTarget(targets, model.Ip.ValueString())
+	if target == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapFields(target, &model)
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("%s target read", r.title()))
+}
+
+// Update updates the display name of the target and sets the updated Terraform state on success.
+func (r *TargetResource[C]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = setLogFields(ctx, &model)
+
+	target := toTarget(&model)
+	err := r.updateTargets(ctx, &model, func(targets []Target) ([]Target, error) {
+		return ReplaceTarget(targets, target.Ip, &target), nil
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error updating %s target", r.ProductName), err.Error())
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	mapFields(&target, &model)
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("%s target updated", r.title()))
+}
+
+// Delete removes the target from the target pool and removes the Terraform state on success.
+func (r *TargetResource[C]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = setLogFields(ctx, &model)
+
+	ip := model.Ip.ValueString()
+	err := r.updateTargets(ctx, &model, func(targets []Target) ([]Target, error) {
+		return ReplaceTarget(targets, ip, nil), nil
+	})
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("%s or target pool already deleted", r.title()))
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error deleting %s target", r.ProductName), err.Error())
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	tflog.Info(ctx, fmt.Sprintf("%s target deleted", r.title()))
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,region,load_balancer_name,target_pool_name,ip
+func (r *TargetResource[C]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 5 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" || idParts[4] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			fmt.Sprintf("Error importing %s target", r.ProductName),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[load_balancer_name],[target_pool_name],[ip]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("load_balancer_name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_pool_name"), idParts[3])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), idParts[4])...)
+	tflog.Info(ctx, fmt.Sprintf("%s target state imported", r.title()))
+}
+
+func (r *TargetResource[C]) targetPoolAPI(model *Model) TargetPoolAPI {
+	return r.NewTargetPoolAPI(r.client, model.ProjectId.ValueString(), model.Region.ValueString(), model.LoadBalancerName.ValueString(), model.TargetPoolName.ValueString())
+}
+
+// updateTargets changes the targets of the target pool of the model while holding the lock of the target pool.
+func (r *TargetResource[C]) updateTargets(ctx context.Context, model *Model, modify func([]Target) ([]Target, error)) error {
+	unlock := r.LockTargetPool(model.ProjectId.ValueString(), model.Region.ValueString(), model.LoadBalancerName.ValueString(), model.TargetPoolName.ValueString())
+	defer unlock()
+	return UpdateTargets(ctx, r.targetPoolAPI(model), modify)
+}
+
+// title returns the product name with a capitalized first letter, e.g. "Load balancer".
+func (r *TargetResource[C]) title() string {
+	if r.ProductName == "" {
+		return ""
+	}
+	return strings.ToUpper(r.ProductName[:1]) + r.ProductName[1:]
+}
+
+// withArticle returns the product name with its indefinite article, e.g. "a load balancer".
+func (r *TargetResource[C]) withArticle() string {
+	if r.ProductName != "" && strings.ContainsRune("aeiouAEIOU", rune(r.ProductName[0])) {
+		return "an " + r.ProductName
+	}
+	return "a " + r.ProductName
+}
+
+func setLogFields(ctx context.Context, model *Model) context.Context {
+	ctx = tflog.SetField(ctx, "project_id", model.ProjectId.ValueString())
+	ctx = tflog.SetField(ctx, "region", model.Region.ValueString())
+	ctx = tflog.SetField(ctx, "load_balancer_name", model.LoadBalancerName.ValueString())
+	ctx = tflog.SetField(ctx, "target_pool_name", model.TargetPoolName.ValueString())
+	ctx = tflog.SetField(ctx, "ip", model.Ip.ValueString())
+	return ctx
+}
+
+// isNotFound returns true if the load balancer or the target pool doesn't exist.
+func isNotFound(err error) bool {
+	var oapiErr *oapierror.GenericOpenAPIError
+	return errors.Is(err, ErrTargetPoolNotFound) || (errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound)
+}
+
+func toTarget(model *Model) Target {
+	return Target{
+		Ip:          model.Ip.ValueString(),
+		DisplayName: conversion.StringValueToPointer(model.DisplayName),
+	}
+}
+
+func mapFields(target *Target, model *Model) {
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.Region.ValueString(), model.LoadBalancerName.ValueString(), model.TargetPoolName.ValueString(), target.Ip)
+	model.Ip = types.StringValue(target.Ip)
+	model.DisplayName = types.StringPointerValue(target.DisplayName)
+}
@@ -0,0 +1,143 @@
+package targetpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+// maxUpdateAttempts is the number of read-modify-write cycles, before a change of the targets is given up.
+// A cycle is repeated, if the load balancer was changed concurrently between reading and writing it.
+const maxUpdateAttempts = 3
+
+var ErrTargetPoolNotFound = errors.New("target pool not found")
+
+// Target is a target of a target pool, independent of the SDK of the load balancer API.
+type Target struct {
+	Ip          string
+	DisplayName *string
+}
+
+// GetDisplayName returns the display name of the target, or an empty string if it isn't set.
+func (t *Target) GetDisplayName() string {
+	if t.DisplayName == nil {
+		return ""
+	}
+	return *t.DisplayName
+}
+
+// Locks serializes changes of the targets of target pools. The locks only cover one provider process, i.e. they don't
+// protect against changes of other Terraform runs or of other clients of the API. Such changes are detected by the
+// version of the load balancer, the locks only avoid conflicts within one process.
+type Locks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// Lock acquires the locks of the given target pools of a load balancer. The locks are acquired in a fixed order, so
+// callers which lock multiple target pools don't deadlock each other. It returns an unlock function that must be deferred.
+func (l *Locks) Lock(projectId, region, loadBalancerName string, targetPoolNames ...string) func() {
+	names := slices.Clone(targetPoolNames)
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	mutexes := []*sync.Mutex{}
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*sync.Mutex)
+	}
+	for _, name := range names {
+		key := utils.BuildInternalTerraformId(projectId, region, loadBalancerName, name).ValueString()
+		mu, ok := l.locks[key]
+		if !ok {
+			mu = &sync.Mutex{}
+			l.locks[key] = mu
+		}
+		mutexes = append(mutexes, mu)
+	}
+	l.mu.Unlock()
+
+	for _, mu := range mutexes {
+		mu.Lock()
+	}
+	return func() {
+		for i := len(mutexes) - 1; i >= 0; i-- {
+			mutexes[i].Unlock()
+		}
+	}
+}
+
+// TargetPoolAPI reads and writes the targets of a target pool.
+type TargetPoolAPI interface {
+	// GetTargets reads the load balancer and returns the targets of the target pool, or ErrTargetPoolNotFound if the
+	// load balancer has no such pool.
+	GetTargets(ctx context.Context) ([]Target, error)
+	// SetTargets replaces the targets of the target pool and keeps all other settings of the load balancer read last
+	// by GetTargets. The update is sent with the version of that load balancer, so the API rejects it if the load
+	// balancer was changed since it was read.
+	SetTargets(ctx context.Context, targets []Target) error
+}
+
+// UpdateTargets changes the targets of a target pool with read-modify-write cycles. Since the load balancer is updated
+// with the version it was read with, a concurrent change by another process or client of the API is detected and the
+// cycle is repeated with a fresh read. The lock of the target pool should be held by the caller, it avoids these
+// conflicts within this provider process.
+func UpdateTargets(ctx context.Context, api TargetPoolAPI, modify func([]Target) ([]Target, error)) error {
+	for attempt := 1; ; attempt++ {
+		current, err := api.GetTargets(ctx)
+		if err != nil {
+			return err
+		}
+		targets, err := modify(current)
+		if err != nil {
+			return err
+		}
+		err = api.SetTargets(ctx, targets)
+		if err == nil {
+			return nil
+		}
+		if !isVersionConflict(err) {
+			return fmt.Errorf("updating target pool: %w", err)
+		}
+		if attempt == maxUpdateAttempts {
+			return fmt.Errorf("the load balancer was changed concurrently %d times, the change could not be applied: %w", maxUpdateAttempts, err)
+		}
+		tflog.Warn(ctx, "Load balancer was changed concurrently, retrying", map[string]any{"attempt": attempt + 1})
+	}
+}
+
+// isVersionConflict reports whether the API rejected an update, because the load balancer version it was sent with is outdated.
+func isVersionConflict(err error) bool {
+	var oapiErr *oapierror.GenericOpenAPIError
+	return errors.As(err, &oapiErr) && (oapiErr.StatusCode == http.StatusConflict || oapiErr.StatusCode == http.StatusPreconditionFailed)
+}
+
+func FindTarget(targets []Target, ip string) *Target {
+	for i := range targets {
+		if targets[i].Ip == ip {
+			return &targets[i]
+		}
+	}
+	return nil
+}
+
+// ReplaceTarget replaces the target with the given IP. If target is nil, it is removed.
+func ReplaceTarget(targets []Target, ip string, target *Target) []Target {
+	result := []Target{}
+	for i := range targets {
+		if targets[i].Ip != ip {
+			result = append(result, targets[i])
+		} else if target != nil {
+			result = append(result, *target)
+		}
+	}
+	return result
+}
@@ -0,0 +1,230 @@
+package targetpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+// fakeTargetPoolAPI returns setErrors on consecutive calls of SetTargets, the last one is repeated.
+type fakeTargetPoolAPI struct {
+	targets   []Target
+	getError  error
+	setErrors []error
+	getCalls  int
+	setCalls  int
+}
+
+func (f *fakeTargetPoolAPI) GetTargets(_ context.Context) ([]Target, error) {
+	f.getCalls++
+	if f.getError != nil {
+		return nil, f.getError
+	}
+	return f.targets, nil
+}
+
+func (f *fakeTargetPoolAPI) SetTargets(_ context.Context, targets []Target) error {
+	f.setCalls++
+	if len(f.setErrors) == 0 {
+		f.targets = targets
+		return nil
+	}
+	err := f.setErrors[min(f.setCalls, len(f.setErrors))-1]
+	if err == nil {
+		f.targets = targets
+	}
+	return err
+}
+
+func TestUpdateTargets(t *testing.T) {
+	existing := Target{DisplayName: new("existing"), Ip: "10.0.0.1"}
+	target := Target{DisplayName: new("target"), Ip: "10.0.0.2"}
+	conflict := &oapierror.GenericOpenAPIError{StatusCode: http.StatusConflict}
+
+	tests := []struct {
+		description     string
+		api             *fakeTargetPoolAPI
+		expectedReads   int
+		expectedUpdates int
+		expectedErr     error
+		isValid         bool
+	}{
+		{
+			description:     "applied",
+			api:             &fakeTargetPoolAPI{targets: []Target{existing}},
+			expectedReads:   1,
+			expectedUpdates: 1,
+			isValid:         true,
+		},
+		{
+			description:     "version conflict is retried",
+			api:             &fakeTargetPoolAPI{targets: []Target{existing}, setErrors: []error{conflict, nil}},
+			expectedReads:   2,
+			expectedUpdates: 2,
+			isValid:         true,
+		},
+		{
+			description:     "version precondition failed is retried",
+			api:             &fakeTargetPoolAPI{targets: []Target{existing}, setErrors: []error{&oapierror.GenericOpenAPIError{StatusCode: http.StatusPreconditionFailed}, nil}},
+			expectedReads:   2,
+			expectedUpdates: 2,
+			isValid:         true,
+		},
+		{
+			description:     "version conflict on every attempt",
+			api:             &fakeTargetPoolAPI{targets: []Target{existing}, setErrors: []error{conflict}},
+			expectedReads:   maxUpdateAttempts,
+			expectedUpdates: maxUpdateAttempts,
+			expectedErr:     conflict,
+			isValid:         false,
+		},
+		{
+			description:   "target pool missing",
+			api:           &fakeTargetPoolAPI{getError: fmt.Errorf("load balancer %q: %w", "lb", ErrTargetPoolNotFound)},
+			expectedReads: 1,
+			expectedErr:   ErrTargetPoolNotFound,
+			isValid:       false,
+		},
+		{
+			description:     "update error isn't retried",
+			api:             &fakeTargetPoolAPI{targets: []Target{existing}, setErrors: []error{&oapierror.GenericOpenAPIError{StatusCode: http.StatusBadRequest}}},
+			expectedReads:   1,
+			expectedUpdates: 1,
+			isValid:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := UpdateTargets(context.Background(), tt.api, func(targets []Target) ([]Target, error) {
+				return append(slices.Clone(targets), target), nil
+			})
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Expected error %v, got %v", tt.expectedErr, err)
+			}
+			if tt.api.getCalls != tt.expectedReads {
+				t.Fatalf("Expected %d reads, got %d", tt.expectedReads, tt.api.getCalls)
+			}
+			if tt.api.setCalls != tt.expectedUpdates {
+				t.Fatalf("Expected %d updates, got %d", tt.expectedUpdates, tt.api.setCalls)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.api.targets, []Target{existing, target})
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestReplaceTarget(t *testing.T) {
+	targets := []Target{
+		{DisplayName: new("a"), Ip: "10.0.0.1"},
+		{DisplayName: new("b"), Ip: "10.0.0.2"},
+	}
+
+	tests := []struct {
+		description string
+		ip          string
+		target      *Target
+		expected    []Target
+	}{
+		{
+			"replace",
+			"10.0.0.2",
+			&Target{DisplayName: new("c"), Ip: "10.0.0.2"},
+			[]Target{
+				{DisplayName: new("a"), Ip: "10.0.0.1"},
+				{DisplayName: new("c"), Ip: "10.0.0.2"},
+			},
+		},
+		{
+			"remove",
+			"10.0.0.1",
+			nil,
+			[]Target{
+				{DisplayName: new("b"), Ip: "10.0.0.2"},
+			},
+		},
+		{
+			"missing",
+			"10.0.0.3",
+			nil,
+			targets,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := ReplaceTarget(targets, tt.ip, tt.target)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocks(t *testing.T) {
+	var locks Locks
+
+	unlock := locks.Lock("pid", "eu01", "lb", "pool-b", "pool-a")
+	// other pools and load balancers aren't blocked
+	locks.Lock("pid", "eu01", "lb", "pool-c")()
+	locks.Lock("pid", "eu01", "other-lb", "pool-a")()
+
+	locked := make(chan struct{})
+	go func() {
+		defer close(locked)
+		locks.Lock("pid", "eu01", "lb", "pool-a", "pool-a")()
+	}()
+	select {
+	case <-locked:
+		t.Fatalf("Target pool was locked twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatalf("Target pool wasn't unlocked")
+	}
+}
+
+func TestMapFields(t *testing.T) {
+	model := &Model{
+		ProjectId:        types.StringValue("pid"),
+		Region:           types.StringValue("eu01"),
+		LoadBalancerName: types.StringValue("lb"),
+		TargetPoolName:   types.StringValue("pool"),
+	}
+	expected := &Model{
+		Id:               types.StringValue("pid,eu01,lb,pool,10.0.0.1"),
+		ProjectId:        types.StringValue("pid"),
+		Region:           types.StringValue("eu01"),
+		LoadBalancerName: types.StringValue("lb"),
+		TargetPoolName:   types.StringValue("pool"),
+		Ip:               types.StringValue("10.0.0.1"),
+		DisplayName:      types.StringValue("target"),
+	}
+
+	mapFields(&Target{DisplayName: new("target"), Ip: "10.0.0.1"}, model)
+	diff := cmp.Diff(model, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
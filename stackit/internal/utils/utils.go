@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"golang.org/x/mod/semver"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 )
@@ -227,3 +228,21 @@ func Map[T, U any](input []T, mapFn func(T) U) []U {
 	}
 	return values
 }
+
+// CompareVersions orders version identifiers like "9" < "10" < "10.1". Identifiers which are no valid
+// semantic versions (without "v" prefix) are ordered lexicographically after the valid ones.
+func CompareVersions(a, b string) int {
+	va, vb := "v"+a, "v"+b
+	validA, validB := semver.IsValid(va), semver.IsValid(vb)
+	switch {
+	case validA && validB:
+		if c := semver.Compare(va, vb); c != 0 {
+			return c
+		}
+	case validA:
+		return -1
+	case validB:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
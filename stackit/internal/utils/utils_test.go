@@ -676,3 +676,27 @@ func TestMap(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"9", "10", -1},
+		{"10", "9", 1},
+		{"10", "10.1", -1},
+		{"2019", "2022", -1},
+		{"7.0", "8.0", -1},
+		{"16", "16", 0},
+		{"16", "latest", -1},
+		{"latest", "16", 1},
+		{"alpha", "beta", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			if got := CompareVersions(tt.a, tt.b); got != tt.want {
+				t.Errorf("CompareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/teambition/rrule-go"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
@@ -288,17 +287,9 @@ func Rrule() *Validator {
 	return &Validator{
 		description: description,
 		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
-			// The go library rrule-go expects \n before RRULE (to be a newline and not a space)
-			// for example: "DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=DAILY;COUNT=10"
-			// whereas a valid rrule according to the API docs is:
+			// A valid rrule according to the API docs separates DTSTART and RRULE with a ' ',
 			// for example: "DTSTART;TZID=America/New_York:19970902T090000 RRULE:FREQ=DAILY;COUNT=10"
-			//
-			// So we will accept a ' ' (which is valid per API docs),
-			// but replace it with a '\n' for the rrule-go validations
-			value := req.ConfigValue.ValueString()
-			value = strings.ReplaceAll(value, " ", "\n")
-
-			if _, err := rrule.StrToRRuleSet(value); err != nil {
+			if _, err := utils.ParseRrule(req.ConfigValue.ValueString()); err != nil {
 				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 					req.Path,
 					description,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -20,6 +21,10 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/access_token"
 	alb "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/alb/applicationloadbalancer"
+	albPlans "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/alb/plans"
+	albQuotas "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/alb/quotas"
+	albTarget "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/alb/target"
+	acmeCert "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/albcertificates/acmecertificate"
 	cert "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/albcertificates/certificate"
 	albWafCustomRuleGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/albwaf/custom_rule_group"
 	albWafManagedRuleSet "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/albwaf/managed_rule_set"
@@ -28,16 +33,27 @@ import (
 	roleAssignements "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/authorization/roleassignments"
 	cdnCustomDomain "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/cdn/customdomain"
 	cdn "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/cdn/distribution"
+	cdnPurgeCache "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/cdn/purgecache"
 	dnsRecordSet "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/recordset"
 	dnsZone "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/zone"
 	dremioInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/instance"
+	dremioPrivilege "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/privilege"
+	dremioRole "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/role"
+	dremioSource "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/source"
+	dremioSpace "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/space"
 	dremioUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dremio/user"
 	edgeCloudInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/edgecloud/instance"
 	edgeCloudInstances "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/edgecloud/instances"
 	edgeCloudKubeconfig "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/edgecloud/kubeconfig"
 	edgeCloudPlans "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/edgecloud/plans"
 	edgeCloudToken "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/edgecloud/token"
+	gitBranchProtection "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/branchprotection"
+	gitDeployKey "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/deploykey"
 	gitInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/instance"
+	gitOrganization "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/organization"
+	gitRepository "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/repository"
+	gitRunnerToken "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/runnertoken"
+	gitUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/user"
 	iaasAffinityGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/affinitygroup"
 	iaasImage "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/image"
 	iaasImageV2 "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/imagev2"
@@ -69,12 +85,22 @@ import (
 	iaasAlphaVpcRoutingTable "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/vpcroutingtable"
 	iaasAlphaVpcStaticRoute "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/vpcroutingtable/staticroute"
 	iamRoleBindingsV1 "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iam/rolebindings/v1"
+	intakeIntake "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/intake/intake"
 	intakeRunner "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/intake/runner"
+	intakeUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/intake/user"
+	kmsDecrypt "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/decrypt"
 	kmsKey "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/key"
+	kmsKeyImport "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/key-import"
+	kmsKeyVersion "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/key-version"
 	kmsKeyRing "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/keyring"
+	kmsPublicKey "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/public-key"
+	kmsSign "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/sign"
 	kmsWrappingKey "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/wrapping-key"
 	loadBalancer "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/loadbalancer"
 	loadBalancerObservabilityCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/observability-credential"
+	loadBalancerPlans "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/plans"
+	loadBalancerQuotas "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/quotas"
+	loadBalancerTarget "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/target"
 	logMeCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/logme/credential"
 	logMeInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/logme/instance"
 	logsAccessToken "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/logs/accesstoken"
@@ -83,9 +109,15 @@ import (
 	mariaDBInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mariadb/instance"
 	modelExperimentsInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/modelexperiments/instance"
 	modelExperimentsToken "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/modelexperiments/token"
+	modelServingModel "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/modelserving/model"
 	modelServingToken "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/modelserving/token"
+	mongoDBFlexBackups "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/backups"
+	mongoDBFlexFlavors "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/flavors"
 	mongoDBFlexInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/instance"
+	mongoDBFlexRestore "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/restore"
+	mongoDBFlexStorages "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/storages"
 	mongoDBFlexUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/user"
+	mongoDBFlexVersions "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/versions"
 	objectStorageBucket "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/bucket"
 	compliancelock "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/compliance-lock"
 	objecStorageCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/credential"
@@ -99,9 +131,13 @@ import (
 	openSearchCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/opensearch/credential"
 	openSearchInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/opensearch/instance"
 	postgresFlexDatabase "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/postgresflex/database"
+	postgresFlexExtension "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/postgresflex/extension"
 	postgresFlexFlavors "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/postgresflex/flavors"
 	postgresFlexInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/postgresflex/instance"
+	postgresFlexSettings "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/postgresflex/settings"
+	postgresFlexStorages "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/postgresflex/storages"
 	postgresFlexUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/postgresflex/user"
+	postgresFlexVersions "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/postgresflex/versions"
 	rabbitMQCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/rabbitmq/credential"
 	rabbitMQInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/rabbitmq/instance"
 	redisCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/redis/credential"
@@ -111,12 +147,18 @@ import (
 	scfOrganization "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/scf/organization"
 	scfOrganizationmanager "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/scf/organizationmanager"
 	scfPlatform "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/scf/platform"
+	scfQuotas "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/scf/quotas"
+	scfSpace "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/scf/space"
+	scfSpacerole "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/scf/spacerole"
 	secretsManagerInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/secretsmanager/instance"
+	secretsManagerSecret "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/secretsmanager/secret"
 	secretsManagerUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/secretsmanager/user"
+	serverBackup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverbackup/backup"
 	serverBackupEnable "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverbackup/enable"
 	serverBackupSchedule "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverbackup/schedule"
 	serverUpdateEnable "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverupdate/enable"
 	serverUpdateSchedule "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverupdate/schedule"
+	serverUpdate "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverupdate/update"
 	serviceAccount "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/account"
 	serviceAccounts "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/accounts"
 	serviceAccountFederatedIdentityProvider "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/federated_identity_provider"
@@ -131,10 +173,13 @@ import (
 	skeKubeconfig "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/kubeconfig"
 	skeKubernetesVersion "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/provideroptions/kubernetesversions"
 	skeMachineImages "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/provideroptions/machineimages"
+	sqlServerFlexBackups "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/backups"
 	sqlServerFlexDatabase "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/database"
 	sqlServerFlexFlavors "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/flavors"
 	sqlServerFlexInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/instance"
+	sqlServerFlexStorages "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/storages"
 	sqlServerFlexUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/user"
+	sqlServerFlexVersions "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/versions"
 	telemetryLink "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/telemetrylink/link"
 	telemetryRouterAccessToken "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/telemetryrouter/accesstoken"
 	telemetryRouterDestination "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/telemetryrouter/destination"
@@ -149,6 +194,7 @@ import (
 var (
 	_ provider.Provider                       = &Provider{}
 	_ provider.ProviderWithEphemeralResources = &Provider{}
+	_ provider.ProviderWithActions            = &Provider{}
 )
 
 // Provider is the provider implementation.
@@ -660,11 +706,13 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	// Make round tripper and custom endpoints available during DataSource and Resource
 	// type Configure methods.
 	providerData.RoundTripper = roundTripper
+	providerData.ServiceAccountEmail = core.GetServiceAccountEmail(providerConfig.ServiceAccountEmail, roundTripper)
 
 	providerData.Version = p.version
 
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.ActionData = providerData
 
 	// Copy service account, private key credentials and custom-token endpoint to support ephemeral access token generation
 	var ephemeralProviderData core.EphemeralProviderData
@@ -672,10 +720,21 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	resp.EphemeralResourceData = ephemeralProviderData
 }
 
+// Actions defines the actions implemented in the provider.
+func (p *Provider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		cdnPurgeCache.NewPurgeCacheAction,
+		serverBackup.NewRestoreAction,
+		serverUpdate.NewRunAction,
+	}
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource {
 	dataSources := []func() datasource.DataSource{
 		alb.NewApplicationLoadBalancerDataSource,
+		albPlans.NewPlansDataSource,
+		albQuotas.NewQuotasDataSource,
 		albWafCustomRuleGroup.NewCustomRuleGroupDataSource,
 		albWaf.NewWafConfigurationDatasource,
 		albWafManagedRuleSet.NewManagedRuleSetDataSource,
@@ -715,11 +774,17 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		iaasRoutingTables.NewRoutingTablesDataSource,
 		iaasRoutingTableRoutes.NewRoutingTableRoutesDataSource,
 		iaasSecurityGroupRule.NewSecurityGroupRuleDataSource,
+		intakeIntake.NewIntakeDataSource,
 		intakeRunner.NewRunnerDataSource,
+		intakeUser.NewUserDataSource,
 		kmsKey.NewKeyDataSource,
+		kmsKeyVersion.NewKeyVersionsDataSource,
 		kmsKeyRing.NewKeyRingDataSource,
+		kmsPublicKey.NewPublicKeyDataSource,
 		kmsWrappingKey.NewWrappingKeyDataSource,
 		loadBalancer.NewLoadBalancerDataSource,
+		loadBalancerPlans.NewPlansDataSource,
+		loadBalancerQuotas.NewQuotasDataSource,
 		logMeInstance.NewInstanceDataSource,
 		logMeCredential.NewCredentialDataSource,
 		logsInstance.NewLogsInstanceDataSource,
@@ -730,8 +795,14 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		mariaDBCredential.NewCredentialDataSource,
 		modelExperimentsInstance.NewInstanceDataSource,
 		modelExperimentsToken.NewInstanceTokenDataSource,
+		modelServingModel.NewModelDataSource,
+		modelServingModel.NewModelsDataSource,
+		mongoDBFlexBackups.NewBackupsDataSource,
+		mongoDBFlexFlavors.NewFlavorsDataSource,
 		mongoDBFlexInstance.NewInstanceDataSource,
+		mongoDBFlexStorages.NewStoragesDataSource,
 		mongoDBFlexUser.NewUserDataSource,
+		mongoDBFlexVersions.NewVersionsDataSource,
 		objectStorageBucket.NewBucketDataSource,
 		objecStorageCredentialsGroup.NewCredentialsGroupDataSource,
 		objecStorageCredential.NewCredentialDataSource,
@@ -743,7 +814,9 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		postgresFlexDatabase.NewDatabaseDataSource,
 		postgresFlexFlavors.NewFlavorsDataSource,
 		postgresFlexInstance.NewInstanceDataSource,
+		postgresFlexStorages.NewStoragesDataSource,
 		postgresFlexUser.NewUserDataSource,
+		postgresFlexVersions.NewVersionsDataSource,
 		rabbitMQInstance.NewInstanceDataSource,
 		rabbitMQCredential.NewCredentialDataSource,
 		redisInstance.NewInstanceDataSource,
@@ -752,6 +825,8 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		scfOrganization.NewScfOrganizationDataSource,
 		scfOrganizationmanager.NewScfOrganizationManagerDataSource,
 		scfPlatform.NewScfPlatformDataSource,
+		scfQuotas.NewScfQuotasDataSource,
+		scfSpace.NewScfSpaceDataSource,
 		resourceManagerFolder.NewFolderDataSource,
 		secretsManagerInstance.NewInstanceDataSource,
 		secretsManagerUser.NewUserDataSource,
@@ -759,10 +834,15 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		sqlServerFlexInstance.NewInstanceDataSource,
 		sqlServerFlexUser.NewUserDataSource,
 		sqlServerFlexFlavors.NewFlavorsDataSource,
+		sqlServerFlexBackups.NewBackupsDataSource,
+		sqlServerFlexStorages.NewStoragesDataSource,
+		sqlServerFlexVersions.NewVersionsDataSource,
 		serverBackupSchedule.NewScheduleDataSource,
 		serverBackupSchedule.NewSchedulesDataSource,
+		serverBackup.NewBackupsDataSource,
 		serverUpdateSchedule.NewScheduleDataSource,
 		serverUpdateSchedule.NewSchedulesDataSource,
+		serverUpdate.NewUpdatesDataSource,
 		serviceAccount.NewServiceAccountDataSource,
 		serviceAccountFederatedIdentityProvider.NewServiceAccountFederatedIdentityProviderDataSource,
 		serviceAccounts.NewServiceAccountsDataSource,
@@ -796,21 +876,33 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 	resources := []func() resource.Resource{
 		alb.NewApplicationLoadBalancerResource,
+		albTarget.NewTargetResource,
 		albWafCustomRuleGroup.NewCustomRuleGroupResource,
 		albWaf.NewWafConfigurationResource,
 		albWafManagedRuleSet.NewManagedRuleSetResource,
 		alertGroup.NewAlertGroupResource,
 		cdn.NewDistributionResource,
 		cert.NewCertificatesResource,
+		acmeCert.NewACMECertificateResource,
 		cdnCustomDomain.NewCustomDomainResource,
 		dnsZone.NewZoneResource,
 		dnsRecordSet.NewRecordSetResource,
 		dremioInstance.NewInstanceResource,
+		dremioPrivilege.NewPrivilegeResource,
+		dremioRole.NewRoleResource,
+		dremioSource.NewSourceResource,
+		dremioSpace.NewSpaceResource,
 		dremioUser.NewUserResource,
 		edgeCloudInstance.NewInstanceResource,
 		edgeCloudKubeconfig.NewKubeconfigResource,
 		edgeCloudToken.NewTokenResource,
 		gitInstance.NewGitResource,
+		gitOrganization.NewOrganizationResource,
+		gitRepository.NewRepositoryResource,
+		gitBranchProtection.NewBranchProtectionResource,
+		gitDeployKey.NewDeployKeyResource,
+		gitUser.NewUserResource,
+		gitRunnerToken.NewRunnerTokenResource,
 		iaasAlphaVpc.NewVPCResource,
 		iaasAlphaVpcRoutingTable.NewVpcRoutingTableResource,
 		iaasAlphaVpcNetworkRange.NewVpcNetworkRangeResource,
@@ -835,12 +927,17 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		iaasSecurityGroupRule.NewSecurityGroupRuleResource,
 		iaasRoutingTable.NewRoutingTableResource,
 		iaasRoutingTableRoute.NewRoutingTableRouteResource,
+		intakeIntake.NewIntakeResource,
 		intakeRunner.NewRunnerResource,
+		intakeUser.NewUserResource,
 		kmsKey.NewKeyResource,
+		kmsKeyImport.NewKeyImportResource,
+		kmsKeyVersion.NewKeyVersionResource,
 		kmsKeyRing.NewKeyRingResource,
 		kmsWrappingKey.NewWrappingKeyResource,
 		loadBalancer.NewLoadBalancerResource,
 		loadBalancerObservabilityCredential.NewObservabilityCredentialResource,
+		loadBalancerTarget.NewTargetResource,
 		logMeInstance.NewInstanceResource,
 		logMeCredential.NewCredentialResource,
 		logAlertGroup.NewLogAlertGroupResource,
@@ -852,6 +949,7 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		modelExperimentsInstance.NewInstanceResourceEmpty,
 		modelExperimentsToken.NewInstanceTokenResourceEmpty,
 		mongoDBFlexInstance.NewInstanceResource,
+		mongoDBFlexRestore.NewRestoreResource,
 		mongoDBFlexUser.NewUserResource,
 		objectStorageBucket.NewBucketResource,
 		objecStorageCredentialsGroup.NewCredentialsGroupResource,
@@ -863,7 +961,9 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		openSearchInstance.NewInstanceResource,
 		openSearchCredential.NewCredentialResource,
 		postgresFlexDatabase.NewDatabaseResource,
+		postgresFlexExtension.NewExtensionResource,
 		postgresFlexInstance.NewInstanceResource,
+		postgresFlexSettings.NewSettingsResource,
 		postgresFlexUser.NewUserResource,
 		rabbitMQInstance.NewInstanceResource,
 		rabbitMQCredential.NewCredentialResource,
@@ -872,13 +972,17 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		resourceManagerProject.NewProjectResource,
 		scfOrganization.NewScfOrganizationResource,
 		scfOrganizationmanager.NewScfOrganizationManagerResource,
+		scfSpace.NewScfSpaceResource,
+		scfSpacerole.NewScfSpaceRoleResource,
 		resourceManagerFolder.NewFolderResource,
 		secretsManagerInstance.NewInstanceResource,
+		secretsManagerSecret.NewSecretResource,
 		secretsManagerUser.NewUserResource,
 		sqlServerFlexDatabase.NewDatabaseResource,
 		sqlServerFlexInstance.NewInstanceResource,
 		sqlServerFlexUser.NewUserResource,
 		serverBackupSchedule.NewScheduleResource,
+		serverBackup.NewBackupResource,
 		serverUpdateSchedule.NewScheduleResource,
 		serviceAccount.NewServiceAccountResource,
 		serviceAccountFederatedIdentityProvider.NewServiceAccountFederatedIdentityProviderResource,
@@ -888,6 +992,8 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		resourcepool.NewResourcePoolResource,
 		share.NewShareResource,
 		exportpolicy.NewExportPolicyResource,
+		snapshots.NewSnapshotResource,
+		snapshotPolicy.NewSnapshotPolicyResource,
 		projectLock.NewProjectLockResource,
 		compliancelock.NewComplianceLockResource,
 		serverBackupEnable.NewServerBackupEnableResource,
@@ -900,6 +1006,7 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		vpnGateway.NewGatewayResource,
 	}
 	resources = append(resources, roleAssignements.NewRoleAssignmentResources()...)
+	resources = append(resources, roleAssignements.NewRoleAssignmentsResources()...)
 	resources = append(resources, customRole.NewCustomRoleResources()...)
 	resources = append(resources, iamRoleBindingsV1.NewRoleBindingResources()...)
 
@@ -910,6 +1017,9 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
 		access_token.NewAccessTokenEphemeralResource,
+		kmsDecrypt.NewDecryptEphemeralResource,
+		kmsSign.NewSignEphemeralResource,
+		secretsManagerSecret.NewSecretEphemeralResource,
 		skeKubeconfig.NewKubeconfigEphemeralResource,
 	}
 }